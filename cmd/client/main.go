@@ -5,6 +5,7 @@ import (
 	"main/internal/client/app/proto"
 	"main/internal/client/cli"
 	"main/internal/client/config"
+	"main/internal/client/session"
 	l "main/internal/logger"
 )
 
@@ -18,11 +19,25 @@ func main() {
 
 	c := config.Parse(logger)
 
-	client, err := proto.NewGothKeeperClient(c.GRPCAddr)
+	var store *session.Store
+	if c.SessionDir != "" {
+		store = session.NewStore(c.SessionDir)
+	}
+
+	creds, err := proto.NewTransportCredentials(c)
+	if err != nil {
+		logger.Fatalw(err.Error(), "event", "initialize transport security")
+	}
+
+	client, err := proto.NewGothKeeperClient(c.GRPCAddr, creds, store)
 	if err != nil {
 		logger.Fatalw(err.Error(), "event", "initialize client")
 	}
 	defer client.Close()
 
+	if err := client.LoadSession(); err != nil {
+		logger.Warnw(err.Error(), "event", "load session")
+	}
+
 	cli.Execute(client)
 }
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"main/internal/server/app/proto"
+	"main/internal/server/config"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// blobsUsage describes the arguments accepted by the blobs subcommand.
+const blobsUsage = "usage: server blobs gc | migrate"
+
+// runBlobs executes the blobs subcommand against the configured database and blob store.
+// "gc" removes blobs no binary refers to right away instead of waiting for the server to do it;
+// "migrate" moves binaries stored in the database by earlier versions into the blob store.
+// Both can run while servers are serving requests and may be interrupted at any time.
+func runBlobs(args []string, c *config.Config, l *zap.SugaredLogger) error {
+	if len(args) == 0 {
+		return errors.New(blobsUsage)
+	}
+
+	s, err := proto.NewServices(c, l)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	switch args[0] {
+	case "gc":
+		removed, err := s.Binaries().CollectGarbage(ctx, c.BlobGCGrace)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d orphaned blobs removed\n", removed)
+	case "migrate":
+		moved, err := s.Binaries().Offload(ctx, c.RotationBatch)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d binaries moved to the blob store\n", moved)
+	default:
+		return errors.New(blobsUsage)
+	}
+	return nil
+}
@@ -22,6 +22,27 @@ func main() {
 
 	c := config.Parse(logger)
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:], c); err != nil {
+			logger.Fatalw(err.Error(), "event", "migrate database")
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "rotate" {
+		if err := runRotate(os.Args[2:], c); err != nil {
+			logger.Fatalw(err.Error(), "event", "rotate master key")
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "blobs" {
+		if err := runBlobs(os.Args[2:], c, logger); err != nil {
+			logger.Fatalw(err.Error(), "event", "maintain blob store")
+		}
+		return
+	}
+
 	a, err := proto.NewApp(c, logger)
 	if err != nil {
 		logger.Fatalw(err.Error(), "event", "initialize application")
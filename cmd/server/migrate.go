@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/config"
+	"strconv"
+	"time"
+)
+
+// migrateUsage describes the arguments accepted by the migrate subcommand.
+const migrateUsage = "usage: server migrate up | down [steps] | status"
+
+// runMigrate executes the migrate subcommand against the configured database.
+// "up" applies pending migrations, "down" reverts the given number of migrations (one by default),
+// and "status" prints every known migration with the time it was applied.
+func runMigrate(args []string, c *config.Config) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+	if c.DatabaseType != string(config.PostgresSQL) {
+		return fmt.Errorf("unsupported database type: %s", c.DatabaseType)
+	}
+
+	db, err := psql.NewDB(c.DatabaseDSN)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), psql.MigrateTimeout)
+	defer cancel()
+
+	switch args[0] {
+	case "up":
+		applied, err := db.MigrateUp(ctx)
+		for _, m := range applied {
+			fmt.Printf("applied  %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Println("schema is up to date")
+		}
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return errors.New(migrateUsage)
+			}
+		}
+		reverted, err := db.MigrateDown(ctx, steps)
+		for _, m := range reverted {
+			fmt.Printf("reverted %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+	case "status":
+		statuses, err := db.MigrationStatus(ctx)
+		for _, s := range statuses {
+			applied := "pending"
+			if s.AppliedAt != nil {
+				applied = s.AppliedAt.Local().Format(time.DateTime)
+			}
+			fmt.Printf("%04d_%-30s %s\n", s.Version, s.Name, applied)
+		}
+		if err != nil {
+			return err
+		}
+	default:
+		return errors.New(migrateUsage)
+	}
+	return nil
+}
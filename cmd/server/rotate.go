@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/adapters/db/psql/repositories"
+	"main/internal/server/config"
+	"main/internal/server/services"
+	"time"
+)
+
+// rotateUsage describes the arguments accepted by the rotate subcommand.
+const rotateUsage = "usage: server rotate start | status"
+
+// rotateTimeout bounds the time the rotate subcommand may take.
+const rotateTimeout = 30 * time.Second
+
+// runRotate executes the rotate subcommand against the configured database.
+// "start" requests a background job moving every ciphertext to the active master key, which running
+// servers configured with that key pick up; "status" prints the most recent jobs and their progress.
+func runRotate(args []string, c *config.Config) error {
+	if len(args) == 0 {
+		return errors.New(rotateUsage)
+	}
+	if c.DatabaseType != string(config.PostgresSQL) {
+		return fmt.Errorf("unsupported database type: %s", c.DatabaseType)
+	}
+	if _, ok := c.CryptoKeys[c.CryptoKeyID]; !ok {
+		return fmt.Errorf("active master key %d is not configured", c.CryptoKeyID)
+	}
+
+	db, err := psql.NewDB(c.DatabaseDSN)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), rotateTimeout)
+	defer cancel()
+
+	r := repositories.NewKeyRotationsRepository(db)
+
+	switch args[0] {
+	case "start":
+		job, err := r.Start(ctx, c.CryptoKeyID)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("key rotation %d to master key %d requested\n", job.ID, job.MasterKeyID)
+	case "status":
+		jobs, err := r.List(ctx, services.RotationHistorySize)
+		if err != nil {
+			return err
+		}
+		if len(jobs) == 0 {
+			fmt.Println("no key rotations")
+		}
+		for _, job := range jobs {
+			state := "running: " + job.Phase
+			if job.FinishedAt != nil {
+				state = "finished " + job.FinishedAt.Local().Format(time.DateTime)
+			}
+			fmt.Printf("%d\tkey %d\t%s\tprocessed %d\tskipped %d\tupdated %s\n", job.ID, job.MasterKeyID,
+				state, job.Processed, job.Skipped, job.UpdatedAt.Local().Format(time.DateTime))
+		}
+	default:
+		return errors.New(rotateUsage)
+	}
+	return nil
+}
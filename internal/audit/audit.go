@@ -0,0 +1,151 @@
+package audit
+
+import (
+	"cmp"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"slices"
+	"time"
+)
+
+// Default policy of an audit.
+const (
+	DefaultMinScore   = 3   // Passwords scoring below this are weak.
+	DefaultMaxAgeDays = 180 // Passwords not changed for longer than this are stale.
+)
+
+// ErrInvalidPolicy is returned for a policy whose minimum score is out of range or whose maximum age is negative.
+var ErrInvalidPolicy = errors.New("invalid audit policy")
+
+// Policy tells which passwords an audit reports.
+type Policy struct {
+	MinScore   int `json:"min_score"`    // Passwords scoring below this are weak; 1 to MaxScore.
+	MaxAgeDays int `json:"max_age_days"` // Passwords not changed for longer than this many days are stale; zero disables the check.
+}
+
+// DefaultPolicy returns the policy applied when none is given.
+func DefaultPolicy() Policy {
+	return Policy{MinScore: DefaultMinScore, MaxAgeDays: DefaultMaxAgeDays}
+}
+
+// Validate checks that the minimum score is in range and the maximum age is not negative.
+func (p Policy) Validate() error {
+	if p.MinScore < 1 || p.MinScore > MaxScore || p.MaxAgeDays < 0 {
+		return ErrInvalidPolicy
+	}
+	return nil
+}
+
+// Entry is a decrypted password entry to audit.
+type Entry struct {
+	Title     string    // Title of the entry.
+	Password  string    // Password in clear.
+	UpdatedAt time.Time // Time the entry was last changed.
+	Breaches  int64     // Times the password was seen in known breaches; zero if never or not checked.
+}
+
+// Report is the outcome of an audit. Passwords themselves are never part of it.
+type Report struct {
+	Policy   Policy   `json:"policy"`   // Policy the entries were audited against.
+	Total    int      `json:"total"`    // Number of audited entries.
+	Weak     []Weak   `json:"weak"`     // Entries with weak passwords, weakest first.
+	Reused   []Reuse  `json:"reused"`   // Groups of entries sharing a password.
+	Stale    []Stale  `json:"stale"`    // Entries not changed for too long, oldest first.
+	Breached []Breach `json:"breached"` // Entries whose passwords were seen in known breaches, most seen first.
+}
+
+// Weak is an entry whose password scores below the policy minimum.
+type Weak struct {
+	Title    string   `json:"title"`    // Title of the entry.
+	Strength Strength `json:"strength"` // Estimated strength of the password.
+}
+
+// Reuse is a group of entries sharing the same password.
+type Reuse struct {
+	Titles []string `json:"titles"` // Titles of the entries, in alphabetical order.
+}
+
+// Stale is an entry whose password was not changed for longer than the policy allows.
+type Stale struct {
+	Title     string    `json:"title"`      // Title of the entry.
+	UpdatedAt time.Time `json:"updated_at"` // Time the entry was last changed.
+	AgeDays   int       `json:"age_days"`   // Whole days since the entry was last changed.
+}
+
+// Breach is an entry whose password was seen in known breaches.
+type Breach struct {
+	Title string `json:"title"` // Title of the entry.
+	Count int64  `json:"count"` // Times the password was seen in breaches.
+}
+
+// Run audits the entries against the policy as of now.
+// Reused passwords are told apart by an HMAC-SHA256 under a random key drawn for this run only.
+func Run(entries []Entry, policy Policy, now time.Time) (*Report, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	key := make([]byte, sha256.Size)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Policy:   policy,
+		Total:    len(entries),
+		Weak:     []Weak{},
+		Reused:   []Reuse{},
+		Stale:    []Stale{},
+		Breached: []Breach{},
+	}
+	groups := make(map[string][]string)
+	maxAge := time.Duration(policy.MaxAgeDays) * 24 * time.Hour
+
+	for _, e := range entries {
+		if strength := Estimate(e.Password, policy.MinScore); strength.Score < policy.MinScore {
+			report.Weak = append(report.Weak, Weak{Title: e.Title, Strength: strength})
+		}
+
+		if e.Password != "" {
+			mac := hmac.New(sha256.New, key)
+			mac.Write([]byte(e.Password))
+			sum := string(mac.Sum(nil))
+			groups[sum] = append(groups[sum], e.Title)
+		}
+
+		if age := now.Sub(e.UpdatedAt); policy.MaxAgeDays > 0 && age > maxAge {
+			report.Stale = append(report.Stale, Stale{
+				Title:     e.Title,
+				UpdatedAt: e.UpdatedAt,
+				AgeDays:   int(age / (24 * time.Hour)),
+			})
+		}
+
+		if e.Breaches > 0 {
+			report.Breached = append(report.Breached, Breach{Title: e.Title, Count: e.Breaches})
+		}
+	}
+
+	for _, titles := range groups {
+		if len(titles) > 1 {
+			slices.Sort(titles)
+			report.Reused = append(report.Reused, Reuse{Titles: titles})
+		}
+	}
+
+	slices.SortFunc(report.Weak, func(a, b Weak) int {
+		return cmp.Or(cmp.Compare(a.Strength.GuessesLog10, b.Strength.GuessesLog10), cmp.Compare(a.Title, b.Title))
+	})
+	slices.SortFunc(report.Reused, func(a, b Reuse) int {
+		return cmp.Compare(a.Titles[0], b.Titles[0])
+	})
+	slices.SortFunc(report.Stale, func(a, b Stale) int {
+		return cmp.Or(a.UpdatedAt.Compare(b.UpdatedAt), cmp.Compare(a.Title, b.Title))
+	})
+	slices.SortFunc(report.Breached, func(a, b Breach) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Title, b.Title))
+	})
+	return report, nil
+}
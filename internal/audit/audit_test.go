@@ -0,0 +1,155 @@
+package audit
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEstimate(t *testing.T) {
+	tests := []struct {
+		name        string
+		password    string
+		wantScore   int
+		wantWarning string
+	}{
+		{"empty", "", 0, warnEmpty},
+		{"top password", "password", 0, warnTopCommon},
+		{"capitalized", "Password", 0, warnTopCommon},
+		{"reversed", "drowssap", 0, warnTopCommon},
+		{"substitutions", "p@ssw0rd", 0, warnTopCommon},
+		{"word and year", "monkey1987", 1, warnCommon},
+		{"keyboard row", "zxcvbnm,./", 0, warnSpatial},
+		{"sequence up", "abcdefgh", 0, warnSequence},
+		{"sequence down", "98765432", 0, warnSequence},
+		{"repeat", "aaaaaaaa", 0, warnRepeat},
+		{"year", "1987", 0, warnYear},
+		{"random characters", "x7#Qm!2vLp9@", MaxScore, ""},
+		{"uncommon words", "correct horse battery staple", MaxScore, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Estimate(tt.password, MaxScore)
+			if got.Score != tt.wantScore || got.Warning != tt.wantWarning {
+				t.Errorf("Estimate(%q) = %d, %q; want %d, %q", tt.password, got.Score, got.Warning, tt.wantScore, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestEstimateWarnsBelowMinimum(t *testing.T) {
+	tests := []struct {
+		name        string
+		minScore    int
+		wantWarning string
+	}{
+		{"minimum above the score", 2, warnCommon},
+		{"minimum at the score", 1, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Estimate("monkey1987", tt.minScore); got.Warning != tt.wantWarning {
+				t.Errorf("Estimate() warning = %q, want %q", got.Warning, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestEstimateOrdersPasswords(t *testing.T) {
+	weaker := []string{"password", "p@ssw0rd", "monkey1987", "x7#Qm!2vLp9@", "kT9$wq2!Lm#8vZ4&pR"}
+	for i := 1; i < len(weaker); i++ {
+		a, b := Estimate(weaker[i-1], 1), Estimate(weaker[i], 1)
+		if a.GuessesLog10 >= b.GuessesLog10 {
+			t.Errorf("%q needs %.2f guesses, not fewer than %q with %.2f", weaker[i-1], a.GuessesLog10, weaker[i], b.GuessesLog10)
+		}
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  Policy
+		wantErr error
+	}{
+		{"default", DefaultPolicy(), nil},
+		{"no age limit", Policy{MinScore: 1}, nil},
+		{"maximum score", Policy{MinScore: MaxScore}, nil},
+		{"score too low", Policy{MinScore: 0}, ErrInvalidPolicy},
+		{"score too high", Policy{MinScore: MaxScore + 1}, ErrInvalidPolicy},
+		{"negative age", Policy{MinScore: 1, MaxAgeDays: -1}, ErrInvalidPolicy},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.policy.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	days := func(n int) time.Time { return now.Add(-time.Duration(n) * 24 * time.Hour) }
+	strong := "kT9$wq2!Lm#8vZ4&pR"
+
+	entries := []Entry{
+		{Title: "mail", Password: "password", UpdatedAt: days(1), Breaches: 100},
+		{Title: "bank", Password: strong, UpdatedAt: days(400)},
+		{Title: "shop", Password: strong, UpdatedAt: days(181), Breaches: 3},
+		{Title: "forum", Password: "monkey1987", UpdatedAt: days(180)},
+		{Title: "blank", Password: "", UpdatedAt: days(2)},
+		{Title: "blank too", Password: "", UpdatedAt: days(2)},
+	}
+
+	report, err := Run(entries, DefaultPolicy(), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Total != len(entries) {
+		t.Errorf("Total = %d, want %d", report.Total, len(entries))
+	}
+	if got := weakTitles(report.Weak); !reflect.DeepEqual(got, []string{"blank", "blank too", "mail", "forum"}) {
+		t.Errorf("Weak = %v, want the weakest first", got)
+	}
+	if want := []Reuse{{Titles: []string{"bank", "shop"}}}; !reflect.DeepEqual(report.Reused, want) {
+		t.Errorf("Reused = %v, want %v", report.Reused, want)
+	}
+	wantStale := []Stale{{Title: "bank", UpdatedAt: days(400), AgeDays: 400}, {Title: "shop", UpdatedAt: days(181), AgeDays: 181}}
+	if !reflect.DeepEqual(report.Stale, wantStale) {
+		t.Errorf("Stale = %v, want %v", report.Stale, wantStale)
+	}
+	wantBreached := []Breach{{Title: "mail", Count: 100}, {Title: "shop", Count: 3}}
+	if !reflect.DeepEqual(report.Breached, wantBreached) {
+		t.Errorf("Breached = %v, want %v", report.Breached, wantBreached)
+	}
+}
+
+func TestRunWithoutAgeLimit(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	entries := []Entry{{Title: "old", Password: "kT9$wq2!Lm#8vZ4&pR", UpdatedAt: now.AddDate(-10, 0, 0)}}
+
+	report, err := Run(entries, Policy{MinScore: 1}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Stale) != 0 {
+		t.Errorf("Stale = %v, want none", report.Stale)
+	}
+}
+
+func TestRunInvalidPolicy(t *testing.T) {
+	if _, err := Run(nil, Policy{}, time.Now()); !errors.Is(err, ErrInvalidPolicy) {
+		t.Errorf("Run() error = %v, want %v", err, ErrInvalidPolicy)
+	}
+}
+
+// weakTitles returns the titles of weak entries in report order.
+func weakTitles(weak []Weak) []string {
+	titles := make([]string, len(weak))
+	for i, w := range weak {
+		titles[i] = w.Title
+	}
+	return titles
+}
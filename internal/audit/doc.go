@@ -0,0 +1,13 @@
+// Package audit reviews the health of stored passwords: it flags weak passwords by estimating how many
+// guesses an attacker needs to find them, groups passwords reused across entries, lists passwords
+// that have not been changed for longer than a policy allows, and lists those the caller found in
+// known breaches.
+//
+// Strength is estimated the way zxcvbn does it: the password is split into the cheapest sequence of
+// patterns an attacker would try, such as common passwords, keyboard rows, sequences, repeats and years,
+// with the characters covered by none of them guessed by brute force. Reused passwords are compared by
+// a keyed hash whose key lives only as long as a single audit, so they are never compared or reported in clear.
+//
+// The package is shared by the server, which audits the passwords of regular accounts, and the client,
+// which audits zero-knowledge vault accounts whose passwords the server cannot decrypt.
+package audit
@@ -0,0 +1,374 @@
+package audit
+
+import (
+	_ "embed"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// MaxScore is the score of a password too costly to guess for any practical attack.
+const MaxScore = 4
+
+// scoreThresholds are the guess counts, as powers of ten, a password must reach to score 1 to MaxScore.
+var scoreThresholds = [MaxScore]float64{3, 6, 8, 10}
+
+// Warnings explaining what makes a password weak.
+const (
+	warnEmpty      = "The password is empty."
+	warnTopCommon  = "This is a very common password."
+	warnCommon     = "Common words and passwords are easy to guess, even with substitutions like @ for a."
+	warnSpatial    = "Straight rows of keys are easy to guess."
+	warnSequence   = "Sequences like abc or 6543 are easy to guess."
+	warnRepeat     = "Repeats like aaa are easy to guess."
+	warnYear       = "Years are easy to guess."
+	warnBruteforce = "Add more characters; a few uncommon words are easy to remember and hard to guess."
+)
+
+// Strength is the estimated resistance of a password to guessing.
+type Strength struct {
+	Score        int     `json:"score"`             // 0 for a password guessed in a moment to MaxScore for a very unguessable one.
+	GuessesLog10 float64 `json:"guesses_log10"`     // Estimated number of guesses needed, as a power of ten.
+	Warning      string  `json:"warning,omitempty"` // What makes the password weak; empty unless it scores below the minimum it was estimated against.
+}
+
+//go:embed common.txt
+var commonList string
+
+// common ranks common passwords and words, the most common first, by their lowercase form.
+var common = rankWords(commonList)
+
+// maxWordLen is the length of the longest common word, which bounds dictionary matches.
+var maxWordLen = longestWord(common)
+
+// keyboardRows are the rows of a QWERTY keyboard, matched in both directions.
+var keyboardRows = []string{"`1234567890-=", "qwertyuiop[]\\", "asdfghjkl;'", "zxcvbnm,./"}
+
+// leet undoes the substitutions people commonly make in words.
+var leet = strings.NewReplacer("4", "a", "@", "a", "8", "b", "(", "c", "3", "e", "6", "g", "1", "i", "!", "i",
+	"|", "l", "0", "o", "$", "s", "5", "s", "7", "t", "+", "t", "2", "z")
+
+// pattern is a kind of guessable fragment of a password.
+type pattern int
+
+// Patterns matched in a password, in the order their warnings are preferred.
+const (
+	bruteforce pattern = iota // Characters matched by no other pattern.
+	dictionary                // A common password or word, possibly capitalized, reversed or with substitutions.
+	spatial                   // Adjacent keys of a keyboard row.
+	sequence                  // Consecutive letters or digits, up or down.
+	repeat                    // The same character over and over.
+	year                      // A year between 1900 and 2099.
+)
+
+// match is a fragment of a password covered by a pattern.
+type match struct {
+	start, end int     // Runes of the password covered, end excluded.
+	guesses    float64 // Guesses needed for the fragment, as a power of ten.
+	kind       pattern // Pattern the fragment matches.
+}
+
+// Estimate returns the strength of a password. The password is covered by the cheapest series of matched
+// patterns, guessing the remaining characters by brute force, and the guesses of the fragments multiply.
+// A password scoring below minScore is given a warning explaining its weakness.
+func Estimate(password string, minScore int) Strength {
+	runes := []rune(password)
+	if len(runes) == 0 {
+		return Strength{Warning: warnEmpty}
+	}
+
+	ending := make([][]match, len(runes)+1)
+	for _, m := range findMatches(runes) {
+		ending[m.end] = append(ending[m.end], m)
+	}
+
+	best := make([]float64, len(runes)+1)
+	last := make([]match, len(runes)+1)
+	for end := 1; end <= len(runes); end++ {
+		best[end] = best[end-1] + math.Log10(cardinality(runes[end-1]))
+		last[end] = match{start: end - 1, end: end, kind: bruteforce}
+		for _, m := range ending[end] {
+			if g := best[m.start] + m.guesses; g < best[end] {
+				best[end], last[end] = g, m
+			}
+		}
+	}
+
+	var path []match
+	for end := len(runes); end > 0; end = last[end].start {
+		path = append(path, last[end])
+	}
+
+	s := Strength{GuessesLog10: math.Round(best[len(runes)]*100) / 100}
+	for s.Score < MaxScore && s.GuessesLog10 >= scoreThresholds[s.Score] {
+		s.Score++
+	}
+	if s.Score < minScore {
+		s.Warning = warning(path, len(runes))
+	}
+	return s
+}
+
+// warning explains the weakness of a password by the pattern covering most of it.
+func warning(path []match, length int) string {
+	covered := make(map[pattern]int)
+	for _, m := range path {
+		covered[m.kind] += m.end - m.start
+	}
+
+	kind, most := bruteforce, 0
+	for k := dictionary; k <= year; k++ {
+		if covered[k] > most {
+			kind, most = k, covered[k]
+		}
+	}
+
+	switch kind {
+	case dictionary:
+		if len(path) == 1 && most == length {
+			return warnTopCommon
+		}
+		return warnCommon
+	case spatial:
+		return warnSpatial
+	case sequence:
+		return warnSequence
+	case repeat:
+		return warnRepeat
+	case year:
+		return warnYear
+	default:
+		return warnBruteforce
+	}
+}
+
+// findMatches returns every fragment of the password some pattern matches.
+func findMatches(runes []rune) []match {
+	var matches []match
+	matches = append(matches, dictionaryMatches(runes)...)
+	matches = append(matches, spatialMatches(runes)...)
+	matches = append(matches, sequenceMatches(runes)...)
+	matches = append(matches, repeatMatches(runes)...)
+	matches = append(matches, yearMatches(runes)...)
+	return matches
+}
+
+// dictionaryMatches finds common words of three characters or more, as typed, reversed or with substitutions undone.
+// Each capitalization and substitution variant multiplies the guesses by the number of ways to produce it.
+func dictionaryMatches(runes []rune) []match {
+	var matches []match
+	for i := range runes {
+		for j := i + 3; j <= len(runes) && j-i <= maxWordLen; j++ {
+			word := string(runes[i:j])
+			lower := strings.ToLower(word)
+			variants := capitalizations(runes[i:j])
+
+			guesses := math.Inf(1)
+			if rank, ok := common[lower]; ok {
+				guesses = math.Log10(float64(rank)) + variants
+			}
+			if rank, ok := common[reverse(lower)]; ok {
+				guesses = min(guesses, math.Log10(float64(rank))+variants+math.Log10(2))
+			}
+			if decoded := leet.Replace(lower); decoded != lower {
+				if rank, ok := common[decoded]; ok {
+					guesses = min(guesses, math.Log10(float64(rank))+variants+substitutions(lower, decoded))
+				}
+			}
+			if !math.IsInf(guesses, 1) {
+				matches = append(matches, match{start: i, end: j, guesses: guesses, kind: dictionary})
+			}
+		}
+	}
+	return matches
+}
+
+// spatialMatches finds four or more adjacent keys of a keyboard row, typed in either direction.
+func spatialMatches(runes []rune) []match {
+	var matches []match
+	lower := []rune(strings.ToLower(string(runes)))
+	for i := range lower {
+		for j := i + 4; j <= len(lower); j++ {
+			frag := string(lower[i:j])
+			if !onKeyboardRow(frag) {
+				break
+			}
+			guesses := math.Log10(float64(len(keyboardRows)*12*(j-i))) + capitalizations(runes[i:j])
+			matches = append(matches, match{start: i, end: j, guesses: guesses, kind: spatial})
+		}
+	}
+	return matches
+}
+
+// sequenceMatches finds three or more consecutive letters or digits, going up or down by one.
+func sequenceMatches(runes []rune) []match {
+	var matches []match
+	for i := 0; i+2 < len(runes); i++ {
+		delta := runes[i+1] - runes[i]
+		if delta != 1 && delta != -1 || !sameClass(runes[i], runes[i+1]) {
+			continue
+		}
+		j := i + 2
+		for j < len(runes) && runes[j]-runes[j-1] == delta && sameClass(runes[j-1], runes[j]) {
+			j++
+		}
+		if j-i < 3 {
+			continue
+		}
+
+		base := cardinality(runes[i])
+		if strings.ContainsRune("aAzZ019", runes[i]) {
+			base = 4
+		}
+		guesses := math.Log10(base * float64(j-i))
+		if delta < 0 {
+			guesses += math.Log10(2)
+		}
+		matches = append(matches, match{start: i, end: j, guesses: guesses, kind: sequence})
+	}
+	return matches
+}
+
+// repeatMatches finds three or more repetitions of the same character.
+func repeatMatches(runes []rune) []match {
+	var matches []match
+	for i := 0; i < len(runes); {
+		j := i + 1
+		for j < len(runes) && runes[j] == runes[i] {
+			j++
+		}
+		if j-i >= 3 {
+			guesses := math.Log10(cardinality(runes[i]) * float64(j-i))
+			matches = append(matches, match{start: i, end: j, guesses: guesses, kind: repeat})
+		}
+		i = j
+	}
+	return matches
+}
+
+// yearMatches finds years between 1900 and 2099.
+func yearMatches(runes []rune) []match {
+	var matches []match
+	for i := 0; i+4 <= len(runes); i++ {
+		frag := string(runes[i : i+4])
+		if (strings.HasPrefix(frag, "19") || strings.HasPrefix(frag, "20")) && isDigits(frag) {
+			matches = append(matches, match{start: i, end: i + 4, guesses: math.Log10(200), kind: year})
+		}
+	}
+	return matches
+}
+
+// cardinality returns the size of the character class a rune is guessed from by brute force.
+func cardinality(r rune) float64 {
+	switch {
+	case r >= '0' && r <= '9':
+		return 10
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		return 26
+	case r < unicode.MaxASCII:
+		return 33
+	default:
+		return 100
+	}
+}
+
+// sameClass reports whether two runes are both digits, both lowercase or both uppercase letters.
+func sameClass(a, b rune) bool {
+	return unicode.IsDigit(a) && unicode.IsDigit(b) ||
+		unicode.IsLower(a) && unicode.IsLower(b) ||
+		unicode.IsUpper(a) && unicode.IsUpper(b)
+}
+
+// capitalizations returns the number of ways, as a power of ten, to capitalize a word the way it is,
+// counting a fully lowercase word as a single way and a capitalized or uppercase one as two.
+func capitalizations(word []rune) float64 {
+	var upper, lower int
+	for _, r := range word {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		}
+	}
+
+	switch {
+	case upper == 0:
+		return 0
+	case lower == 0, upper == 1 && unicode.IsUpper(word[0]):
+		return math.Log10(2)
+	default:
+		return combinations(upper+lower, min(upper, lower))
+	}
+}
+
+// substitutions returns the number of ways, as a power of ten, to make the substitutions turning decoded into word.
+func substitutions(word, decoded string) float64 {
+	var subbed int
+	for i := range word {
+		if word[i] != decoded[i] {
+			subbed++
+		}
+	}
+	return combinations(len(word), subbed)
+}
+
+// combinations returns the number of ways to choose up to k of n items, as a power of ten.
+func combinations(n, k int) float64 {
+	var sum, c float64 = 0, 1
+	for i := 1; i <= k; i++ {
+		c = c * float64(n-i+1) / float64(i)
+		sum += c
+	}
+	return math.Log10(max(sum, 1))
+}
+
+// onKeyboardRow reports whether the fragment is part of a keyboard row, typed in either direction.
+func onKeyboardRow(frag string) bool {
+	for _, row := range keyboardRows {
+		if strings.Contains(row, frag) || strings.Contains(row, reverse(frag)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isDigits reports whether s consists of ASCII digits only.
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// reverse returns s with its runes in reverse order.
+func reverse(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+
+// rankWords ranks the lines of a word list by their position, starting at one.
+func rankWords(list string) map[string]int {
+	ranks := make(map[string]int)
+	for i, word := range strings.Fields(list) {
+		word = strings.ToLower(word)
+		if _, ok := ranks[word]; !ok {
+			ranks[word] = i + 1
+		}
+	}
+	return ranks
+}
+
+// longestWord returns the length in runes of the longest ranked word.
+func longestWord(ranks map[string]int) int {
+	var longest int
+	for word := range ranks {
+		longest = max(longest, len([]rune(word)))
+	}
+	return longest
+}
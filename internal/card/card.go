@@ -0,0 +1,264 @@
+package card
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Supported card brands.
+const (
+	Visa       = "visa"       // Visa.
+	Mastercard = "mastercard" // Mastercard.
+	Amex       = "amex"       // American Express.
+	Discover   = "discover"   // Discover.
+	JCB        = "jcb"        // JCB.
+	Diners     = "diners"     // Diners Club.
+	UnionPay   = "unionpay"   // UnionPay.
+	Mir        = "mir"        // Mir.
+	Maestro    = "maestro"    // Maestro.
+	Unknown    = "unknown"    // A number outside the known IIN ranges.
+)
+
+// Brands lists the card brands, Unknown last.
+var Brands = []string{Visa, Mastercard, Amex, Discover, JCB, Diners, UnionPay, Mir, Maestro, Unknown}
+
+// Errors returned for malformed card data.
+var (
+	ErrInvalidNumber = errors.New("invalid card number")   // The number is not made of digits, has a wrong length or fails the Luhn check.
+	ErrInvalidExpiry = errors.New("invalid expiry date")   // The expiry date is not a month and a year, such as 04/27.
+	ErrInvalidCVV    = errors.New("invalid security code") // The security code is not made of digits or has a wrong length for the brand.
+)
+
+// iinRange is a range of issuer identification numbers, given as prefixes of the same length.
+type iinRange struct {
+	lo, hi string // First and last prefix of the range, inclusive.
+	brand  string // Brand issuing the numbers.
+}
+
+// iinRanges are the issuer identification numbers of the known brands. The longest matching prefix wins,
+// so narrow ranges carved out of wider ones, such as Discover numbers starting with 622126, take precedence.
+var iinRanges = []iinRange{
+	{"4", "4", Visa},
+	{"51", "55", Mastercard},
+	{"2221", "2720", Mastercard},
+	{"34", "34", Amex},
+	{"37", "37", Amex},
+	{"6011", "6011", Discover},
+	{"644", "649", Discover},
+	{"65", "65", Discover},
+	{"622126", "622925", Discover},
+	{"3528", "3589", JCB},
+	{"300", "305", Diners},
+	{"3095", "3095", Diners},
+	{"36", "36", Diners},
+	{"38", "39", Diners},
+	{"62", "62", UnionPay},
+	{"81", "81", UnionPay},
+	{"2200", "2204", Mir},
+	{"5018", "5018", Maestro},
+	{"5020", "5020", Maestro},
+	{"5038", "5038", Maestro},
+	{"5893", "5893", Maestro},
+	{"6304", "6304", Maestro},
+	{"6759", "6759", Maestro},
+	{"6761", "6763", Maestro},
+}
+
+// rule is what a brand issues: the lengths of its numbers and of its security codes.
+type rule struct {
+	minLen, maxLen int   // Shortest and longest card number.
+	cvvLens        []int // Allowed security code lengths.
+}
+
+// rules are the number and security code lengths of each brand.
+var rules = map[string]rule{
+	Visa:       {13, 19, []int{3}},
+	Mastercard: {16, 16, []int{3}},
+	Amex:       {15, 15, []int{4}},
+	Discover:   {16, 19, []int{3}},
+	JCB:        {16, 19, []int{3}},
+	Diners:     {14, 19, []int{3}},
+	UnionPay:   {16, 19, []int{3}},
+	Mir:        {16, 19, []int{3}},
+	Maestro:    {12, 19, []int{3}},
+	Unknown:    {12, 19, []int{3, 4}},
+}
+
+// Expiry is the month a card expires at the end of.
+type Expiry struct {
+	Month int // Month, 1 to 12.
+	Year  int // Four-digit year.
+}
+
+// String formats the expiry date the way it is printed on cards, as MM/YY.
+func (e Expiry) String() string {
+	return fmt.Sprintf("%02d/%02d", e.Month, e.Year%100)
+}
+
+// Card is validated and normalized card data.
+type Card struct {
+	Number string // Card number, digits only.
+	Brand  string // Brand detected from the number; one of Brands.
+	Expiry Expiry // Expiry date.
+	CVV    string // Security code.
+}
+
+// Validate checks a card number, expiry date and security code as entered by a user, ignoring the spaces
+// and dashes grouping the digits of the number, and returns them normalized with the detected brand.
+func Validate(number, expiry, cvv string) (Card, error) {
+	digits := Normalize(number)
+	if !isDigits(digits) || !Luhn(digits) {
+		return Card{}, ErrInvalidNumber
+	}
+	brand := Detect(digits)
+	r := rules[brand]
+	if len(digits) < r.minLen || len(digits) > r.maxLen {
+		return Card{}, fmt.Errorf("%w: %s numbers have %s digits", ErrInvalidNumber, issuer(brand), lengths(r.minLen, r.maxLen))
+	}
+
+	exp, err := ParseExpiry(expiry)
+	if err != nil {
+		return Card{}, err
+	}
+
+	cvv = strings.TrimSpace(cvv)
+	if !isDigits(cvv) || !slices.Contains(r.cvvLens, len(cvv)) {
+		return Card{}, fmt.Errorf("%w: %s security codes have %s digits", ErrInvalidCVV, issuer(brand), cvvLengths(r.cvvLens))
+	}
+
+	return Card{Number: digits, Brand: brand, Expiry: exp, CVV: cvv}, nil
+}
+
+// Normalize strips the spaces and dashes grouping the digits of a card number.
+func Normalize(number string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, number)
+}
+
+// Luhn reports whether a number of digits passes the Luhn checksum.
+func Luhn(digits string) bool {
+	var sum int
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return len(digits) > 0 && sum%10 == 0
+}
+
+// Detect returns the brand issuing a card number from its IIN, or Unknown.
+func Detect(digits string) string {
+	brand, longest := Unknown, 0
+	for _, r := range iinRanges {
+		n := len(r.lo)
+		if n <= longest || len(digits) < n {
+			continue
+		}
+		if prefix := digits[:n]; prefix >= r.lo && prefix <= r.hi {
+			brand, longest = r.brand, n
+		}
+	}
+	return brand
+}
+
+// ValidBrand reports whether a brand is one of Brands.
+func ValidBrand(brand string) bool {
+	return slices.Contains(Brands, brand)
+}
+
+// ParseExpiry parses an expiry date given as MM/YY, MM/YYYY or MMYY; a dash may stand for the slash.
+func ParseExpiry(s string) (Expiry, error) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), " ", "")
+	month, year, ok := strings.Cut(strings.ReplaceAll(s, "-", "/"), "/")
+	if !ok && len(s) == 4 {
+		month, year = s[:2], s[2:]
+	}
+	if !isDigits(month) || !isDigits(year) || len(month) > 2 || len(year) != 2 && len(year) != 4 {
+		return Expiry{}, ErrInvalidExpiry
+	}
+
+	m, _ := strconv.Atoi(month)
+	y, _ := strconv.Atoi(year)
+	if m < 1 || m > 12 {
+		return Expiry{}, ErrInvalidExpiry
+	}
+	if len(year) == 2 {
+		y += 2000
+	}
+	return Expiry{Month: m, Year: y}, nil
+}
+
+// Mask hides all but the last four digits of a card number, grouping the digits by four.
+// Numbers of four digits or fewer are hidden entirely.
+func Mask(number string) string {
+	digits := Normalize(number)
+	visible := 4
+	if len(digits) <= visible {
+		visible = 0
+	}
+
+	var b strings.Builder
+	for i := range len(digits) {
+		if i > 0 && i%4 == 0 {
+			b.WriteByte(' ')
+		}
+		if i < len(digits)-visible {
+			b.WriteByte('*')
+		} else {
+			b.WriteByte(digits[i])
+		}
+	}
+	return b.String()
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := range len(s) {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// issuer names the brand in messages, cards of an unknown brand being just cards.
+func issuer(brand string) string {
+	if brand == Unknown {
+		return "card"
+	}
+	return brand
+}
+
+// lengths describes a range of lengths.
+func lengths(lo, hi int) string {
+	if lo == hi {
+		return strconv.Itoa(lo)
+	}
+	return fmt.Sprintf("%d to %d", lo, hi)
+}
+
+// cvvLengths describes the allowed security code lengths.
+func cvvLengths(lens []int) string {
+	s := make([]string, len(lens))
+	for i, n := range lens {
+		s[i] = strconv.Itoa(n)
+	}
+	return strings.Join(s, " or ")
+}
@@ -0,0 +1,116 @@
+package card
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLuhn(t *testing.T) {
+	tests := []struct {
+		digits string
+		want   bool
+	}{
+		{"4111111111111111", true},
+		{"4111111111111112", false},
+		{"378282246310005", true},
+		{"79927398713", true},
+		{"79927398710", false},
+		{"0", true},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := Luhn(tt.digits); got != tt.want {
+			t.Errorf("Luhn(%q) = %v, want %v", tt.digits, got, tt.want)
+		}
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		digits string
+		want   string
+	}{
+		{"4111111111111111", Visa},
+		{"5555555555554444", Mastercard},
+		{"2221000000000009", Mastercard},
+		{"378282246310005", Amex},
+		{"6011111111111117", Discover},
+		{"6221260000000000", Discover}, // Carved out of the UnionPay range.
+		{"6200000000000005", UnionPay},
+		{"3530111333300000", JCB},
+		{"30569309025904", Diners},
+		{"2200000000000004", Mir},
+		{"6759000000000000", Maestro},
+		{"9000000000000001", Unknown},
+		{"", Unknown},
+	}
+	for _, tt := range tests {
+		if got := Detect(tt.digits); got != tt.want {
+			t.Errorf("Detect(%q) = %s, want %s", tt.digits, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		number  string
+		expiry  string
+		cvv     string
+		want    Card
+		wantErr error
+	}{
+		{"visa grouped by spaces", "4111 1111 1111 1111", "04/27", "123",
+			Card{Number: "4111111111111111", Brand: Visa, Expiry: Expiry{4, 2027}, CVV: "123"}, nil},
+		{"amex grouped by dashes", "3782-822463-10005", "12/2030", " 1234 ",
+			Card{Number: "378282246310005", Brand: Amex, Expiry: Expiry{12, 2030}, CVV: "1234"}, nil},
+		{"short visa", "4000000000006", "0127", "123",
+			Card{Number: "4000000000006", Brand: Visa, Expiry: Expiry{1, 2027}, CVV: "123"}, nil},
+		{"unknown brand with a long code", "9000000000000001", "1-27", "1234",
+			Card{Number: "9000000000000001", Brand: Unknown, Expiry: Expiry{1, 2027}, CVV: "1234"}, nil},
+		{"failed checksum", "4111111111111112", "04/27", "123", Card{}, ErrInvalidNumber},
+		{"letters", "4111 1111 1111 111a", "04/27", "123", Card{}, ErrInvalidNumber},
+		{"wrong length for brand", "5100000000003", "04/27", "123", Card{}, ErrInvalidNumber},
+		{"month out of range", "4111111111111111", "13/27", "123", Card{}, ErrInvalidExpiry},
+		{"three-digit year", "4111111111111111", "04/270", "123", Card{}, ErrInvalidExpiry},
+		{"four-digit code for visa", "4111111111111111", "04/27", "1234", Card{}, ErrInvalidCVV},
+		{"three-digit code for amex", "378282246310005", "04/27", "123", Card{}, ErrInvalidCVV},
+		{"missing code", "4111111111111111", "04/27", "", Card{}, ErrInvalidCVV},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Validate(tt.number, tt.expiry, tt.cvv)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpiryString(t *testing.T) {
+	if got := (Expiry{Month: 4, Year: 2027}).String(); got != "04/27" {
+		t.Errorf("String() = %s, want 04/27", got)
+	}
+}
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		number string
+		want   string
+	}{
+		{"4111111111111111", "**** **** **** 1111"},
+		{"4111 1111 1111 1111", "**** **** **** 1111"},
+		{"378282246310005", "**** **** ***0 005"},
+		{"12345", "*234 5"},
+		{"1234", "****"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Mask(tt.number); got != tt.want {
+			t.Errorf("Mask(%q) = %q, want %q", tt.number, got, tt.want)
+		}
+	}
+}
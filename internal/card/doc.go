@@ -0,0 +1,8 @@
+// Package card checks and normalizes payment card data: numbers must pass the Luhn check and have a length
+// their brand issues, expiry dates are parsed into a month and a year, and security codes must have the
+// length of the brand. The brand is detected from the issuer identification number (IIN) at the start
+// of the card number, and numbers are masked down to their last four digits for display.
+//
+// The package is shared by the server, which validates the cards of regular accounts, and the client,
+// which validates cards before a vault account encrypts them and masks the numbers it decrypts.
+package card
@@ -0,0 +1,91 @@
+package proto
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	pb "main/proto"
+	"time"
+)
+
+// refreshMargin is how long before its expiration an authorization token is refreshed ahead of a call.
+const refreshMargin = 30 * time.Second
+
+// publicMethods lists the calls made without an authorization token.
+var publicMethods = map[string]bool{
+	"/gophkeeper.Users/Login":              true, // Authenticated by the credentials in the request body.
+	"/gophkeeper.Users/Register":           true, // Creates the account.
+	"/gophkeeper.Users/Refresh":            true, // Authenticated by the refresh token in the request body.
+	"/gophkeeper.Users/VerifySecondFactor": true, // Authenticated by the login challenge in the request body.
+}
+
+// authInterceptor attaches the authorization token to every call that needs one.
+// A token about to expire is refreshed before the call, and a call rejected as unauthenticated
+// is retried once after a refresh. Refreshed tokens are persisted right away, because the server
+// invalidates the previous refresh token.
+func (c *GothKeeperClient) authInterceptor(ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if publicMethods[method] {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	if c.Refresh != "" && time.Until(c.ExpiresAt) < refreshMargin {
+		if err := c.refresh(ctx); err != nil {
+			return err
+		}
+	}
+
+	err := invoker(c.withToken(ctx), method, req, reply, cc, opts...)
+	if status.Code(err) != codes.Unauthenticated || c.Refresh == "" {
+		return err
+	}
+
+	if err := c.refresh(ctx); err != nil {
+		return err
+	}
+	return invoker(c.withToken(ctx), method, req, reply, cc, opts...)
+}
+
+// authStreamInterceptor attaches the authorization token to streaming calls, refreshing it first if it is
+// about to expire. Streams are not retried, since their messages may already be consumed.
+func (c *GothKeeperClient) authStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn,
+	method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if c.Refresh != "" && time.Until(c.ExpiresAt) < refreshMargin {
+		if err := c.refresh(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return streamer(c.withToken(ctx), desc, cc, method, opts...)
+}
+
+// withToken returns a context carrying the current authorization token in the outgoing metadata.
+// Without a token the call is sent unauthenticated, leaving the server to rely on the client certificate.
+func (c *GothKeeperClient) withToken(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	if c.Token == "" {
+		md.Delete("token")
+	} else {
+		md.Set("token", c.Token)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// refresh exchanges the refresh token for a new token pair and persists it.
+// A rejected refresh token ends the session, so the stored credentials are cleared.
+func (c *GothKeeperClient) refresh(ctx context.Context) error {
+	result, err := c.Users.Refresh(ctx, &pb.RefreshRequest{RefreshToken: c.Refresh})
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			_ = c.ClearSession()
+		}
+		return err
+	}
+
+	c.Token = result.Token
+	c.Refresh = result.RefreshToken
+	c.ExpiresAt = result.ExpiresAt.AsTime()
+	return c.SaveSession()
+}
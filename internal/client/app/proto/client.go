@@ -1,43 +1,120 @@
 package proto
 
 import (
+	"errors"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
+	"main/internal/client/session"
+	"main/internal/client/vault"
 	pb "main/proto"
+	"time"
 )
 
 // GothKeeperClient represents a client wrapper for interacting with GRPC services.
 // Provides access to different servers through one unified interface.
 type GothKeeperClient struct {
 	conn      *grpc.ClientConn   // Connection to GRPC server
+	addr      string             // Address of the GRPC server
+	store     *session.Store     // Store persisting the session between invocations; nil disables persistence
+	Login     string             // Login of the authenticated user
 	Token     string             // Authorization token
+	Refresh   string             // Refresh token exchanging an expired authorization token for a new one
+	ExpiresAt time.Time          // Expiration time of the authorization token
+	Vault     *vault.Vault       // Unlocked zero-knowledge vault; nil until unlocked or if the server encrypts the data
 	Users     pb.UsersClient     // Client for users operations
 	Passwords pb.PasswordsClient // Client for passwords operations
 	Cards     pb.CardsClient     // Client for cards operations
 	Binaries  pb.BinariesClient  // Client for binaries operations
+	Notes     pb.NotesClient     // Client for notes operations
+	OTP       pb.OTPClient       // Client for authenticator secrets operations
+	SSHKeys   pb.SSHKeysClient   // Client for SSH keys operations
+	Folders   pb.FoldersClient   // Client for folders operations
+	Trash     pb.TrashClient     // Client for trash operations
 }
 
 // NewGothKeeperClient creates a new connection to a GRPC server and initializes corresponding clients.
 // Parameters:
 // - GRPCAddr: Address of the GRPC server.
-// - token: Authentication token.
+// - creds: Transport security of the connection, see NewTransportCredentials.
+// - store: Store of the persisted session; nil keeps the session in memory only.
 // Returns created client and possible error.
 // Potential errors:
 // - FailedConnection: Unable to establish a connection to the GRPC server.
 // - InvalidArguments: Incorrect arguments passed to the constructor.
-func NewGothKeeperClient(GRPCAddr string) (*GothKeeperClient, error) {
-	conn, err := grpc.NewClient(GRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func NewGothKeeperClient(GRPCAddr string, creds credentials.TransportCredentials, store *session.Store) (*GothKeeperClient, error) {
+	c := &GothKeeperClient{
+		addr:  GRPCAddr,
+		store: store,
+	}
+
+	conn, err := grpc.NewClient(GRPCAddr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUnaryInterceptor(c.authInterceptor),
+		grpc.WithStreamInterceptor(c.authStreamInterceptor),
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &GothKeeperClient{
-		conn:      conn,
-		Users:     pb.NewUsersClient(conn),
-		Passwords: pb.NewPasswordsClient(conn),
-		Cards:     pb.NewCardsClient(conn),
-		Binaries:  pb.NewBinariesClient(conn),
-	}, nil
+	c.conn = conn
+	c.Users = pb.NewUsersClient(conn)
+	c.Passwords = pb.NewPasswordsClient(conn)
+	c.Cards = pb.NewCardsClient(conn)
+	c.Binaries = pb.NewBinariesClient(conn)
+	c.Notes = pb.NewNotesClient(conn)
+	c.OTP = pb.NewOTPClient(conn)
+	c.SSHKeys = pb.NewSSHKeysClient(conn)
+	c.Folders = pb.NewFoldersClient(conn)
+	c.Trash = pb.NewTrashClient(conn)
+	return c, nil
+}
+
+// LoadSession restores the persisted session of the server the client is connected to.
+// A missing session, or one of another server, leaves the client logged out.
+func (c *GothKeeperClient) LoadSession() error {
+	if c.store == nil {
+		return nil
+	}
+
+	creds, err := c.store.Load()
+	if errors.Is(err, session.ErrNoSession) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if creds.Server != c.addr {
+		return nil
+	}
+
+	c.Login = creds.Login
+	c.Token = creds.Token
+	c.Refresh = creds.Refresh
+	c.ExpiresAt = creds.ExpiresAt
+	return nil
+}
+
+// SaveSession persists the current session so that later invocations stay logged in.
+func (c *GothKeeperClient) SaveSession() error {
+	if c.store == nil {
+		return nil
+	}
+	return c.store.Save(session.Credentials{
+		Server:    c.addr,
+		Login:     c.Login,
+		Token:     c.Token,
+		Refresh:   c.Refresh,
+		ExpiresAt: c.ExpiresAt,
+	})
+}
+
+// ClearSession forgets the current session in memory and on disk.
+func (c *GothKeeperClient) ClearSession() error {
+	c.Login, c.Token, c.Refresh, c.ExpiresAt, c.Vault = "", "", "", time.Time{}, nil
+	if c.store == nil {
+		return nil
+	}
+	return c.store.Clear()
 }
 
 // Close closes the active connection to the GRPC server.
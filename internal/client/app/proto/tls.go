@@ -0,0 +1,82 @@
+package proto
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"main/internal/client/config"
+	"os"
+)
+
+// ErrPinMismatch is returned when the server presents a public key that is not pinned.
+var ErrPinMismatch = errors.New("server public key does not match any pin")
+
+// NewTransportCredentials builds the transport security of the connection from the configuration.
+// Without TLS settings the connection is plaintext. With TLS, the server certificate is verified against
+// the CA bundle or the system roots, and additionally against the pinned public keys if any are set.
+func NewTransportCredentials(c *config.Config) (credentials.TransportCredentials, error) {
+	if !c.TLS {
+		return insecure.NewCredentials(), nil
+	}
+
+	conf := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: c.TLSServerName,
+	}
+
+	if c.TLSCAFile != "" {
+		pem, err := os.ReadFile(c.TLSCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("load CA bundle: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("load CA bundle: no certificates in %s", c.TLSCAFile)
+		}
+		conf.RootCAs = pool
+	}
+
+	if c.TLSCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.TLSCertFile, c.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate: %w", err)
+		}
+		conf.Certificates = []tls.Certificate{cert}
+	}
+
+	if len(c.TLSPins) > 0 {
+		pins := make([][]byte, 0, len(c.TLSPins))
+		for _, pin := range c.TLSPins {
+			hash, err := base64.StdEncoding.DecodeString(pin)
+			if err != nil || len(hash) != sha256.Size {
+				return nil, fmt.Errorf("invalid public key pin: %q", pin)
+			}
+			pins = append(pins, hash)
+		}
+		conf.VerifyConnection = func(state tls.ConnectionState) error {
+			return verifyPins(state, pins)
+		}
+	}
+
+	return credentials.NewTLS(conf), nil
+}
+
+// verifyPins accepts the connection if the public key of the server certificate matches one of the pins.
+func verifyPins(state tls.ConnectionState, pins [][]byte) error {
+	if len(state.PeerCertificates) == 0 {
+		return ErrPinMismatch
+	}
+	sum := sha256.Sum256(state.PeerCertificates[0].RawSubjectPublicKeyInfo)
+	for _, pin := range pins {
+		if subtle.ConstantTimeCompare(sum[:], pin) == 1 {
+			return nil
+		}
+	}
+	return ErrPinMismatch
+}
@@ -0,0 +1,203 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"main/internal/audit"
+	"main/internal/client/app/proto"
+	"main/internal/client/vault"
+	pb "main/proto"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// auditPassword reports weak, reused, stale and breached passwords on the standard output as tables or, with --json, as JSON.
+// The server audits regular accounts, against its breach corpus if it has one; the sealed passwords of zero-knowledge
+// vault accounts are fetched in one call and audited by the client, which has no breach corpus to check them against.
+// Passwords themselves are never printed.
+// Possible errors include an out of range --min-score (`InvalidArgument`) or an invalid token (`Unauthenticated`).
+func auditPassword(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Report weak, reused, stale and breached passwords",
+		Long: `Report passwords that are easy to guess, passwords shared by several entries,
+passwords not changed for longer than the given number of days and passwords
+found in the breach corpus of the server.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			minScore, err := cmd.Flags().GetInt("min-score")
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+			maxAge, err := cmd.Flags().GetInt("max-age-days")
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+			asJSON, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			policy := audit.Policy{MinScore: minScore, MaxAgeDays: maxAge}
+			if err := policy.Validate(); err != nil {
+				cmd.PrintErrf("Error: --min-score must be 1 to %d and --max-age-days must not be negative", audit.MaxScore)
+				return
+			}
+
+			v, err := unlockVault(cmd, client)
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+
+			var report *audit.Report
+			if v != nil {
+				report, err = auditLocally(cmd, client, v, policy)
+				if err != nil {
+					dispatchErrors(cmd, err)
+					return
+				}
+			} else {
+				cond := pb.AuditRequest{
+					MinScore:   int32(minScore),
+					MaxAgeDays: int32(maxAge),
+				}
+				if maxAge == 0 {
+					cond.MaxAgeDays = -1
+				}
+
+				ctx := cmd.Context()
+				newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+				result, err := client.Passwords.Audit(newCtx, &cond)
+				if err != nil {
+					dispatchErrors(cmd, err)
+					return
+				}
+				report = newAuditReport(result)
+			}
+
+			if asJSON {
+				out := json.NewEncoder(cmd.OutOrStdout())
+				out.SetIndent("", "  ")
+				if err := out.Encode(report); err != nil {
+					cmd.PrintErr(err)
+				}
+				return
+			}
+			printAuditReport(cmd, report)
+		},
+	}
+	cmd.Flags().Int("min-score", audit.DefaultMinScore, "Report passwords scoring below this, from 1 to 4")
+	cmd.Flags().Int("max-age-days", audit.DefaultMaxAgeDays, "Report passwords not changed for this many days; 0 skips the check")
+	cmd.Flags().Bool("json", false, "Print the report as JSON")
+	return cmd
+}
+
+// auditLocally fetches the sealed passwords of a vault account at once, decrypts them and audits them on the client.
+func auditLocally(cmd *cobra.Command, client *proto.GothKeeperClient, v *vault.Vault, policy audit.Policy) (*audit.Report, error) {
+	ctx := cmd.Context()
+	newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+	result, err := client.Passwords.AuditEntries(newCtx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]audit.Entry, 0, len(result.Items))
+	for _, item := range result.Items {
+		password, err := v.OpenString(item.Id, "password.password", item.Password)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, audit.Entry{
+			Title:     item.Title,
+			Password:  password,
+			UpdatedAt: item.UpdatedAt.AsTime(),
+		})
+	}
+
+	return audit.Run(entries, policy, time.Now())
+}
+
+// newAuditReport converts the audit report of the server into the one printed for vault accounts as well.
+func newAuditReport(in *pb.AuditResponse) *audit.Report {
+	report := &audit.Report{
+		Policy:   audit.Policy{MinScore: int(in.MinScore), MaxAgeDays: int(in.MaxAgeDays)},
+		Total:    int(in.Total),
+		Weak:     make([]audit.Weak, 0, len(in.Weak)),
+		Reused:   make([]audit.Reuse, 0, len(in.Reused)),
+		Stale:    make([]audit.Stale, 0, len(in.Stale)),
+		Breached: make([]audit.Breach, 0, len(in.Breached)),
+	}
+	for _, w := range in.Weak {
+		report.Weak = append(report.Weak, audit.Weak{
+			Title: w.Title,
+			Strength: audit.Strength{
+				Score:        int(w.Strength.GetScore()),
+				GuessesLog10: w.Strength.GetGuessesLog10(),
+				Warning:      w.Strength.GetWarning(),
+			},
+		})
+	}
+	for _, r := range in.Reused {
+		report.Reused = append(report.Reused, audit.Reuse{Titles: r.Titles})
+	}
+	for _, s := range in.Stale {
+		report.Stale = append(report.Stale, audit.Stale{
+			Title:     s.Title,
+			UpdatedAt: s.UpdatedAt.AsTime(),
+			AgeDays:   int(s.AgeDays),
+		})
+	}
+	for _, b := range in.Breached {
+		report.Breached = append(report.Breached, audit.Breach{Title: b.Title, Count: b.Count})
+	}
+	return report
+}
+
+// printAuditReport outputs an audit report to the standard output as tables of weak, reused, stale and breached passwords.
+func printAuditReport(cmd *cobra.Command, report *audit.Report) {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "Audited %d passwords: %d weak, %d reused, %d stale, %d breached\n",
+		report.Total, len(report.Weak), len(report.Reused), len(report.Stale), len(report.Breached))
+
+	if len(report.Weak) > 0 {
+		fmt.Fprintf(w, "\nWeak passwords, scoring below %d of %d:\n", report.Policy.MinScore, audit.MaxScore)
+		fmt.Fprintln(w, "TITLE\tSCORE\tGUESSES\tWARNING")
+		for _, weak := range report.Weak {
+			fmt.Fprintf(w, "%s\t%d\t10^%.1f\t%s\n", weak.Title, weak.Strength.Score, weak.Strength.GuessesLog10, weak.Strength.Warning)
+		}
+	}
+
+	if len(report.Reused) > 0 {
+		fmt.Fprintln(w, "\nReused passwords, each shared by:")
+		for _, reuse := range report.Reused {
+			fmt.Fprintln(w, strings.Join(reuse.Titles, ", "))
+		}
+	}
+
+	if len(report.Stale) > 0 {
+		fmt.Fprintf(w, "\nStale passwords, not changed for %d days:\n", report.Policy.MaxAgeDays)
+		fmt.Fprintln(w, "TITLE\tUPDATED\tAGE")
+		for _, stale := range report.Stale {
+			fmt.Fprintf(w, "%s\t%s\t%d days\n", stale.Title, stale.UpdatedAt.Local().Format(time.DateTime), stale.AgeDays)
+		}
+	}
+
+	if len(report.Breached) > 0 {
+		fmt.Fprintln(w, "\nBreached passwords, seen in known data breaches:")
+		fmt.Fprintln(w, "TITLE\tSEEN")
+		for _, breach := range report.Breached {
+			fmt.Fprintf(w, "%s\t%d times\n", breach.Title, breach.Count)
+		}
+	}
+}
@@ -1,15 +1,29 @@
 package cli
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/metadata"
+	"hash"
+	"io"
 	"main/internal/client/app/proto"
+	"main/internal/client/vault"
 	pb "main/proto"
+	"os"
 )
 
+// transferChunkSize is the amount of content read from a file per upload message.
+const transferChunkSize = 256 * 1024
+
+// errChecksumMismatch is reported when downloaded content does not match the checksum sent by the server.
+var errChecksumMismatch = errors.New("downloaded content does not match its checksum")
+
 // SetupBinaryCommand initializes the main binary processing commands.
 // This function creates a parent command that groups all operations dealing with binary data management.
-// It adds four child commands: adding, getting, updating, and removing binaries.
+// It adds child commands for adding, getting, updating, removing, and listing binaries,
+// as well as for streaming files of any size to and from the server.
 //
 // The function does not return an error since it's only setting up the structure.
 func SetupBinaryCommand(client *proto.GothKeeperClient) *cobra.Command {
@@ -17,12 +31,17 @@ func SetupBinaryCommand(client *proto.GothKeeperClient) *cobra.Command {
 		Use:   "binary",
 		Short: "Processing of binary data",
 		Long: `Processing of binary data. 
-		Includes methods for saving, retrieving, modifying, and deleting.`,
+		Includes methods for saving, retrieving, modifying, deleting, and listing.`,
 	}
 	cmd.AddCommand(addBinary(client))
 	cmd.AddCommand(getBinary(client))
 	cmd.AddCommand(updateBinary(client))
 	cmd.AddCommand(removeBinary(client))
+	cmd.AddCommand(listBinaries(client))
+	cmd.AddCommand(historyBinary(client))
+	cmd.AddCommand(restoreBinary(client))
+	cmd.AddCommand(uploadBinary(client))
+	cmd.AddCommand(downloadBinary(client))
 	return cmd
 }
 
@@ -45,11 +64,24 @@ func addBinary(client *proto.GothKeeperClient) *cobra.Command {
 				cmd.PrintErr(err)
 			}
 
+			placement, err := newPlacement(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
 			cond := pb.BinariesCreateRequest{
-				Title: title,
-				Data:  binary,
+				Title:     title,
+				Data:      binary,
+				Placement: placement,
 			}
 
+			id, ok := recordID(cmd, client, pb.ItemKind_ITEM_KIND_BINARY, title, true)
+			if !ok || !sealBytes(cmd, client, id, "binary.data", &cond.Data) {
+				return
+			}
+			cond.Id = id
+
 			ctx := cmd.Context()
 			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
 
@@ -63,6 +95,7 @@ func addBinary(client *proto.GothKeeperClient) *cobra.Command {
 	}
 	cmd.Flags().StringP("title", "t", "", "Record title")
 	cmd.Flags().StringP("binary", "b", "", "Binary data")
+	addPlacementFlags(cmd, false)
 	err := cmd.MarkFlagRequired("title")
 	if err != nil {
 		cmd.PrintErr(err)
@@ -98,9 +131,11 @@ func getBinary(client *proto.GothKeeperClient) *cobra.Command {
 			result, err := client.Binaries.Get(newCtx, &cond)
 			if err != nil {
 				dispatchErrors(cmd, err)
-			} else {
+			} else if openBytes(cmd, client, result.Id, "binary.data", &result.Data) {
 				cmd.Print("Get object with title: ", result.Title)
 				cmd.Print("Binary data: ", result.Data)
+				printPlacement(cmd, result.Placement)
+				printStats(cmd, result)
 			}
 		},
 	}
@@ -114,6 +149,8 @@ func getBinary(client *proto.GothKeeperClient) *cobra.Command {
 
 // updateBinary updates an existing binary record using its title and updated binary content.
 // Possible errors arise from either unauthenticated requests (`Unauthenticated`) or trying to modify a nonexistent record (`NotFound`).
+// With --new-title the record is renamed as well, keeping its ID and history. Given only --new-title, --folder,
+// --tag or --clear-tags, the record is renamed, moved and retagged without being rewritten.
 // A successful operation results in printing the updated record's title.
 func updateBinary(client *proto.GothKeeperClient) *cobra.Command {
 	cmd := &cobra.Command{
@@ -125,37 +162,58 @@ func updateBinary(client *proto.GothKeeperClient) *cobra.Command {
 			if err != nil {
 				cmd.PrintErr(err)
 			}
+			if metadataOnly(cmd, "binary") {
+				updateMetadata(cmd, client, pb.ItemKind_ITEM_KIND_BINARY, title, client.Binaries.Rename)
+				return
+			}
+			if !cmd.Flags().Changed("binary") {
+				cmd.PrintErr("--binary is required unless only the title or placement is changed")
+				return
+			}
 			binary, err := cmd.Flags().GetBytesHex("binary")
 			if err != nil {
 				cmd.PrintErr(err)
 			}
 
+			placement, replaceFolder, replaceTags, err := newPlacementUpdate(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
 			cond := pb.BinariesUpdateRequest{
-				Title: title,
-				Data:  binary,
+				Title:         title,
+				Data:          binary,
+				Placement:     placement,
+				ReplaceFolder: replaceFolder,
+				ReplaceTags:   replaceTags,
 			}
 
+			id, ok := recordID(cmd, client, pb.ItemKind_ITEM_KIND_BINARY, title, false)
+			if !ok || !sealBytes(cmd, client, id, "binary.data", &cond.Data) {
+				return
+			}
+			cond.Id = id
+
 			ctx := cmd.Context()
 			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
 
 			result, err := client.Binaries.Update(newCtx, &cond)
 			if err != nil {
 				dispatchErrors(cmd, err)
-			} else {
-				cmd.Print("Update object with title: ", result.Title)
+			} else if current, ok := renameRecord(cmd, client, result.Title, client.Binaries.Rename); ok {
+				cmd.Print("Update object with title: ", current)
 			}
 		},
 	}
 	cmd.Flags().StringP("title", "t", "", "Record title")
 	cmd.Flags().StringP("binary", "b", "", "Binary data")
+	addPlacementFlags(cmd, true)
+	addRenameFlag(cmd)
 	err := cmd.MarkFlagRequired("title")
 	if err != nil {
 		cmd.PrintErr(err)
 	}
-	err = cmd.MarkFlagRequired("binary")
-	if err != nil {
-		cmd.PrintErr(err)
-	}
 	return cmd
 }
 
@@ -195,3 +253,381 @@ func removeBinary(client *proto.GothKeeperClient) *cobra.Command {
 	}
 	return cmd
 }
+
+// listBinaries prints the titles of stored binary data records page by page.
+// Records can be filtered by a title prefix or by how long they went unchanged or unread, and sorted
+// by title or by creation, update or access time; the cursor printed after a full page is passed back
+// with --cursor to fetch the next one.
+// Possible errors include an invalid cursor (`InvalidArgument`) or an invalid token (`Unauthenticated`).
+func listBinaries(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List binary data",
+		Long:  `List titles of stored binary data.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cond, err := newListRequest(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Binaries.List(newCtx, cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				for _, item := range result.Items {
+					printListItem(cmd, item)
+				}
+				printNextCursor(cmd, result.NextCursor)
+			}
+		},
+	}
+	addListFlags(cmd)
+	return cmd
+}
+
+// uploadBinary streams a file to the server in chunks, so files of any size can be stored.
+// The SHA-256 checksum of the transmitted content is sent last and checked by the server before the record is saved.
+// For vault accounts the file is encrypted segment by segment before it leaves the client.
+// Potential errors include duplicate titles (`AlreadyExists`), a checksum mismatch (`DataLoss`) or an invalid token (`Unauthenticated`).
+func uploadBinary(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upload",
+		Short: "Upload a file as binary data",
+		Long:  `Upload a file as binary data, streaming it to the server in chunks.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			path, err := cmd.Flags().GetString("file")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			placement, err := newPlacement(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			file, err := os.Open(path)
+			if err != nil {
+				cmd.PrintErrf("Error: %v", err)
+				return
+			}
+			defer file.Close()
+
+			v, err := unlockVault(cmd, client)
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+			id, ok := recordID(cmd, client, pb.ItemKind_ITEM_KIND_BINARY, title, true)
+			if !ok {
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			stream, err := client.Binaries.Upload(newCtx)
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+
+			err = stream.Send(&pb.BinaryUploadRequest{
+				Payload: &pb.BinaryUploadRequest_Info{
+					Info: &pb.BinaryUploadInfo{Title: title, Placement: placement, Id: id},
+				},
+			})
+			if err == nil {
+				sender := &chunkSender{stream: stream, hash: sha256.New()}
+				if err = sendBinary(sender, file, id, v); err == nil {
+					err = stream.Send(&pb.BinaryUploadRequest{
+						Payload: &pb.BinaryUploadRequest_Sha256{
+							Sha256: sender.hash.Sum(nil),
+						},
+					})
+				}
+			}
+			// A failed Send reports io.EOF; the actual error is returned by CloseAndRecv.
+			if err != nil && !errors.Is(err, io.EOF) {
+				cmd.PrintErrf("Error: %v", err)
+				return
+			}
+
+			result, err := stream.CloseAndRecv()
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Save object with title: ", result.Title)
+			}
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	cmd.Flags().StringP("file", "f", "", "Path of the file to upload")
+	addPlacementFlags(cmd, false)
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	err = cmd.MarkFlagRequired("file")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// downloadBinary streams binary data from the server into a file.
+// The received content is checked against the SHA-256 checksum sent by the server; on a mismatch
+// the output file is removed. For vault accounts the content is decrypted as it arrives.
+// Possible errors include a missing record (`NotFound`) or an invalid token (`Unauthenticated`).
+func downloadBinary(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "download",
+		Short: "Download binary data into a file",
+		Long:  `Download binary data into a file, streaming it from the server in chunks.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			out, err := cmd.Flags().GetString("out")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			v, err := unlockVault(cmd, client)
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			stream, err := client.Binaries.Download(newCtx, &pb.BinariesRequest{Title: title})
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+			first, err := stream.Recv()
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+			info := first.GetInfo()
+			if info == nil {
+				cmd.PrintErr("Error: download did not start with the binary info")
+				return
+			}
+
+			file, err := os.Create(out)
+			if err != nil {
+				cmd.PrintErrf("Error: %v", err)
+				return
+			}
+
+			err = receiveBinary(file, stream, info, v)
+			if cerr := file.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				_ = os.Remove(out)
+				dispatchErrors(cmd, err)
+				return
+			}
+			cmd.Print("Download object with title: ", info.Title, " to ", out)
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	cmd.Flags().StringP("out", "o", "", "Path of the file to write")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	err = cmd.MarkFlagRequired("out")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// sendBinary writes the content of r to an upload of the record with the given ID,
+// sealing it segment by segment first if v is not nil.
+func sendBinary(w io.Writer, r io.Reader, id int64, v *vault.Vault) error {
+	if v == nil {
+		_, err := io.CopyBuffer(w, r, make([]byte, transferChunkSize))
+		return err
+	}
+
+	sw, err := v.SealStream(id, "binary.data", w)
+	if err != nil {
+		return err
+	}
+	if _, err := io.CopyBuffer(sw, r, make([]byte, transferChunkSize)); err != nil {
+		return err
+	}
+	return sw.Close()
+}
+
+// receiveBinary writes the content of a download into w and verifies its size and checksum.
+// The checksum covers the content as stored, so for vault accounts it is computed before decryption.
+// Binaries stored in one piece are decrypted as a whole, streamed ones segment by segment.
+func receiveBinary(w io.Writer, stream pb.Binaries_DownloadClient, info *pb.BinaryDownloadInfo, v *vault.Vault) error {
+	h := sha256.New()
+	r := &countingReader{r: io.TeeReader(&chunkReceiver{stream: stream}, h)}
+
+	var err error
+	switch {
+	case v == nil:
+		_, err = io.Copy(w, r)
+	case info.Streamed:
+		var sr io.Reader
+		if sr, err = v.OpenStream(info.Id, "binary.data", r); err == nil {
+			_, err = io.Copy(w, sr)
+		}
+	default:
+		var data []byte
+		if data, err = io.ReadAll(r); err == nil {
+			if data, err = v.Open(info.Id, "binary.data", data); err == nil {
+				_, err = w.Write(data)
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	// Drain the rest of the stream so the checksum covers everything the server sent.
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		return err
+	}
+	if r.n != info.Size || !bytes.Equal(h.Sum(nil), info.Sha256) {
+		return errChecksumMismatch
+	}
+	return nil
+}
+
+// chunkSender sends everything written to it as upload chunks, hashing it on the way.
+type chunkSender struct {
+	stream pb.Binaries_UploadClient // Upload the chunks are sent on.
+	hash   hash.Hash                // SHA-256 of the content sent so far.
+}
+
+// Write sends p as the next chunk.
+func (c *chunkSender) Write(p []byte) (int, error) {
+	err := c.stream.Send(&pb.BinaryUploadRequest{
+		Payload: &pb.BinaryUploadRequest_Chunk{Chunk: p},
+	})
+	if err != nil {
+		return 0, err
+	}
+	c.hash.Write(p)
+	return len(p), nil
+}
+
+// chunkReceiver reads the chunks of a download as one continuous stream.
+type chunkReceiver struct {
+	stream pb.Binaries_DownloadClient // Download the chunks are received from.
+	buf    []byte                     // Unread rest of the current chunk.
+}
+
+// Read copies the next bytes of the download into p; it returns io.EOF once the server ends the stream.
+func (c *chunkReceiver) Read(p []byte) (int, error) {
+	for len(c.buf) == 0 {
+		in, err := c.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		c.buf = in.GetChunk()
+	}
+
+	n := copy(p, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader // Underlying reader.
+	n int64     // Number of bytes read so far.
+}
+
+// Read reads from the underlying reader and counts the bytes.
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// historyBinary prints the archived revisions of a binary file, newest first.
+// Every update and delete archives the previous version; revisions of a deleted binary file are listed as well.
+// Possible errors include an invalid token (`Unauthenticated`).
+func historyBinary(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "List previous versions of a binary file",
+		Long:  `List previous versions of a binary file kept by the server.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			cond := pb.HistoryRequest{
+				Title: title,
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Binaries.History(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				printHistory(cmd, result)
+			}
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// restoreBinary brings back a previous version of a binary file; the current one is archived in turn.
+// A deleted binary file is added again.
+// Possible errors include a missing or pruned revision (`NotFound`) or an invalid token (`Unauthenticated`).
+func restoreBinary(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore a previous version of a binary file",
+		Long:  `Restore a previous version of a binary file, as listed by the history command.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cond, err := newRestoreRequest(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Binaries.Restore(newCtx, cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Printf("Restore object with title: %s, revision %d", result.Title, cond.Revision)
+			}
+		},
+	}
+	addRestoreFlags(cmd)
+	return cmd
+}
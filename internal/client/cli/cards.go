@@ -3,6 +3,7 @@ package cli
 import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/metadata"
+	"main/internal/card"
 	"main/internal/client/app/proto"
 	pb "main/proto"
 )
@@ -15,19 +16,25 @@ func SetupCardCommand(client *proto.GothKeeperClient) *cobra.Command {
 		Use:   "card",
 		Short: "Processing of bank card data",
 		Long: `Processing of bank card data. 
-		Includes methods for saving, retrieving, modifying, and deleting.`,
+		Includes methods for saving, retrieving, revealing, modifying, deleting, and listing.`,
 	}
 	cmd.AddCommand(addCard(client))
 	cmd.AddCommand(getCard(client))
+	cmd.AddCommand(revealCard(client))
 	cmd.AddCommand(updateCard(client))
 	cmd.AddCommand(removeCard(client))
+	cmd.AddCommand(listCards(client))
+	cmd.AddCommand(historyCard(client))
+	cmd.AddCommand(restoreCard(client))
 	return cmd
 }
 
 // addCard manages the addition of a new bank card record.
 // It requires multiple parameters such as title, bank name, card number, expiration date, and security code.
-// The process involves sending these details to the backend via gRPC.
-// Potential errors include duplicated card records (`AlreadyExists`) and invalid/unauthorized tokens (`Unauthenticated`).
+// The process involves sending these details to the backend via gRPC, which checks the number against the Luhn
+// algorithm, the expiry date and the security code length of the detected brand; vault accounts check them locally.
+// Potential errors include duplicated card records (`AlreadyExists`), malformed card data (`InvalidArgument`)
+// and invalid/unauthorized tokens (`Unauthenticated`).
 func addCard(client *proto.GothKeeperClient) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add",
@@ -55,14 +62,35 @@ func addCard(client *proto.GothKeeperClient) *cobra.Command {
 				cmd.PrintErr(err)
 			}
 
+			placement, err := newPlacement(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
 			cond := pb.CardCreateRequest{
 				Title:      title,
 				Bank:       bank,
 				Number:     number,
 				DataEnd:    dataEnd,
 				SecretCode: secretCode,
+				Placement:  placement,
 			}
 
+			if !validateCard(cmd, client, &cond.Number, &cond.DataEnd, &cond.SecretCode, &cond.Brand) {
+				return
+			}
+			id, ok := recordID(cmd, client, pb.ItemKind_ITEM_KIND_CARD, title, true)
+			if !ok || !sealText(cmd, client, id, map[string]*string{
+				"card.bank":       &cond.Bank,
+				"card.number":     &cond.Number,
+				"card.dataEnd":    &cond.DataEnd,
+				"card.secretCode": &cond.SecretCode,
+			}) {
+				return
+			}
+			cond.Id = id
+
 			ctx := cmd.Context()
 			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
 
@@ -79,6 +107,7 @@ func addCard(client *proto.GothKeeperClient) *cobra.Command {
 	cmd.Flags().StringP("number", "n", "", "Card number")
 	cmd.Flags().StringP("dataEnd", "d", "", "Date end")
 	cmd.Flags().StringP("secretCode", "s", "", "Secret code")
+	addPlacementFlags(cmd, false)
 	err := cmd.MarkFlagRequired("title")
 	if err != nil {
 		cmd.PrintErr(err)
@@ -103,7 +132,8 @@ func addCard(client *proto.GothKeeperClient) *cobra.Command {
 }
 
 // getCard retrieves details about a bank card given its title.
-// It communicates with the gRPC server to fetch the requested card’s attributes.
+// It communicates with the gRPC server to fetch the requested card’s attributes. The number is masked down to its
+// last four digits and the security code left out; the reveal command prints them in full.
 // Potential issues include an invalid token (`Unauthenticated`) or a missing card record (`NotFound`).
 func getCard(client *proto.GothKeeperClient) *cobra.Command {
 	cmd := &cobra.Command{
@@ -126,12 +156,60 @@ func getCard(client *proto.GothKeeperClient) *cobra.Command {
 			result, err := client.Cards.Get(newCtx, &cond)
 			if err != nil {
 				dispatchErrors(cmd, err)
-			} else {
+			} else if openText(cmd, client, result.Id, map[string]*string{
+				"card.bank":    &result.Bank,
+				"card.number":  &result.Number,
+				"card.dataEnd": &result.DataEnd,
+			}) {
+				// The server cannot mask the sealed numbers of vault accounts, unlocked by openText.
+				if client.Vault != nil {
+					result.Number = card.Mask(result.Number)
+				}
 				cmd.Print("Get object with title: ", result.Title)
-				cmd.Print("Bank: ", result.Bank)
-				cmd.Print("Card number: ", result.Number)
-				cmd.Print("Date end: ", result.DataEnd)
-				cmd.Print("Secret code: ", result.SecretCode)
+				printCard(cmd, result)
+			}
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// revealCard retrieves a bank card given its title with its full number and security code.
+// Every reveal is recorded by the server and counted on the card.
+// Potential issues include an invalid token (`Unauthenticated`) or a missing card record (`NotFound`).
+func revealCard(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal",
+		Short: "Reveal the full number and secret code of a bank card",
+		Long:  `Reveal the full number and secret code of a bank card. Every reveal is recorded.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			cond := pb.CardRequest{
+				Title: title,
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Cards.Reveal(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else if openText(cmd, client, result.Id, map[string]*string{
+				"card.bank":       &result.Bank,
+				"card.number":     &result.Number,
+				"card.dataEnd":    &result.DataEnd,
+				"card.secretCode": &result.SecretCode,
+			}) {
+				cmd.Print("Reveal object with title: ", result.Title)
+				printCard(cmd, result)
 			}
 		},
 	}
@@ -145,7 +223,11 @@ func getCard(client *proto.GothKeeperClient) *cobra.Command {
 
 // updateCard modifies an existing bank card record by its title.
 // It expects several inputs (like bank name, card number, expiration date, and security code), which are then sent to the gRPC server.
-// Common errors include a non-existent card (`NotFound`) or failed authentication (`Unauthenticated`).
+// The card is checked like a new one, see addCard.
+// With --new-title the record is renamed as well, keeping its ID and history. Given only --new-title, --folder,
+// --tag or --clear-tags, the record is renamed, moved and retagged without being rewritten.
+// Common errors include a non-existent card (`NotFound`), malformed card data (`InvalidArgument`)
+// or failed authentication (`Unauthenticated`).
 func updateCard(client *proto.GothKeeperClient) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update",
@@ -156,6 +238,10 @@ func updateCard(client *proto.GothKeeperClient) *cobra.Command {
 			if err != nil {
 				cmd.PrintErr(err)
 			}
+			if metadataOnly(cmd, "bank", "number", "dataEnd", "secretCode") {
+				updateMetadata(cmd, client, pb.ItemKind_ITEM_KIND_CARD, title, client.Cards.Rename)
+				return
+			}
 			bank, err := cmd.Flags().GetString("bank")
 			if err != nil {
 				cmd.PrintErr(err)
@@ -173,22 +259,45 @@ func updateCard(client *proto.GothKeeperClient) *cobra.Command {
 				cmd.PrintErr(err)
 			}
 
+			placement, replaceFolder, replaceTags, err := newPlacementUpdate(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
 			cond := pb.CardUpdateRequest{
-				Title:      title,
-				Bank:       bank,
-				Number:     number,
-				DataEnd:    dataEnd,
-				SecretCode: secretCode,
+				Title:         title,
+				Bank:          bank,
+				Number:        number,
+				DataEnd:       dataEnd,
+				SecretCode:    secretCode,
+				Placement:     placement,
+				ReplaceFolder: replaceFolder,
+				ReplaceTags:   replaceTags,
 			}
 
+			if !validateCard(cmd, client, &cond.Number, &cond.DataEnd, &cond.SecretCode, &cond.Brand) {
+				return
+			}
+			id, ok := recordID(cmd, client, pb.ItemKind_ITEM_KIND_CARD, title, false)
+			if !ok || !sealText(cmd, client, id, map[string]*string{
+				"card.bank":       &cond.Bank,
+				"card.number":     &cond.Number,
+				"card.dataEnd":    &cond.DataEnd,
+				"card.secretCode": &cond.SecretCode,
+			}) {
+				return
+			}
+			cond.Id = id
+
 			ctx := cmd.Context()
 			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
 
 			result, err := client.Cards.Update(newCtx, &cond)
 			if err != nil {
 				dispatchErrors(cmd, err)
-			} else {
-				cmd.Print("Update object with title: ", result.Title)
+			} else if current, ok := renameRecord(cmd, client, result.Title, client.Cards.Rename); ok {
+				cmd.Print("Update object with title: ", current)
 			}
 		},
 	}
@@ -197,6 +306,8 @@ func updateCard(client *proto.GothKeeperClient) *cobra.Command {
 	cmd.Flags().StringP("number", "n", "", "Card number")
 	cmd.Flags().StringP("dataEnd", "d", "", "Date end")
 	cmd.Flags().StringP("secretCode", "s", "", "Secret code")
+	addPlacementFlags(cmd, true)
+	addRenameFlag(cmd)
 	err := cmd.MarkFlagRequired("title")
 	if err != nil {
 		cmd.PrintErr(err)
@@ -240,3 +351,145 @@ func removeCard(client *proto.GothKeeperClient) *cobra.Command {
 	}
 	return cmd
 }
+
+// listCards prints the titles and brands of stored bank card records page by page.
+// Records can be filtered by a title prefix or by how long they went unchanged or unread, and sorted
+// by title or by creation, update or access time; the cursor printed after a full page is passed back
+// with --cursor to fetch the next one.
+// Possible errors include an invalid cursor (`InvalidArgument`) or an invalid token (`Unauthenticated`).
+func listCards(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List bank card data",
+		Long:  `List titles of stored bank card data.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cond, err := newListRequest(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Cards.List(newCtx, cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				for _, item := range result.Items {
+					printLabeledItem(cmd, item, item.Brand)
+				}
+				printNextCursor(cmd, result.NextCursor)
+			}
+		},
+	}
+	addListFlags(cmd)
+	return cmd
+}
+
+// historyCard prints the archived revisions of a card, newest first.
+// Every update and delete archives the previous version; revisions of a deleted card are listed as well.
+// Possible errors include an invalid token (`Unauthenticated`).
+func historyCard(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "List previous versions of a card",
+		Long:  `List previous versions of a card kept by the server.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			cond := pb.HistoryRequest{
+				Title: title,
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Cards.History(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				printHistory(cmd, result)
+			}
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// restoreCard brings back a previous version of a card; the current one is archived in turn.
+// A deleted card is added again.
+// Possible errors include a missing or pruned revision (`NotFound`) or an invalid token (`Unauthenticated`).
+func restoreCard(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore a previous version of a card",
+		Long:  `Restore a previous version of a card, as listed by the history command.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cond, err := newRestoreRequest(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Cards.Restore(newCtx, cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Printf("Restore object with title: %s, revision %d", result.Title, cond.Revision)
+			}
+		},
+	}
+	addRestoreFlags(cmd)
+	return cmd
+}
+
+// validateCard checks the number, expiry date and security code of a card for a vault account, normalizing them and
+// setting its brand, since the server only sees them sealed. The server validates the cards of regular accounts.
+// Errors are reported to the user; false means the command must stop.
+func validateCard(cmd *cobra.Command, client *proto.GothKeeperClient, number, dataEnd, secretCode, brand *string) bool {
+	v, err := unlockVault(cmd, client)
+	if err != nil {
+		dispatchErrors(cmd, err)
+		return false
+	}
+	if v == nil {
+		return true
+	}
+
+	result, err := card.Validate(*number, *dataEnd, *secretCode)
+	if err != nil {
+		cmd.PrintErr(err)
+		return false
+	}
+	*number, *dataEnd, *secretCode, *brand = result.Number, result.Expiry.String(), result.CVV, result.Brand
+	return true
+}
+
+// printCard outputs the details of a card, its brand if it was detected, its security code if it was revealed,
+// and how often it was revealed.
+func printCard(cmd *cobra.Command, result *pb.CardResponse) {
+	cmd.Print("Bank: ", result.Bank)
+	if result.Brand != "" {
+		cmd.Print("Brand: ", result.Brand)
+	}
+	cmd.Print("Card number: ", result.Number)
+	cmd.Print("Date end: ", result.DataEnd)
+	if result.SecretCode != "" {
+		cmd.Print("Secret code: ", result.SecretCode)
+	}
+	printPlacement(cmd, result.Placement)
+	printStats(cmd, result)
+	cmd.Printf("\nLast revealed: %s", formatTime(result.LastRevealedAt))
+	cmd.Printf("\nReveal count: %d", result.RevealCount)
+}
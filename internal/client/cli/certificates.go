@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"main/internal/client/app/proto"
+	pb "main/proto"
+	"time"
+)
+
+// certificateCommand sets up the 'cert' command group binding client certificates to the user.
+func certificateCommand(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cert",
+		Short: "Client certificate authentication",
+		Long: `The section contains methods for binding the client certificate
+		of a mutual TLS connection to the user, so it authenticates calls without a token`,
+	}
+	cmd.AddCommand(bindCertificate(client))
+	cmd.AddCommand(unbindCertificate(client))
+	cmd.AddCommand(listCertificates(client))
+	return cmd
+}
+
+// bindCertificate creates a command binding the configured client certificate to the logged in user.
+// The binding opens a session of its own, which can be revoked like any other session.
+// Possible errors include a connection without a verified certificate, a missing or wrong two-factor code
+// (`InvalidArgument`) or a certificate bound already (`AlreadyExists`).
+func bindCertificate(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bind",
+		Short: "Bind the client certificate",
+		Long: `Bind the client certificate of the connection to the logged in user.
+Users with two-factor authentication have to pass a code.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			code, err := cmd.Flags().GetString("code")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Users.BindCertificate(newCtx, &pb.CertificateBindRequest{Code: code})
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Printf("Certificate '%s' bound under session %d", result.Subject, result.SessionId)
+			}
+		},
+	}
+	cmd.Flags().StringP("code", "c", "", "Code from the authenticator app or a recovery code")
+	return cmd
+}
+
+// unbindCertificate creates a command removing the binding of a client certificate and revoking its session.
+// Without --subject the configured client certificate is unbound, so a lost one can be unbound from another device.
+func unbindCertificate(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbind",
+		Short: "Unbind a client certificate",
+		Long:  `Remove the binding of a client certificate by subject, or of the client certificate of the connection.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			subject, err := cmd.Flags().GetString("subject")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Users.UnbindCertificate(newCtx, &pb.CertificateRequest{Subject: subject})
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Printf("Certificate '%s' unbound", result.Subject)
+			}
+		},
+	}
+	cmd.Flags().StringP("subject", "s", "", "Subject of the certificate, as printed by the list command")
+	return cmd
+}
+
+// listCertificates creates a command printing the client certificates bound to the user.
+func listCertificates(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List bound client certificates",
+		Long:  `List the client certificates bound to the user together with the sessions backing them.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Users.ListCertificates(newCtx, &emptypb.Empty{})
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+			for _, c := range result.Items {
+				cmd.Printf("%s\tsession %d\tbound %s\texpires %s\n", c.Subject, c.SessionId,
+					c.CreatedAt.AsTime().Local().Format(time.DateTime), c.ExpiresAt.AsTime().Local().Format(time.DateTime))
+			}
+		},
+	}
+	return cmd
+}
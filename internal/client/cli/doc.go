@@ -1,3 +1,3 @@
 // Package cli implements the command-line interface for the GophKeeper application.
-// It provides a set of commands for user authentication, password management, binary data management, and bank card data management.
+// It provides a set of commands for user authentication, password management, binary data management, bank card data management, secure notes, authenticator secrets, SSH keys served through a built-in ssh-agent, and the folders and tags records are organised with.
 package cli
@@ -0,0 +1,262 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"main/internal/client/app/proto"
+	pb "main/proto"
+	"strings"
+)
+
+// SetupFolderCommand configures the top-level command for managing the folder tree.
+// Records of every kind can be placed in folders with the --folder flag of their add and update commands.
+// No error handling needed at this level as it merely organizes sub-commands.
+func SetupFolderCommand(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "folder",
+		Short: "Processing of folders",
+		Long: `Create, move, rename and remove the folders records are placed in.
+		Folders are addressed by slash-separated paths, such as work/prod.`,
+	}
+	cmd.AddCommand(createFolder(client))
+	cmd.AddCommand(moveFolder(client))
+	cmd.AddCommand(removeFolder(client))
+	cmd.AddCommand(listFolders(client))
+	return cmd
+}
+
+// createFolder creates a folder along with its missing parents.
+// Possible errors include an existing folder (`AlreadyExists`), a malformed path (`InvalidArgument`)
+// or an invalid token (`Unauthenticated`).
+func createFolder(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a folder",
+		Long:  `Create a folder; missing parent folders are created as well.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			path, err := cmd.Flags().GetString("path")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			_, err = client.Folders.Create(newCtx, &pb.FolderRequest{Path: path})
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Successfully created: ", path)
+			}
+		},
+	}
+	cmd.Flags().String("path", "", "Folder path, such as work/prod")
+	err := cmd.MarkFlagRequired("path")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// moveFolder places a folder under another one, or at the root, optionally renaming it.
+// The records and subfolders of the folder move along with it.
+// Possible errors include a missing folder (`NotFound`), a name taken at the destination (`AlreadyExists`),
+// a move into the folder's own subtree (`InvalidArgument`) or an invalid token (`Unauthenticated`).
+func moveFolder(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "move",
+		Short: "Move or rename a folder",
+		Long: `Move a folder under another one, or to the root if --to is empty, and optionally rename it.
+		Records and subfolders move along with the folder.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			path, err := cmd.Flags().GetString("path")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			parent, err := cmd.Flags().GetString("to")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			name, err := cmd.Flags().GetString("name")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			cond := pb.FolderMoveRequest{
+				Path:   path,
+				Parent: parent,
+				Name:   name,
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			_, err = client.Folders.Move(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Successfully moved: ", path)
+			}
+		},
+	}
+	cmd.Flags().String("path", "", "Path of the folder to move")
+	cmd.Flags().String("to", "", "Path of the new parent folder; the root if empty")
+	cmd.Flags().String("name", "", "New name of the folder; kept if empty")
+	err := cmd.MarkFlagRequired("path")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// removeFolder removes an empty folder.
+// Possible errors include a missing folder (`NotFound`), a folder still holding subfolders or records
+// (`FailedPrecondition`) or an invalid token (`Unauthenticated`).
+func removeFolder(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove an empty folder",
+		Long:  `Remove a folder that holds neither subfolders nor records; deleted records in it are moved to the root.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			path, err := cmd.Flags().GetString("path")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			_, err = client.Folders.Remove(newCtx, &pb.FolderRequest{Path: path})
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Successfully removed: ", path)
+			}
+		},
+	}
+	cmd.Flags().String("path", "", "Path of the folder to remove")
+	err := cmd.MarkFlagRequired("path")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// listFolders prints the paths of all folders, one per line in alphabetical order.
+// Possible errors include an invalid token (`Unauthenticated`).
+func listFolders(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List folders",
+		Long:  `List the paths of all folders.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Folders.List(newCtx, &emptypb.Empty{})
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+			if len(result.Paths) == 0 {
+				cmd.Print("No folders")
+				return
+			}
+			for _, path := range result.Paths {
+				cmd.Println(path)
+			}
+		},
+	}
+	return cmd
+}
+
+// addPlacementFlags registers the flags placing a record in a folder and tagging it.
+// Update commands also get --clear-tags, as they keep the folder and tags unless asked otherwise.
+func addPlacementFlags(cmd *cobra.Command, update bool) {
+	cmd.Flags().String("folder", "", "Folder path of the record, such as work/prod; the root if empty")
+	cmd.Flags().StringSlice("tag", nil, "Tag of the record; repeatable or comma-separated")
+	if update {
+		cmd.Flags().Bool("clear-tags", false, "Remove all tags")
+		cmd.MarkFlagsMutuallyExclusive("tag", "clear-tags")
+	}
+}
+
+// newPlacement builds the placement of a new record from the flags registered by addPlacementFlags.
+func newPlacement(cmd *cobra.Command) (*pb.Placement, error) {
+	folder, err := cmd.Flags().GetString("folder")
+	if err != nil {
+		return nil, err
+	}
+	tags, err := cmd.Flags().GetStringSlice("tag")
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Placement{
+		Folder: folder,
+		Tags:   tags,
+	}, nil
+}
+
+// newPlacementUpdate builds the placement of an updated record from the flags registered by addPlacementFlags,
+// reporting which of the folder and the tags are to be replaced: only those given on the command line.
+func newPlacementUpdate(cmd *cobra.Command) (*pb.Placement, bool, bool, error) {
+	placement, err := newPlacement(cmd)
+	if err != nil {
+		return nil, false, false, err
+	}
+	clear, err := cmd.Flags().GetBool("clear-tags")
+	if err != nil {
+		return nil, false, false, err
+	}
+
+	replaceFolder := cmd.Flags().Changed("folder")
+	replaceTags := clear || cmd.Flags().Changed("tag")
+	return placement, replaceFolder, replaceTags, nil
+}
+
+// placementChanged reports whether any of the flags registered by addPlacementFlags was given.
+func placementChanged(cmd *cobra.Command) bool {
+	return cmd.Flags().Changed("folder") || cmd.Flags().Changed("tag") || cmd.Flags().Changed("clear-tags")
+}
+
+// placeRecord moves a record of the given kind to a folder and retags it as the placement flags ask,
+// leaving its content untouched. It reports false if the record could not be placed.
+// Possible errors include an absent record or folder (`NotFound`), a malformed folder or tag (`InvalidArgument`)
+// or an invalid token (`Unauthenticated`).
+func placeRecord(cmd *cobra.Command, client *proto.GothKeeperClient, kind pb.ItemKind, title string) bool {
+	placement, replaceFolder, replaceTags, err := newPlacementUpdate(cmd)
+	if err != nil {
+		cmd.PrintErr(err)
+		return false
+	}
+
+	cond := pb.PlaceRequest{
+		Kind:          kind,
+		Title:         title,
+		Placement:     placement,
+		ReplaceFolder: replaceFolder,
+		ReplaceTags:   replaceTags,
+	}
+
+	ctx := cmd.Context()
+	newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+	_, err = client.Folders.Place(newCtx, &cond)
+	if err != nil {
+		dispatchErrors(cmd, err)
+		return false
+	}
+	return true
+}
+
+// printPlacement outputs the folder and tags of a record, if it has any.
+func printPlacement(cmd *cobra.Command, placement *pb.Placement) {
+	if placement.GetFolder() != "" {
+		cmd.Printf("\nFolder: %s", placement.GetFolder())
+	}
+	if len(placement.GetTags()) > 0 {
+		cmd.Printf("\nTags: %s", strings.Join(placement.GetTags(), ", "))
+	}
+}
@@ -0,0 +1,189 @@
+package cli
+
+import (
+	"fmt"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+	"main/internal/client/app/proto"
+	"main/internal/passgen"
+	pb "main/proto"
+	"slices"
+	"strings"
+)
+
+// generatePassword prints a new password or diceware passphrase on the standard output and its strength
+// on the standard error, so the password alone can be piped. The server generates it for regular accounts;
+// for zero-knowledge vault accounts it is generated by the client and never leaves it.
+// Possible errors include an unknown preset or an out of range setting (`InvalidArgument`).
+func generatePassword(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate a password or passphrase",
+		Long: `Generate a random password or diceware passphrase without storing it.
+Presets: ` + strings.Join(presetNames(), ", ") + `; the other flags override the preset.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			req, err := readGenerate(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			v, err := unlockVault(cmd, client)
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+
+			var result *pb.GenerateResponse
+			if v != nil {
+				result, err = generateLocally(req)
+				if err != nil {
+					cmd.PrintErr(err)
+					return
+				}
+			} else {
+				ctx := cmd.Context()
+				newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+				result, err = client.Passwords.Generate(newCtx, req)
+				if err != nil {
+					dispatchErrors(cmd, err)
+					return
+				}
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), result.Password)
+			cmd.Printf("Entropy: %.0f bits\n", result.EntropyBits)
+		},
+	}
+	addGeneratorFlags(cmd)
+	return cmd
+}
+
+// addGenerateFlags registers --generate and the generator settings on a command writing a password entry.
+// --generate replaces --password, so exactly one of them must be given when required is set.
+func addGenerateFlags(cmd *cobra.Command, required bool) {
+	cmd.Flags().Bool("generate", false, "Generate the password and store it without printing it")
+	addGeneratorFlags(cmd)
+	cmd.MarkFlagsMutuallyExclusive("password", "generate")
+	if required {
+		cmd.MarkFlagsOneRequired("password", "generate")
+	}
+}
+
+// addGeneratorFlags registers the settings of the password generator.
+func addGeneratorFlags(cmd *cobra.Command) {
+	cmd.Flags().String("preset", passgen.DefaultPreset, "Generator preset: "+strings.Join(presetNames(), ", "))
+	cmd.Flags().Int("length", 0, fmt.Sprintf("Password length, %d to %d; the preset's if 0", passgen.MinLength, passgen.MaxLength))
+	cmd.Flags().StringSlice("classes", nil, "Character classes: "+strings.Join(passgen.Classes, ", ")+"; the preset's if empty")
+	cmd.Flags().Bool("exclude-ambiguous", false, "Leave out characters easily mistaken for one another, such as 0 and O")
+	cmd.Flags().Int("words", 0, fmt.Sprintf("Generate a diceware passphrase of this many words, %d to %d", passgen.MinWords, passgen.MaxWords))
+	cmd.Flags().String("separator", "", "Separator between the words of a passphrase; the preset's if empty")
+}
+
+// readGenerate builds the generator request from the flags registered by addGeneratorFlags.
+func readGenerate(cmd *cobra.Command) (*pb.GenerateRequest, error) {
+	preset, err := cmd.Flags().GetString("preset")
+	if err != nil {
+		return nil, err
+	}
+	length, err := cmd.Flags().GetInt("length")
+	if err != nil {
+		return nil, err
+	}
+	classes, err := cmd.Flags().GetStringSlice("classes")
+	if err != nil {
+		return nil, err
+	}
+	excludeAmbiguous, err := cmd.Flags().GetBool("exclude-ambiguous")
+	if err != nil {
+		return nil, err
+	}
+	words, err := cmd.Flags().GetInt("words")
+	if err != nil {
+		return nil, err
+	}
+	separator, err := cmd.Flags().GetString("separator")
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GenerateRequest{
+		Preset:           preset,
+		Length:           int32(length),
+		Classes:          classes,
+		ExcludeAmbiguous: excludeAmbiguous,
+		Words:            int32(words),
+		Separator:        separator,
+	}, nil
+}
+
+// generateInto applies --generate to a password entry being written. For vault accounts the password is
+// generated here into *password, to be sealed like a typed one; for other accounts the request asking the
+// server to generate and store it is returned. Nothing is returned without --generate, and the password
+// is never printed. Errors are reported to the user; false means the command must stop.
+func generateInto(cmd *cobra.Command, client *proto.GothKeeperClient, password *string) (*pb.GenerateRequest, bool) {
+	generate, err := cmd.Flags().GetBool("generate")
+	if err != nil {
+		cmd.PrintErr(err)
+		return nil, false
+	}
+	if !generate {
+		return nil, true
+	}
+
+	req, err := readGenerate(cmd)
+	if err != nil {
+		cmd.PrintErr(err)
+		return nil, false
+	}
+
+	v, err := unlockVault(cmd, client)
+	if err != nil {
+		dispatchErrors(cmd, err)
+		return nil, false
+	}
+	if v == nil {
+		return req, true
+	}
+
+	result, err := generateLocally(req)
+	if err != nil {
+		cmd.PrintErr(err)
+		return nil, false
+	}
+	*password = result.Password
+	return nil, true
+}
+
+// generateLocally generates a password on the client, resolving the request the way the server does.
+func generateLocally(req *pb.GenerateRequest) (*pb.GenerateResponse, error) {
+	policy, err := passgen.Resolve(req.Preset, passgen.Policy{
+		Length:           int(req.Length),
+		Classes:          req.Classes,
+		ExcludeAmbiguous: req.ExcludeAmbiguous,
+		Words:            int(req.Words),
+		Separator:        req.Separator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := passgen.Generate(policy)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GenerateResponse{Password: password, EntropyBits: policy.EntropyBits()}, nil
+}
+
+// presetNames lists the generator presets, the default one first and the others in alphabetical order.
+func presetNames() []string {
+	names := []string{passgen.DefaultPreset}
+	for name := range passgen.Presets {
+		if name != passgen.DefaultPreset {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names[1:])
+	return names
+}
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+	"main/internal/client/app/proto"
+	pb "main/proto"
+	"time"
+)
+
+// printHistory outputs archived revisions as revision number, archive date and state lines, newest first.
+func printHistory(cmd *cobra.Command, result *pb.HistoryResponse) {
+	if len(result.Items) == 0 {
+		cmd.Print("No revisions")
+		return
+	}
+	for _, item := range result.Items {
+		state := "updated"
+		if item.Deleted {
+			state = "deleted"
+		}
+		cmd.Printf("%d\t%s\t%s\n", item.Revision, item.ArchivedAt.AsTime().Local().Format(time.DateTime), state)
+	}
+}
+
+// addRestoreFlags registers the title and revision flags shared by all restore commands.
+func addRestoreFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	cmd.Flags().Int32P("rev", "r", 0, "Revision number, as printed by the history command")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	err = cmd.MarkFlagRequired("rev")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+}
+
+// newRestoreRequest builds a RestoreRequest from the flags registered by addRestoreFlags.
+func newRestoreRequest(cmd *cobra.Command) (*pb.RestoreRequest, error) {
+	title, err := cmd.Flags().GetString("title")
+	if err != nil {
+		return nil, err
+	}
+	revision, err := cmd.Flags().GetInt32("rev")
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreRequest{
+		Title:    title,
+		Revision: revision,
+	}, nil
+}
+
+// historyRetention creates a command changing the number of revisions the server keeps per record.
+// Revisions beyond the new limit are dropped at once; zero turns the history off.
+// Possible errors include an out of range number (`InvalidArgument`) or an invalid token (`Unauthenticated`).
+func historyRetention(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history-retention",
+		Short: "Set the number of kept revisions",
+		Long:  `Set the number of previous versions kept for every record; older ones are dropped.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			keep, err := cmd.Flags().GetInt32("keep")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			cond := pb.HistoryRetentionRequest{
+				Keep: keep,
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			_, err = client.Users.SetHistoryRetention(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Revisions kept per record: ", keep)
+			}
+		},
+	}
+	cmd.Flags().Int32P("keep", "k", 0, "Number of revisions kept per record")
+	err := cmd.MarkFlagRequired("keep")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"fmt"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "main/proto"
+	"time"
+)
+
+// addListFlags registers the pagination, sorting, and filtering flags shared by all list commands.
+// Records can be filtered by title prefix, by folder, subfolders included, by tags,
+// and to those not changed or not read for a number of days.
+func addListFlags(cmd *cobra.Command) {
+	cmd.Flags().Int32P("limit", "n", 0, "Number of records per page (server default if omitted)")
+	cmd.Flags().StringP("cursor", "c", "", "Cursor of the page to fetch, as printed by the previous call")
+	cmd.Flags().StringP("prefix", "p", "", "Only list records whose title starts with the prefix")
+	cmd.Flags().StringP("sort", "s", "title", "Sort order: title, date (of creation), updated or accessed")
+	cmd.Flags().BoolP("desc", "d", false, "Sort in descending order")
+	cmd.Flags().String("folder", "", "Only list records in the folder or its subfolders")
+	cmd.Flags().StringSlice("tag", nil, "Only list records carrying the tag; repeatable, all tags must match")
+	cmd.Flags().Int("not-updated-days", 0, "Only list records not changed for this many days")
+	cmd.Flags().Int("not-accessed-days", 0, "Only list records not read for this many days, never read ones included")
+}
+
+// newListRequest builds a ListRequest from the flags registered by addListFlags.
+// An error is returned if the requested sort order is not supported.
+func newListRequest(cmd *cobra.Command) (*pb.ListRequest, error) {
+	limit, err := cmd.Flags().GetInt32("limit")
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := cmd.Flags().GetString("cursor")
+	if err != nil {
+		return nil, err
+	}
+	prefix, err := cmd.Flags().GetString("prefix")
+	if err != nil {
+		return nil, err
+	}
+	sort, err := cmd.Flags().GetString("sort")
+	if err != nil {
+		return nil, err
+	}
+	desc, err := cmd.Flags().GetBool("desc")
+	if err != nil {
+		return nil, err
+	}
+	folder, err := cmd.Flags().GetString("folder")
+	if err != nil {
+		return nil, err
+	}
+	tags, err := cmd.Flags().GetStringSlice("tag")
+	if err != nil {
+		return nil, err
+	}
+	notUpdated, err := cmd.Flags().GetInt("not-updated-days")
+	if err != nil {
+		return nil, err
+	}
+	notAccessed, err := cmd.Flags().GetInt("not-accessed-days")
+	if err != nil {
+		return nil, err
+	}
+
+	var sortBy pb.SortField
+	switch sort {
+	case "title":
+		sortBy = pb.SortField_SORT_FIELD_TITLE
+	case "date":
+		sortBy = pb.SortField_SORT_FIELD_DATE
+	case "updated":
+		sortBy = pb.SortField_SORT_FIELD_UPDATED
+	case "accessed":
+		sortBy = pb.SortField_SORT_FIELD_ACCESSED
+	default:
+		return nil, fmt.Errorf("unsupported sort order: %s", sort)
+	}
+
+	return &pb.ListRequest{
+		PageSize:       limit,
+		Cursor:         cursor,
+		TitlePrefix:    prefix,
+		SortBy:         sortBy,
+		Descending:     desc,
+		Folder:         folder,
+		Tags:           tags,
+		UpdatedBefore:  daysAgo(notUpdated),
+		AccessedBefore: daysAgo(notAccessed),
+	}, nil
+}
+
+// daysAgo returns the time the given number of days ago, or nil if the number is not positive.
+func daysAgo(days int) *timestamppb.Timestamp {
+	if days <= 0 {
+		return nil
+	}
+	return timestamppb.New(time.Now().AddDate(0, 0, -days))
+}
+
+// recordStats is a record of any kind carrying its timestamps and access counter.
+type recordStats interface {
+	GetCreatedAt() *timestamppb.Timestamp
+	GetUpdatedAt() *timestamppb.Timestamp
+	GetLastAccessedAt() *timestamppb.Timestamp
+	GetAccessCount() int64
+}
+
+// listedRecord is a listed record of any kind.
+type listedRecord interface {
+	recordStats
+	GetTitle() string
+}
+
+// printListItem outputs a single listed record as a line of its creation, update and last access times,
+// its access count and its title.
+func printListItem(cmd *cobra.Command, item listedRecord) {
+	printLabeledItem(cmd, item, "")
+}
+
+// printLabeledItem outputs a listed record like printListItem, followed by a label such as the brand of a card, if any.
+func printLabeledItem(cmd *cobra.Command, item listedRecord, label string) {
+	cmd.Printf("%s\t%s\t%s\t%d\t%s", formatTime(item.GetCreatedAt()), formatTime(item.GetUpdatedAt()),
+		formatTime(item.GetLastAccessedAt()), item.GetAccessCount(), item.GetTitle())
+	if label != "" {
+		cmd.Printf("\t%s", label)
+	}
+	cmd.Println()
+}
+
+// printStats outputs when a record was created, last changed and last read, and how often it was read
+// before this request.
+func printStats(cmd *cobra.Command, record recordStats) {
+	cmd.Printf("\nCreated: %s", formatTime(record.GetCreatedAt()))
+	cmd.Printf("\nUpdated: %s", formatTime(record.GetUpdatedAt()))
+	cmd.Printf("\nLast accessed: %s", formatTime(record.GetLastAccessedAt()))
+	cmd.Printf("\nAccess count: %d", record.GetAccessCount())
+}
+
+// formatTime formats a timestamp in local time, or as "never" if it is unset.
+func formatTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return "never"
+	}
+	return ts.AsTime().Local().Format(time.DateTime)
+}
+
+// printNextCursor tells the user how to fetch the following page, if there is one.
+func printNextCursor(cmd *cobra.Command, cursor string) {
+	if cursor != "" {
+		cmd.Printf("Next page cursor: %s\n", cursor)
+	}
+}
@@ -0,0 +1,439 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+	"io"
+	"main/internal/client/app/proto"
+	pb "main/proto"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// defaultEditor is started to write a note when $EDITOR is not set.
+const defaultEditor = "vi"
+
+// SetupNoteCommand configures the top-level command for managing secure notes.
+// Notes hold free-form, multi-line text such as recovery instructions, license keys or runbooks.
+// No error handling needed at this level as it merely organizes sub-commands.
+func SetupNoteCommand(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "note",
+		Short: "Processing of secure notes",
+		Long: `Processing of secure text notes.
+		Includes methods for saving, retrieving, modifying, deleting, and listing.
+		The text is given with --body, written in $EDITOR with --editor, or read from the standard input.`,
+	}
+	cmd.AddCommand(addNote(client))
+	cmd.AddCommand(getNote(client))
+	cmd.AddCommand(updateNote(client))
+	cmd.AddCommand(removeNote(client))
+	cmd.AddCommand(listNotes(client))
+	cmd.AddCommand(historyNote(client))
+	cmd.AddCommand(restoreNote(client))
+	return cmd
+}
+
+// addNote creates a new secure note with the text from --body, $EDITOR or the standard input.
+// Errors include conflicts (`AlreadyExists`) and authentication issues (`Unauthenticated`).
+func addNote(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a secure note",
+		Long:  `Add a secure note; without --body or --editor the text is read from the standard input.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			body, err := readNoteBody(cmd, "")
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			placement, err := newPlacement(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			cond := pb.NoteCreateRequest{
+				Title:     title,
+				Body:      body,
+				Placement: placement,
+			}
+
+			id, ok := recordID(cmd, client, pb.ItemKind_ITEM_KIND_NOTE, title, true)
+			if !ok || !sealText(cmd, client, id, map[string]*string{
+				"note.body": &cond.Body,
+			}) {
+				return
+			}
+			cond.Id = id
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Notes.Add(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Save object with title: ", result.Title)
+			}
+		},
+	}
+	addNoteBodyFlags(cmd)
+	addPlacementFlags(cmd, false)
+	return cmd
+}
+
+// getNote prints a secure note by its title.
+// Possible errors include an absent record (`NotFound`) or an invalid token (`Unauthenticated`).
+func getNote(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get a secure note",
+		Long:  `Get a secure note.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			result, ok := fetchNote(cmd, client, title)
+			if ok {
+				cmd.Println("Get object with title:", result.Title)
+				cmd.Print(result.Body)
+				printPlacement(cmd, result.Placement)
+				printStats(cmd, result)
+			}
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// updateNote replaces the text of a secure note. With --editor the editor starts with the current text.
+// With --new-title the record is renamed as well, keeping its ID and history. Given only --new-title, --folder,
+// --tag or --clear-tags, the record is renamed, moved and retagged without being rewritten.
+// Errors might arise due to a missing record (`NotFound`) or an improper token (`Unauthenticated`).
+func updateNote(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update a secure note",
+		Long:  `Replace the text of a secure note; with --editor the current text is edited.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			if metadataOnly(cmd, "body", "editor") {
+				updateMetadata(cmd, client, pb.ItemKind_ITEM_KIND_NOTE, title, client.Notes.Rename)
+				return
+			}
+			edit, err := cmd.Flags().GetBool("editor")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			var current string
+			if edit {
+				note, ok := fetchNote(cmd, client, title)
+				if !ok {
+					return
+				}
+				current = note.Body
+			}
+
+			body, err := readNoteBody(cmd, current)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			placement, replaceFolder, replaceTags, err := newPlacementUpdate(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			cond := pb.NoteUpdateRequest{
+				Title:         title,
+				Body:          body,
+				Placement:     placement,
+				ReplaceFolder: replaceFolder,
+				ReplaceTags:   replaceTags,
+			}
+
+			id, ok := recordID(cmd, client, pb.ItemKind_ITEM_KIND_NOTE, title, false)
+			if !ok || !sealText(cmd, client, id, map[string]*string{
+				"note.body": &cond.Body,
+			}) {
+				return
+			}
+			cond.Id = id
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Notes.Update(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else if current, ok := renameRecord(cmd, client, result.Title, client.Notes.Rename); ok {
+				cmd.Print("Update object with title: ", current)
+			}
+		},
+	}
+	addNoteBodyFlags(cmd)
+	addPlacementFlags(cmd, true)
+	addRenameFlag(cmd)
+	return cmd
+}
+
+// removeNote moves a secure note to the trash by its title.
+// Errors could stem from the absence of the record (`NotFound`) or invalid token usage (`Unauthenticated`).
+func removeNote(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Delete a secure note",
+		Long:  `Delete a secure note.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			cond := pb.NoteRequest{
+				Title: title,
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			_, err = client.Notes.Delete(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Successfully deleted")
+			}
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// listNotes prints the titles of stored secure notes page by page.
+// Records can be filtered by a title prefix or by how long they went unchanged or unread, and sorted
+// by title or by creation, update or access time; the cursor printed after a full page is passed back
+// with --cursor to fetch the next one.
+// Possible errors include an invalid cursor (`InvalidArgument`) or an invalid token (`Unauthenticated`).
+func listNotes(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List secure notes",
+		Long:  `List titles of stored secure notes.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cond, err := newListRequest(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Notes.List(newCtx, cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				for _, item := range result.Items {
+					printListItem(cmd, item)
+				}
+				printNextCursor(cmd, result.NextCursor)
+			}
+		},
+	}
+	addListFlags(cmd)
+	return cmd
+}
+
+// historyNote prints the archived revisions of a secure note, newest first.
+// Revisions of a deleted note are listed as well.
+// Possible errors include an invalid token (`Unauthenticated`).
+func historyNote(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "List previous versions of a secure note",
+		Long:  `List previous versions of a secure note kept by the server.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			cond := pb.HistoryRequest{
+				Title: title,
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Notes.History(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				printHistory(cmd, result)
+			}
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// restoreNote brings back a previous version of a secure note; the current one is archived in turn.
+// A deleted note is added again.
+// Possible errors include a missing or pruned revision (`NotFound`) or an invalid token (`Unauthenticated`).
+func restoreNote(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore a previous version of a secure note",
+		Long:  `Restore a previous version of a secure note, as listed by the history command.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cond, err := newRestoreRequest(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Notes.Restore(newCtx, cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Printf("Restore object with title: %s, revision %d", result.Title, cond.Revision)
+			}
+		},
+	}
+	addRestoreFlags(cmd)
+	return cmd
+}
+
+// fetchNote retrieves a secure note by title and decrypts it if the account uses a vault.
+// Errors are reported to the user; false means the command must stop.
+func fetchNote(cmd *cobra.Command, client *proto.GothKeeperClient, title string) (*pb.NoteResponse, bool) {
+	cond := pb.NoteRequest{
+		Title: title,
+	}
+
+	ctx := cmd.Context()
+	newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+	result, err := client.Notes.Get(newCtx, &cond)
+	if err != nil {
+		dispatchErrors(cmd, err)
+		return nil, false
+	}
+	if !openText(cmd, client, result.Id, map[string]*string{
+		"note.body": &result.Body,
+	}) {
+		return nil, false
+	}
+	return result, true
+}
+
+// addNoteBodyFlags registers the title and text source flags shared by the add and update commands.
+func addNoteBodyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	cmd.Flags().StringP("body", "b", "", "Text of the note")
+	cmd.Flags().BoolP("editor", "e", false, "Write the text in $EDITOR")
+	cmd.MarkFlagsMutuallyExclusive("body", "editor")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+}
+
+// readNoteBody returns the text of a note from --body, from $EDITOR started with the given initial text
+// if --editor is set, or otherwise from the standard input up to its end.
+func readNoteBody(cmd *cobra.Command, initial string) (string, error) {
+	if cmd.Flags().Changed("body") {
+		return cmd.Flags().GetString("body")
+	}
+
+	edit, err := cmd.Flags().GetBool("editor")
+	if err != nil {
+		return "", err
+	}
+	if edit {
+		return editNote(cmd, initial)
+	}
+
+	if f, ok := cmd.InOrStdin().(*os.File); ok {
+		if info, err := f.Stat(); err == nil && info.Mode()&os.ModeCharDevice != 0 {
+			cmd.Println("Enter the note, finish with Ctrl-D:")
+		}
+	}
+	body, err := io.ReadAll(cmd.InOrStdin())
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// editNote opens the given text in $EDITOR and returns the saved result.
+// The text is kept in a temporary file readable only by the user, which is removed afterwards.
+func editNote(cmd *cobra.Command, initial string) (string, error) {
+	editor := strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{defaultEditor}
+	}
+
+	f, err := os.CreateTemp("", "gophkeeper-note-*.txt")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.WriteString(initial)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	run := exec.Command(editor[0], append(editor[1:], f.Name())...)
+	run.Stdin = os.Stdin
+	run.Stdout = os.Stdout
+	run.Stderr = os.Stderr
+	if err := run.Run(); err != nil {
+		return "", fmt.Errorf("editor %s: %w", editor[0], err)
+	}
+
+	body, err := os.ReadFile(f.Name())
+	if err != nil {
+		return "", err
+	}
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return "", errors.New("empty note, nothing saved")
+	}
+	return string(body), nil
+}
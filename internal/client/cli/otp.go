@@ -0,0 +1,475 @@
+package cli
+
+import (
+	"bufio"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+	"main/internal/client/app/proto"
+	"main/internal/otp"
+	pb "main/proto"
+	"strings"
+	"time"
+)
+
+// SetupOTPCommand configures the top-level command for managing authenticator secrets.
+// Secrets are imported from otpauth:// URIs and may be linked to the password they protect.
+// No error handling needed at this level as it merely organizes sub-commands.
+func SetupOTPCommand(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "otp",
+		Short: "Processing of authenticator secrets",
+		Long: `Processing of authenticator (TOTP) secrets imported from otpauth:// URIs.
+		Includes methods for saving, retrieving, modifying, deleting, listing, and generating codes.`,
+	}
+	cmd.AddCommand(addOTP(client))
+	cmd.AddCommand(getOTP(client))
+	cmd.AddCommand(updateOTP(client))
+	cmd.AddCommand(removeOTP(client))
+	cmd.AddCommand(listOTP(client))
+	cmd.AddCommand(historyOTP(client))
+	cmd.AddCommand(restoreOTP(client))
+	cmd.AddCommand(codeOTP(client))
+	return cmd
+}
+
+// addOTP stores a new authenticator secret parsed from an otpauth:// URI, optionally linked to a password.
+// The URI is prompted for on the standard input when --uri is empty, keeping it out of the shell history.
+// Errors include an invalid URI, conflicts (`AlreadyExists`), a missing password (`NotFound`)
+// and authentication issues (`Unauthenticated`).
+func addOTP(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add an authenticator secret",
+		Long:  `Add an authenticator secret from an otpauth:// URI, as encoded in the QR code of the service.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			password, err := cmd.Flags().GetString("password")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			key, err := readOTPURI(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			placement, err := newPlacement(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			cond := pb.OTPCreateRequest{
+				Title:     title,
+				Issuer:    key.Issuer,
+				Account:   key.Account,
+				Secret:    key.Secret,
+				Algorithm: key.Algorithm,
+				Digits:    int32(key.Digits),
+				Period:    int32(key.Period),
+				Password:  password,
+				Placement: placement,
+			}
+
+			id, ok := recordID(cmd, client, pb.ItemKind_ITEM_KIND_OTP, title, true)
+			if !ok || !sealText(cmd, client, id, map[string]*string{
+				"otp.issuer":  &cond.Issuer,
+				"otp.account": &cond.Account,
+				"otp.secret":  &cond.Secret,
+			}) {
+				return
+			}
+			cond.Id = id
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.OTP.Add(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Save object with title: ", result.Title)
+			}
+		},
+	}
+	addOTPFlags(cmd)
+	addPlacementFlags(cmd, false)
+	return cmd
+}
+
+// getOTP prints an authenticator secret with its parameters and linked password.
+// Possible errors include an absent record (`NotFound`) or an invalid token (`Unauthenticated`).
+func getOTP(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get an authenticator secret",
+		Long:  `Get an authenticator secret.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			result, ok := fetchOTP(cmd, client, title)
+			if ok {
+				cmd.Println("Get object with title:", result.Title)
+				cmd.Println("Issuer:", result.Issuer)
+				cmd.Println("Account:", result.Account)
+				cmd.Println("Secret:", result.Secret)
+				cmd.Printf("Parameters: %s, %d digits, %ds\n", result.Algorithm, result.Digits, result.Period)
+				if result.Password != "" {
+					cmd.Println("Password:", result.Password)
+				}
+				printPlacement(cmd, result.Placement)
+				printStats(cmd, result)
+			}
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// updateOTP replaces an authenticator secret with one parsed from a new otpauth:// URI, together with its password link.
+// With --new-title the record is renamed as well, keeping its ID and history. Given only --new-title, --folder,
+// --tag or --clear-tags, the record is renamed, moved and retagged without being rewritten.
+// Errors might arise due to an invalid URI, a missing record or password (`NotFound`) or an improper token (`Unauthenticated`).
+func updateOTP(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update an authenticator secret",
+		Long:  `Replace an authenticator secret with a new otpauth:// URI; the password link is replaced as well.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			if metadataOnly(cmd, "uri", "password") {
+				updateMetadata(cmd, client, pb.ItemKind_ITEM_KIND_OTP, title, client.OTP.Rename)
+				return
+			}
+			password, err := cmd.Flags().GetString("password")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			key, err := readOTPURI(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			placement, replaceFolder, replaceTags, err := newPlacementUpdate(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			cond := pb.OTPUpdateRequest{
+				Title:         title,
+				Issuer:        key.Issuer,
+				Account:       key.Account,
+				Secret:        key.Secret,
+				Algorithm:     key.Algorithm,
+				Digits:        int32(key.Digits),
+				Period:        int32(key.Period),
+				Password:      password,
+				Placement:     placement,
+				ReplaceFolder: replaceFolder,
+				ReplaceTags:   replaceTags,
+			}
+
+			id, ok := recordID(cmd, client, pb.ItemKind_ITEM_KIND_OTP, title, false)
+			if !ok || !sealText(cmd, client, id, map[string]*string{
+				"otp.issuer":  &cond.Issuer,
+				"otp.account": &cond.Account,
+				"otp.secret":  &cond.Secret,
+			}) {
+				return
+			}
+			cond.Id = id
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.OTP.Update(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else if current, ok := renameRecord(cmd, client, result.Title, client.OTP.Rename); ok {
+				cmd.Print("Update object with title: ", current)
+			}
+		},
+	}
+	addOTPFlags(cmd)
+	addPlacementFlags(cmd, true)
+	addRenameFlag(cmd)
+	return cmd
+}
+
+// removeOTP moves an authenticator secret to the trash by its title.
+// Errors could stem from the absence of the record (`NotFound`) or invalid token usage (`Unauthenticated`).
+func removeOTP(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Delete an authenticator secret",
+		Long:  `Delete an authenticator secret.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			cond := pb.OTPRequest{
+				Title: title,
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			_, err = client.OTP.Delete(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Successfully deleted")
+			}
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// listOTP prints the titles of stored authenticator secrets page by page.
+// Records can be filtered by a title prefix or by how long they went unchanged or unread, and sorted
+// by title or by creation, update or access time; the cursor printed after a full page is passed back
+// with --cursor to fetch the next one.
+// Possible errors include an invalid cursor (`InvalidArgument`) or an invalid token (`Unauthenticated`).
+func listOTP(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List authenticator secrets",
+		Long:  `List titles of stored authenticator secrets.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cond, err := newListRequest(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.OTP.List(newCtx, cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				for _, item := range result.Items {
+					printListItem(cmd, item)
+				}
+				printNextCursor(cmd, result.NextCursor)
+			}
+		},
+	}
+	addListFlags(cmd)
+	return cmd
+}
+
+// historyOTP prints the archived revisions of an authenticator secret, newest first.
+// Revisions of a deleted secret are listed as well.
+// Possible errors include an invalid token (`Unauthenticated`).
+func historyOTP(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "List previous versions of an authenticator secret",
+		Long:  `List previous versions of an authenticator secret kept by the server.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			cond := pb.HistoryRequest{
+				Title: title,
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.OTP.History(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				printHistory(cmd, result)
+			}
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// restoreOTP brings back a previous version of an authenticator secret; the current one is archived in turn.
+// A deleted secret is added again.
+// Possible errors include a missing or pruned revision (`NotFound`) or an invalid token (`Unauthenticated`).
+func restoreOTP(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore a previous version of an authenticator secret",
+		Long:  `Restore a previous version of an authenticator secret, as listed by the history command.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cond, err := newRestoreRequest(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.OTP.Restore(newCtx, cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Printf("Restore object with title: %s, revision %d", result.Title, cond.Revision)
+			}
+		},
+	}
+	addRestoreFlags(cmd)
+	return cmd
+}
+
+// codeOTP prints the current code of an authenticator secret and the seconds it stays valid.
+// The server generates the code, except for zero-knowledge vault accounts, whose secrets only the client can decrypt.
+// Possible errors include an absent record (`NotFound`) or an invalid token (`Unauthenticated`).
+func codeOTP(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code",
+		Short: "Generate the current code",
+		Long:  `Generate the current code of an authenticator secret.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			v, err := unlockVault(cmd, client)
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+			if v != nil {
+				result, ok := fetchOTP(cmd, client, title)
+				if !ok {
+					return
+				}
+				code, remaining, err := localOTPCode(result)
+				if err != nil {
+					cmd.PrintErr(err)
+					return
+				}
+				printOTPCode(cmd, code, remaining)
+				return
+			}
+
+			cond := pb.OTPRequest{
+				Title: title,
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.OTP.GenerateCode(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				printOTPCode(cmd, result.Code, time.Duration(result.Remaining)*time.Second)
+			}
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// fetchOTP retrieves an authenticator secret by title and decrypts it if the account uses a vault.
+// Errors are reported to the user; false means the command must stop.
+func fetchOTP(cmd *cobra.Command, client *proto.GothKeeperClient, title string) (*pb.OTPResponse, bool) {
+	cond := pb.OTPRequest{
+		Title: title,
+	}
+
+	ctx := cmd.Context()
+	newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+	result, err := client.OTP.Get(newCtx, &cond)
+	if err != nil {
+		dispatchErrors(cmd, err)
+		return nil, false
+	}
+	if !openText(cmd, client, result.Id, map[string]*string{
+		"otp.issuer":  &result.Issuer,
+		"otp.account": &result.Account,
+		"otp.secret":  &result.Secret,
+	}) {
+		return nil, false
+	}
+	return result, true
+}
+
+// localOTPCode generates the current code of a decrypted authenticator secret on the client.
+func localOTPCode(result *pb.OTPResponse) (string, time.Duration, error) {
+	secret, err := otp.DecodeSecret(result.Secret)
+	if err != nil {
+		return "", 0, err
+	}
+	return otp.Code(secret, result.Algorithm, int(result.Digits), int(result.Period), time.Now())
+}
+
+// printOTPCode outputs a code together with the whole seconds it stays valid.
+func printOTPCode(cmd *cobra.Command, code string, remaining time.Duration) {
+	cmd.Printf("Code: %s (valid for %ds)\n", code, int(remaining.Round(time.Second)/time.Second))
+}
+
+// addOTPFlags registers the title, URI and password link flags shared by the add and update commands.
+func addOTPFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	cmd.Flags().StringP("uri", "u", "", "otpauth:// URI of the secret; prompted for when empty")
+	cmd.Flags().StringP("password", "p", "", "Title of the password the secret is the second factor of")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+}
+
+// readOTPURI parses the otpauth:// URI from --uri, prompting for it on the standard input when the flag is empty.
+func readOTPURI(cmd *cobra.Command) (*otp.Key, error) {
+	uri, err := cmd.Flags().GetString("uri")
+	if err != nil {
+		return nil, err
+	}
+
+	if uri == "" {
+		cmd.Print("otpauth URI: ")
+		line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+		if err != nil && line == "" {
+			return nil, err
+		}
+		uri = strings.TrimSpace(line)
+	}
+
+	return otp.ParseURI(uri)
+}
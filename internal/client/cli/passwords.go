@@ -1,9 +1,11 @@
 package cli
 
 import (
+	"fmt"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/metadata"
 	"main/internal/client/app/proto"
+	"main/internal/customfield"
 	pb "main/proto"
 )
 
@@ -15,18 +17,25 @@ func SetupPasswordCommand(client *proto.GothKeeperClient) *cobra.Command {
 		Use:   "password",
 		Short: "Processing of login password pairs",
 		Long: `Processing of login and password pairs. 
-		Includes methods for saving, retrieving, modifying, and deleting.`,
+		Includes methods for saving, retrieving, modifying, deleting, and listing.`,
 	}
 	cmd.AddCommand(addPassword(client))
 	cmd.AddCommand(getPassword(client))
 	cmd.AddCommand(updatePassword(client))
 	cmd.AddCommand(removePassword(client))
+	cmd.AddCommand(listPasswords(client))
+	cmd.AddCommand(historyPassword(client))
+	cmd.AddCommand(restorePassword(client))
+	cmd.AddCommand(auditPassword(client))
+	cmd.AddCommand(generatePassword(client))
 	return cmd
 }
 
 // addPassword creates a new login-password pair.
 // It accepts three required parameters—title, login, and password—and uses them to send a gRPC request.
-// Errors include conflicts (`AlreadyExists`) and authentication issues (`Unauthenticated`).
+// With --generate, the password is generated following the generator flags instead and stored without being printed.
+// Custom fields are given with repeatable --field flags.
+// Errors include malformed fields, conflicts (`AlreadyExists`) and authentication issues (`Unauthenticated`).
 func addPassword(client *proto.GothKeeperClient) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add",
@@ -45,12 +54,40 @@ func addPassword(client *proto.GothKeeperClient) *cobra.Command {
 			if err != nil {
 				cmd.PrintErr(err)
 			}
+			generate, ok := generateInto(cmd, client, &password)
+			if !ok {
+				return
+			}
+
+			fields, err := readFields(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			placement, err := newPlacement(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
 
 			cond := pb.PasswordCreateRequest{
-				Title:    title,
-				Login:    login,
-				Password: password,
+				Title:     title,
+				Login:     login,
+				Password:  password,
+				Fields:    fields,
+				Placement: placement,
+				Generate:  generate,
+			}
+
+			id, ok := recordID(cmd, client, pb.ItemKind_ITEM_KIND_PASSWORD, title, true)
+			if !ok || !sealText(cmd, client, id, map[string]*string{
+				"password.login":    &cond.Login,
+				"password.password": &cond.Password,
+			}) || !sealFields(cmd, client, id, cond.Fields) {
+				return
 			}
+			cond.Id = id
 
 			ctx := cmd.Context()
 			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
@@ -66,6 +103,9 @@ func addPassword(client *proto.GothKeeperClient) *cobra.Command {
 	cmd.Flags().StringP("title", "t", "", "Record title")
 	cmd.Flags().StringP("login", "l", "", "Login")
 	cmd.Flags().StringP("password", "p", "", "Password")
+	addGenerateFlags(cmd, true)
+	addFieldFlag(cmd)
+	addPlacementFlags(cmd, false)
 	err := cmd.MarkFlagRequired("title")
 	if err != nil {
 		cmd.PrintErr(err)
@@ -74,15 +114,12 @@ func addPassword(client *proto.GothKeeperClient) *cobra.Command {
 	if err != nil {
 		cmd.PrintErr(err)
 	}
-	err = cmd.MarkFlagRequired("password")
-	if err != nil {
-		cmd.PrintErr(err)
-	}
 	return cmd
 }
 
-// getPassword retrieves a previously added login-password pair by its title.
-// It connects to the gRPC server to obtain the necessary data.
+// getPassword retrieves a previously added login-password pair by its title, together with its custom fields.
+// It connects to the gRPC server to obtain the necessary data. Hidden fields are masked unless --reveal is given.
+// A warning is printed if the server found the password in its breach corpus.
 // Possible errors include an absent record (`NotFound`) or an invalid token (`Unauthenticated`).
 func getPassword(client *proto.GothKeeperClient) *cobra.Command {
 	cmd := &cobra.Command{
@@ -94,6 +131,10 @@ func getPassword(client *proto.GothKeeperClient) *cobra.Command {
 			if err != nil {
 				cmd.PrintErr(err)
 			}
+			reveal, err := cmd.Flags().GetBool("reveal")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
 
 			cond := pb.PasswordRequest{
 				Title: title,
@@ -105,14 +146,24 @@ func getPassword(client *proto.GothKeeperClient) *cobra.Command {
 			result, err := client.Passwords.Get(newCtx, &cond)
 			if err != nil {
 				dispatchErrors(cmd, err)
-			} else {
+			} else if openText(cmd, client, result.Id, map[string]*string{
+				"password.login":    &result.Login,
+				"password.password": &result.Password,
+			}) && openFields(cmd, client, result.Id, result.Fields) {
 				cmd.Print("Get object with title: ", result.Title)
 				cmd.Print("Login: ", result.Login)
 				cmd.Print("Password: ", result.Password)
+				if result.Compromised {
+					cmd.Print("Warning: this password was seen in known data breaches; change it.")
+				}
+				printFields(cmd, result.Fields, reveal)
+				printPlacement(cmd, result.Placement)
+				printStats(cmd, result)
 			}
 		},
 	}
 	cmd.Flags().StringP("title", "t", "", "Record title")
+	cmd.Flags().Bool("reveal", false, "Show the values of hidden custom fields")
 	err := cmd.MarkFlagRequired("title")
 	if err != nil {
 		cmd.PrintErr(err)
@@ -122,6 +173,9 @@ func getPassword(client *proto.GothKeeperClient) *cobra.Command {
 
 // updatePassword alters an existing login-password pair.
 // It accepts the same parameters as addPassword but focuses on modifying rather than creating a new record.
+// The custom fields are kept unless --field flags replace them or --clear-fields removes them.
+// With --new-title the record is renamed as well, keeping its ID and history. Given only --new-title, --folder,
+// --tag or --clear-tags, the record is renamed, moved and retagged without being rewritten.
 // Errors might arise due to a missing record (`NotFound`) or an improper token (`Unauthenticated`).
 func updatePassword(client *proto.GothKeeperClient) *cobra.Command {
 	cmd := &cobra.Command{
@@ -133,6 +187,10 @@ func updatePassword(client *proto.GothKeeperClient) *cobra.Command {
 			if err != nil {
 				cmd.PrintErr(err)
 			}
+			if metadataOnly(cmd, "login", "password", "generate", "field", "clear-fields") {
+				updateMetadata(cmd, client, pb.ItemKind_ITEM_KIND_PASSWORD, title, client.Passwords.Rename)
+				return
+			}
 			login, err := cmd.Flags().GetString("login")
 			if err != nil {
 				cmd.PrintErr(err)
@@ -141,27 +199,67 @@ func updatePassword(client *proto.GothKeeperClient) *cobra.Command {
 			if err != nil {
 				cmd.PrintErr(err)
 			}
+			generate, ok := generateInto(cmd, client, &password)
+			if !ok {
+				return
+			}
+			clear, err := cmd.Flags().GetBool("clear-fields")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			fields, err := readFields(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			placement, replaceFolder, replaceTags, err := newPlacementUpdate(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
 
 			cond := pb.PasswordUpdateRequest{
-				Title:    title,
-				Login:    login,
-				Password: password,
+				Title:         title,
+				Login:         login,
+				Password:      password,
+				Fields:        fields,
+				ReplaceFields: clear || len(fields) > 0,
+				Placement:     placement,
+				ReplaceFolder: replaceFolder,
+				ReplaceTags:   replaceTags,
+				Generate:      generate,
 			}
 
+			id, ok := recordID(cmd, client, pb.ItemKind_ITEM_KIND_PASSWORD, title, false)
+			if !ok || !sealText(cmd, client, id, map[string]*string{
+				"password.login":    &cond.Login,
+				"password.password": &cond.Password,
+			}) || !sealFields(cmd, client, id, cond.Fields) {
+				return
+			}
+			cond.Id = id
+
 			ctx := cmd.Context()
 			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
 
 			result, err := client.Passwords.Update(newCtx, &cond)
 			if err != nil {
 				dispatchErrors(cmd, err)
-			} else {
-				cmd.Print("Update object with title: ", result.Title)
+			} else if current, ok := renameRecord(cmd, client, result.Title, client.Passwords.Rename); ok {
+				cmd.Print("Update object with title: ", current)
 			}
 		},
 	}
 	cmd.Flags().StringP("title", "t", "", "Record title")
 	cmd.Flags().StringP("login", "l", "", "Login")
 	cmd.Flags().StringP("password", "p", "", "Password")
+	addGenerateFlags(cmd, false)
+	cmd.Flags().Bool("clear-fields", false, "Remove all custom fields")
+	addFieldFlag(cmd)
+	cmd.MarkFlagsMutuallyExclusive("field", "clear-fields")
+	addPlacementFlags(cmd, true)
+	addRenameFlag(cmd)
 	err := cmd.MarkFlagRequired("title")
 	if err != nil {
 		cmd.PrintErr(err)
@@ -206,3 +304,186 @@ func removePassword(client *proto.GothKeeperClient) *cobra.Command {
 
 	return cmd
 }
+
+// listPasswords prints the titles of stored login password pairs page by page.
+// Records can be filtered by a title prefix or by how long they went unchanged or unread, and sorted
+// by title or by creation, update or access time; the cursor printed after a full page is passed back
+// with --cursor to fetch the next one.
+// Possible errors include an invalid cursor (`InvalidArgument`) or an invalid token (`Unauthenticated`).
+func listPasswords(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List login password pairs",
+		Long:  `List titles of stored login password pairs.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cond, err := newListRequest(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Passwords.List(newCtx, cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				for _, item := range result.Items {
+					printListItem(cmd, item)
+				}
+				printNextCursor(cmd, result.NextCursor)
+			}
+		},
+	}
+	addListFlags(cmd)
+	return cmd
+}
+
+// historyPassword prints the archived revisions of a login password pair, newest first.
+// Every update and delete archives the previous version; revisions of a deleted login password pair are listed as well.
+// Possible errors include an invalid token (`Unauthenticated`).
+func historyPassword(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "List previous versions of a login password pair",
+		Long:  `List previous versions of a login password pair kept by the server.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			cond := pb.HistoryRequest{
+				Title: title,
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Passwords.History(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				printHistory(cmd, result)
+			}
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// restorePassword brings back a previous version of a login password pair; the current one is archived in turn.
+// A deleted login password pair is added again.
+// Possible errors include a missing or pruned revision (`NotFound`) or an invalid token (`Unauthenticated`).
+func restorePassword(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore a previous version of a login password pair",
+		Long:  `Restore a previous version of a login password pair, as listed by the history command.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cond, err := newRestoreRequest(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Passwords.Restore(newCtx, cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Printf("Restore object with title: %s, revision %d", result.Title, cond.Revision)
+			}
+		},
+	}
+	addRestoreFlags(cmd)
+	return cmd
+}
+
+// addFieldFlag registers the repeatable flag giving the custom fields of a password entry.
+func addFieldFlag(cmd *cobra.Command) {
+	cmd.Flags().StringArrayP("field", "f", nil,
+		"Custom field as name=value or type:name=value, type being text, hidden, url or email; repeatable")
+}
+
+// readFields parses and validates the custom fields given with --field flags, keeping their order.
+func readFields(cmd *cobra.Command) ([]*pb.CustomField, error) {
+	values, err := cmd.Flags().GetStringArray("field")
+	if err != nil {
+		return nil, err
+	}
+
+	parsed := make([]customfield.Field, 0, len(values))
+	for _, value := range values {
+		field, err := customfield.Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, value)
+		}
+		parsed = append(parsed, field)
+	}
+	if err := customfield.ValidateAll(parsed); err != nil {
+		return nil, err
+	}
+
+	fields := make([]*pb.CustomField, 0, len(parsed))
+	for _, field := range parsed {
+		fields = append(fields, &pb.CustomField{
+			Name:  field.Name,
+			Type:  field.Type,
+			Value: field.Value,
+		})
+	}
+	return fields, nil
+}
+
+// sealFields encrypts the names and values of custom fields of the password entry with the given ID in place
+// if the account uses a vault. Each is bound to the position and type of its field, so the server can neither
+// swap nor reorder them. Errors are reported to the user; false means the command must stop.
+func sealFields(cmd *cobra.Command, client *proto.GothKeeperClient, id int64, fields []*pb.CustomField) bool {
+	for i, field := range fields {
+		if !sealText(cmd, client, id, map[string]*string{
+			fieldLabel(i, field.Type, "name"):  &field.Name,
+			fieldLabel(i, field.Type, "value"): &field.Value,
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// openFields decrypts the names and values of custom fields of the password entry with the given ID in place
+// if the account uses a vault. Errors are reported to the user; false means the command must stop.
+func openFields(cmd *cobra.Command, client *proto.GothKeeperClient, id int64, fields []*pb.CustomField) bool {
+	for i, field := range fields {
+		if !openText(cmd, client, id, map[string]*string{
+			fieldLabel(i, field.Type, "name"):  &field.Name,
+			fieldLabel(i, field.Type, "value"): &field.Value,
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldLabel names a part of the custom field at the given position and of the given type for the vault.
+func fieldLabel(position int, typ, part string) string {
+	return fmt.Sprintf("password.field.%d.%s.%s", position, typ, part)
+}
+
+// printFields outputs custom fields as name, type and value lines, masking hidden values unless reveal is set.
+func printFields(cmd *cobra.Command, fields []*pb.CustomField, reveal bool) {
+	for _, field := range fields {
+		value := field.Value
+		if field.Type == customfield.Hidden && !reveal {
+			value = "********"
+		}
+		cmd.Printf("\n%s (%s): %s", field.Name, field.Type, value)
+	}
+}
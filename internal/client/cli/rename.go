@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"context"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"main/internal/client/app/proto"
+	pb "main/proto"
+)
+
+// titled is implemented by the short responses every kind of record returns from its Rename RPC.
+type titled interface {
+	GetId() int64
+	GetTitle() string
+}
+
+// addRenameFlag registers the flag of update commands giving a record a new title.
+func addRenameFlag(cmd *cobra.Command) {
+	cmd.Flags().String("new-title", "", "New title of the record; its history follows it")
+}
+
+// metadataOnly reports whether an update command was given a new title or placement flags but none of
+// its content flags, in which case updateMetadata renames and places the record without rewriting it.
+func metadataOnly(cmd *cobra.Command, content ...string) bool {
+	for _, name := range content {
+		if cmd.Flags().Changed(name) {
+			return false
+		}
+	}
+	return cmd.Flags().Changed("new-title") || placementChanged(cmd)
+}
+
+// updateMetadata places a record of the given kind as the placement flags ask and renames it as --new-title asks,
+// leaving its content untouched.
+func updateMetadata[T titled](cmd *cobra.Command, client *proto.GothKeeperClient, kind pb.ItemKind, title string,
+	rename func(context.Context, *pb.RenameRequest, ...grpc.CallOption) (T, error)) {
+	if placementChanged(cmd) && !placeRecord(cmd, client, kind, title) {
+		return
+	}
+	if title, ok := renameRecord(cmd, client, title, rename); ok {
+		cmd.Print("Update object with title: ", title)
+	}
+}
+
+// renameRecord gives a record the title set by --new-title with the Rename RPC of its kind, in a single step
+// on the server that keeps its ID and history. It returns the title the record ends up with, the given one
+// if --new-title is absent, and reports false if the rename failed.
+// Possible errors include an absent record (`NotFound`), a title taken by another record (`AlreadyExists`),
+// a blank or overlong title (`InvalidArgument`) or an invalid token (`Unauthenticated`).
+func renameRecord[T titled](cmd *cobra.Command, client *proto.GothKeeperClient, title string,
+	rename func(context.Context, *pb.RenameRequest, ...grpc.CallOption) (T, error)) (string, bool) {
+	if !cmd.Flags().Changed("new-title") {
+		return title, true
+	}
+	newTitle, err := cmd.Flags().GetString("new-title")
+	if err != nil {
+		cmd.PrintErr(err)
+		return "", false
+	}
+
+	cond := pb.RenameRequest{
+		Title:    title,
+		NewTitle: newTitle,
+	}
+
+	ctx := cmd.Context()
+	newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+	result, err := rename(newCtx, &cond)
+	if err != nil {
+		dispatchErrors(cmd, err)
+		return "", false
+	}
+	return result.GetTitle(), true
+}
@@ -17,9 +17,17 @@ var rootCmd = &cobra.Command{
 }
 
 func Execute(client *proto.GothKeeperClient) {
+	rootCmd.PersistentFlags().String("master-password", "",
+		"Master password of a zero-knowledge vault account (defaults to $"+masterPasswordEnv+")")
 	rootCmd.AddCommand(SetupBinaryCommand(client))
 	rootCmd.AddCommand(SetupCardCommand(client))
+	rootCmd.AddCommand(SetupFolderCommand(client))
+	rootCmd.AddCommand(SetupNoteCommand(client))
+	rootCmd.AddCommand(SetupOTPCommand(client))
 	rootCmd.AddCommand(SetupPasswordCommand(client))
+	rootCmd.AddCommand(SetupSSHAgentCommand(client))
+	rootCmd.AddCommand(SetupSSHKeyCommand(client))
+	rootCmd.AddCommand(SetupTrashCommand(client))
 	rootCmd.AddCommand(SetupUserCommand(client))
 
 	if err := rootCmd.Execute(); err != nil {
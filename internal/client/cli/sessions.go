@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"main/internal/client/app/proto"
+	pb "main/proto"
+	"time"
+)
+
+// logoutUser creates a command revoking the current session on the server.
+// The stored tokens are dropped, locally and on disk, even if the server call fails.
+func logoutUser(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logout",
+		Short: "Logout User",
+		Long:  `Revoke the current session; its access and refresh tokens stop working.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			_, err := client.Users.Logout(newCtx, &emptypb.Empty{})
+			if clearErr := client.ClearSession(); clearErr != nil {
+				cmd.PrintErrf("Error: %v\n", clearErr)
+			}
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Successfully logged out")
+			}
+		},
+	}
+	return cmd
+}
+
+// whoami creates a command printing the user of the persisted session.
+// The session is checked with the server, so a revoked or expired one is reported as such.
+func whoami(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the logged in user",
+		Long:  `Show the user and the session the following commands are executed in.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if client.Token == "" {
+				cmd.Print("Not logged in")
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Users.ListSessions(newCtx, &emptypb.Empty{})
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+			cmd.Printf("Login: %s\n", client.Login)
+			for _, s := range result.Items {
+				if s.Current {
+					printSession(cmd, s)
+				}
+			}
+		},
+	}
+	return cmd
+}
+
+// saveSession stores the tokens of a new session in the client and persists them.
+// A failure to persist is reported, but the tokens stay usable for the current invocation.
+func saveSession(cmd *cobra.Command, client *proto.GothKeeperClient, login, token, refresh string, expiresAt *timestamppb.Timestamp) {
+	client.Login = login
+	client.Token = token
+	client.Refresh = refresh
+	client.ExpiresAt = expiresAt.AsTime()
+	if err := client.SaveSession(); err != nil {
+		cmd.PrintErrf("Error: session was not saved: %v\n", err)
+	}
+}
+
+// listSessions creates a command printing the active sessions of the user.
+// The session the command itself runs in is marked as current.
+func listSessions(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sessions",
+		Short: "List active sessions",
+		Long:  `List active login sessions of the user on all devices.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Users.ListSessions(newCtx, &emptypb.Empty{})
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+			for _, s := range result.Items {
+				printSession(cmd, s)
+			}
+		},
+	}
+	return cmd
+}
+
+// revokeSession creates a command revoking a session by ID, e.g. that of a lost device.
+// Possible errors include a missing session (`NotFound`) or an invalid token (`Unauthenticated`).
+func revokeSession(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke a session",
+		Long:  `Revoke a login session by ID, as printed by the sessions command.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := cmd.Flags().GetInt64("id")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			cond := pb.RevokeSessionRequest{
+				Id: id,
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			_, err = client.Users.RevokeSession(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Revoked session: ", id)
+			}
+		},
+	}
+	cmd.Flags().Int64P("id", "i", 0, "Session ID")
+	err := cmd.MarkFlagRequired("id")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// printSession prints a single session line.
+func printSession(cmd *cobra.Command, s *pb.Session) {
+	current := ""
+	if s.Current {
+		current = "\t(current)"
+	}
+	cmd.Printf("%d\t%s\t%s\tlast used %s\texpires %s%s\n", s.Id, s.Address, s.UserAgent,
+		s.LastUsedAt.AsTime().Local().Format(time.DateTime), s.ExpiresAt.AsTime().Local().Format(time.DateTime), current)
+}
@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/agent"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"main/internal/client/app/proto"
+	"main/internal/sshkey"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"syscall"
+)
+
+// SetupSSHAgentCommand configures the command serving stored SSH keys over the ssh-agent protocol.
+// The keys are fetched and decrypted once at startup and only ever kept in memory; the agent listens
+// on a unix socket accessible to the current user until it is interrupted.
+func SetupSSHAgentCommand(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ssh-agent",
+		Short: "Serve stored SSH keys to ssh",
+		Long: `Serve stored SSH keys over the ssh-agent protocol without writing them to disk.
+		Point SSH_AUTH_SOCK at the printed socket to use them; the agent runs until interrupted.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			socket, err := cmd.Flags().GetString("socket")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			titles, err := cmd.Flags().GetStringSlice("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			keyring, ok := loadSSHKeys(cmd, client, titles)
+			if !ok {
+				return
+			}
+
+			if socket == "" {
+				dir, err := os.MkdirTemp("", "gophkeeper-agent-")
+				if err != nil {
+					cmd.PrintErr(err)
+					return
+				}
+				defer os.RemoveAll(dir)
+				socket = filepath.Join(dir, "agent.sock")
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			if err := serveSSHAgent(ctx, cmd, keyring, socket); err != nil {
+				cmd.PrintErr(err)
+			}
+		},
+	}
+	cmd.Flags().String("socket", "", "Path of the agent socket; a private temporary directory is used by default")
+	cmd.Flags().StringSliceP("title", "t", nil, "Titles of the keys to serve; all keys are served by default")
+	return cmd
+}
+
+// loadSSHKeys fetches the selected SSH keys, decrypts them and adds them to an in-memory keyring
+// under their titles. Errors are reported to the user; false means the command must stop.
+func loadSSHKeys(cmd *cobra.Command, client *proto.GothKeeperClient, titles []string) (agent.Agent, bool) {
+	ctx := cmd.Context()
+	newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+	result, err := client.SSHKeys.PublicKeys(newCtx, &emptypb.Empty{})
+	if err != nil {
+		dispatchErrors(cmd, err)
+		return nil, false
+	}
+
+	keyring := agent.NewKeyring()
+	loaded := 0
+	for _, item := range result.Keys {
+		if len(titles) > 0 && !slices.Contains(titles, item.Title) {
+			continue
+		}
+
+		stored, ok := fetchSSHKey(cmd, client, item.Title)
+		if !ok {
+			return nil, false
+		}
+		key, err := sshkey.ParsePrivateKey([]byte(stored.PrivateKey), nil, item.Title)
+		if err != nil {
+			cmd.PrintErrf("%s: %v\n", item.Title, err)
+			return nil, false
+		}
+		if err := keyring.Add(agent.AddedKey{PrivateKey: key.PrivateKey, Comment: item.Title}); err != nil {
+			cmd.PrintErrf("%s: %v\n", item.Title, err)
+			return nil, false
+		}
+		cmd.Printf("Loaded %s\t%s\n", item.Fingerprint, item.Title)
+		loaded++
+	}
+
+	if loaded == 0 {
+		cmd.PrintErr("No SSH keys to serve")
+		return nil, false
+	}
+	return keyring, true
+}
+
+// serveSSHAgent listens on the unix socket and serves the keyring to every connection until the context is done.
+// The socket is only accessible to the current user and is removed on return.
+func serveSSHAgent(ctx context.Context, cmd *cobra.Command, keyring agent.Agent, socket string) error {
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(socket)
+
+	if err := os.Chmod(socket, 0o600); err != nil {
+		listener.Close()
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	cmd.Printf("SSH_AUTH_SOCK=%s; export SSH_AUTH_SOCK;\n", socket)
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			_ = agent.ServeAgent(keyring, conn)
+		}()
+	}
+}
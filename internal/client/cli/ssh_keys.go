@@ -0,0 +1,440 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"main/internal/client/app/proto"
+	"main/internal/sshkey"
+	pb "main/proto"
+	"os"
+	"strings"
+)
+
+// SetupSSHKeyCommand configures the top-level command for managing SSH keys.
+// Private keys are read from key files and stored encrypted; the public keys stay readable for listing.
+// No error handling needed at this level as it merely organizes sub-commands.
+func SetupSSHKeyCommand(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ssh-key",
+		Short: "Processing of SSH keys",
+		Long: `Processing of SSH keys in the OpenSSH or PEM format; use the ssh-agent command to use them.
+		Includes methods for saving, retrieving, modifying, deleting, and listing.`,
+	}
+	cmd.AddCommand(addSSHKey(client))
+	cmd.AddCommand(getSSHKey(client))
+	cmd.AddCommand(updateSSHKey(client))
+	cmd.AddCommand(removeSSHKey(client))
+	cmd.AddCommand(listSSHKeys(client))
+	cmd.AddCommand(fingerprintsSSHKeys(client))
+	cmd.AddCommand(historySSHKey(client))
+	cmd.AddCommand(restoreSSHKey(client))
+	return cmd
+}
+
+// addSSHKey stores a new SSH key read from a private key file.
+// A passphrase-protected key is decrypted first, with the passphrase prompted for on the standard input;
+// the key is stored without it, encrypted like any other secret.
+// Errors include an unreadable or invalid key file, conflicts (`AlreadyExists`) and authentication issues (`Unauthenticated`).
+func addSSHKey(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add an SSH key",
+		Long:  `Add an SSH key from a private key file, such as ~/.ssh/id_ed25519.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			key, err := readSSHKey(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+			private, err := key.Marshal()
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			placement, err := newPlacement(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			cond := pb.SSHKeyCreateRequest{
+				Title:      title,
+				PublicKey:  key.AuthorizedKey(),
+				PrivateKey: string(private),
+				Placement:  placement,
+			}
+
+			id, ok := recordID(cmd, client, pb.ItemKind_ITEM_KIND_SSH_KEY, title, true)
+			if !ok || !sealText(cmd, client, id, map[string]*string{"ssh.private_key": &cond.PrivateKey}) {
+				return
+			}
+			cond.Id = id
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.SSHKeys.Add(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Save object with title: ", result.Title)
+			}
+		},
+	}
+	addSSHKeyFlags(cmd)
+	addPlacementFlags(cmd, false)
+	err := cmd.MarkFlagRequired("file")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// getSSHKey prints the public key and fingerprint of an SSH key, and with --private its private key too.
+// Possible errors include an absent record (`NotFound`) or an invalid token (`Unauthenticated`).
+func getSSHKey(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get an SSH key",
+		Long:  `Get the public key of an SSH key; the private key is only printed with --private.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			private, err := cmd.Flags().GetBool("private")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			result, ok := fetchSSHKey(cmd, client, title)
+			if ok {
+				cmd.Println("Get object with title:", result.Title)
+				cmd.Println("Fingerprint:", result.Fingerprint)
+				cmd.Println("Public key:", result.PublicKey)
+				if private {
+					cmd.Print(result.PrivateKey)
+				}
+				printPlacement(cmd, result.Placement)
+				printStats(cmd, result)
+			}
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	cmd.Flags().Bool("private", false, "Print the private key as well")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// updateSSHKey replaces the key pair of an SSH key with one read from a new private key file.
+// With --new-title the record is renamed as well, keeping its ID and history. Given only --new-title, --folder,
+// --tag or --clear-tags, the record is renamed, moved and retagged without being rewritten.
+// Errors might arise due to an invalid key file, a missing record (`NotFound`) or an improper token (`Unauthenticated`).
+func updateSSHKey(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update an SSH key",
+		Long:  `Replace the key pair of an SSH key with a new private key file.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			if metadataOnly(cmd, "file", "comment") {
+				updateMetadata(cmd, client, pb.ItemKind_ITEM_KIND_SSH_KEY, title, client.SSHKeys.Rename)
+				return
+			}
+			if !cmd.Flags().Changed("file") {
+				cmd.PrintErr("--file is required unless only the title or placement is changed")
+				return
+			}
+			key, err := readSSHKey(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+			private, err := key.Marshal()
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			placement, replaceFolder, replaceTags, err := newPlacementUpdate(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			cond := pb.SSHKeyUpdateRequest{
+				Title:         title,
+				PublicKey:     key.AuthorizedKey(),
+				PrivateKey:    string(private),
+				Placement:     placement,
+				ReplaceFolder: replaceFolder,
+				ReplaceTags:   replaceTags,
+			}
+
+			id, ok := recordID(cmd, client, pb.ItemKind_ITEM_KIND_SSH_KEY, title, false)
+			if !ok || !sealText(cmd, client, id, map[string]*string{"ssh.private_key": &cond.PrivateKey}) {
+				return
+			}
+			cond.Id = id
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.SSHKeys.Update(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else if current, ok := renameRecord(cmd, client, result.Title, client.SSHKeys.Rename); ok {
+				cmd.Print("Update object with title: ", current)
+			}
+		},
+	}
+	addSSHKeyFlags(cmd)
+	addPlacementFlags(cmd, true)
+	addRenameFlag(cmd)
+	return cmd
+}
+
+// removeSSHKey moves an SSH key to the trash by its title.
+// Errors could stem from the absence of the record (`NotFound`) or invalid token usage (`Unauthenticated`).
+func removeSSHKey(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Delete an SSH key",
+		Long:  `Delete an SSH key.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			cond := pb.SSHKeyRequest{
+				Title: title,
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			_, err = client.SSHKeys.Delete(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Successfully deleted")
+			}
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// listSSHKeys prints the titles of stored SSH keys page by page.
+// Records can be filtered by a title prefix or by how long they went unchanged or unread, and sorted
+// by title or by creation, update or access time; the cursor printed after a full page is passed back
+// with --cursor to fetch the next one.
+// Possible errors include an invalid cursor (`InvalidArgument`) or an invalid token (`Unauthenticated`).
+func listSSHKeys(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List SSH keys",
+		Long:  `List titles of stored SSH keys.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cond, err := newListRequest(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.SSHKeys.List(newCtx, cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				for _, item := range result.Items {
+					printListItem(cmd, item)
+				}
+				printNextCursor(cmd, result.NextCursor)
+			}
+		},
+	}
+	addListFlags(cmd)
+	return cmd
+}
+
+// fingerprintsSSHKeys prints the fingerprint, title and type of every stored SSH key, like ssh-add -l.
+// Only the cleartext public keys are fetched, so no master password is needed.
+// Possible errors include an invalid token (`Unauthenticated`).
+func fingerprintsSSHKeys(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fingerprints",
+		Short: "List fingerprints of SSH keys",
+		Long:  `List the fingerprints and types of all stored SSH keys.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.SSHKeys.PublicKeys(newCtx, &emptypb.Empty{})
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+			for _, key := range result.Keys {
+				keyType, _, _ := strings.Cut(key.PublicKey, " ")
+				cmd.Printf("%s\t%s\t(%s)\n", key.Fingerprint, key.Title, keyType)
+			}
+		},
+	}
+	return cmd
+}
+
+// historySSHKey prints the archived revisions of an SSH key, newest first.
+// Revisions of a deleted key are listed as well.
+// Possible errors include an invalid token (`Unauthenticated`).
+func historySSHKey(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "List previous versions of an SSH key",
+		Long:  `List previous versions of an SSH key kept by the server.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			cond := pb.HistoryRequest{
+				Title: title,
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.SSHKeys.History(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				printHistory(cmd, result)
+			}
+		},
+	}
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// restoreSSHKey brings back a previous version of an SSH key; the current one is archived in turn.
+// A deleted key is added again.
+// Possible errors include a missing or pruned revision (`NotFound`) or an invalid token (`Unauthenticated`).
+func restoreSSHKey(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore a previous version of an SSH key",
+		Long:  `Restore a previous version of an SSH key, as listed by the history command.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cond, err := newRestoreRequest(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.SSHKeys.Restore(newCtx, cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Printf("Restore object with title: %s, revision %d", result.Title, cond.Revision)
+			}
+		},
+	}
+	addRestoreFlags(cmd)
+	return cmd
+}
+
+// fetchSSHKey retrieves an SSH key by title and decrypts its private key if the account uses a vault.
+// Errors are reported to the user; false means the command must stop.
+func fetchSSHKey(cmd *cobra.Command, client *proto.GothKeeperClient, title string) (*pb.SSHKeyResponse, bool) {
+	cond := pb.SSHKeyRequest{
+		Title: title,
+	}
+
+	ctx := cmd.Context()
+	newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+	result, err := client.SSHKeys.Get(newCtx, &cond)
+	if err != nil {
+		dispatchErrors(cmd, err)
+		return nil, false
+	}
+	if !openText(cmd, client, result.Id, map[string]*string{"ssh.private_key": &result.PrivateKey}) {
+		return nil, false
+	}
+	return result, true
+}
+
+// addSSHKeyFlags registers the title, key file and comment flags shared by the add and update commands.
+func addSSHKeyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	cmd.Flags().StringP("file", "f", "", "Private key file in the OpenSSH or PEM format")
+	cmd.Flags().StringP("comment", "c", "", "Comment of the public key; defaults to the comment of the .pub file next to the key")
+	err := cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+}
+
+// readSSHKey parses the private key file given by --file, prompting for its passphrase on the standard
+// input if it is protected. The comment is taken from --comment or else from the .pub file next to the key.
+func readSSHKey(cmd *cobra.Command) (*sshkey.Key, error) {
+	path, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return nil, err
+	}
+	comment, err := cmd.Flags().GetString("comment")
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if comment == "" {
+		if pub, err := os.ReadFile(path + ".pub"); err == nil {
+			_, comment, _ = sshkey.ParsePublicKey(string(pub))
+		}
+	}
+
+	key, err := sshkey.ParsePrivateKey(data, nil, comment)
+	if !errors.Is(err, sshkey.ErrPassphraseRequired) {
+		return key, err
+	}
+
+	cmd.Print("Passphrase: ")
+	line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && line == "" {
+		return nil, err
+	}
+	return sshkey.ParsePrivateKey(data, []byte(strings.TrimRight(line, "\r\n")), comment)
+}
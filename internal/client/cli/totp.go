@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"bufio"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"main/internal/client/app/proto"
+	pb "main/proto"
+	"strings"
+)
+
+// twoFactorCommand sets up the '2fa' command group managing TOTP two-factor authentication.
+func twoFactorCommand(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "2fa",
+		Short: "Two-factor authentication",
+		Long: `The section contains methods for enabling and disabling
+		two-factor authentication with a TOTP authenticator app`,
+	}
+	cmd.AddCommand(enrollTOTP(client))
+	cmd.AddCommand(confirmTOTP(client))
+	cmd.AddCommand(disableTOTP(client))
+	return cmd
+}
+
+// enrollTOTP creates a command generating a TOTP secret for the user.
+// It prints the secret and the otpauth:// URI to add to an authenticator app;
+// two-factor authentication is enabled only after the secret is confirmed.
+func enrollTOTP(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enroll",
+		Short: "Generate a TOTP secret",
+		Long:  `Generate a TOTP secret to add to an authenticator app; confirm it with 'user 2fa confirm'.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Users.EnrollTOTP(newCtx, &emptypb.Empty{})
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+			cmd.Printf("Secret: %s\nURI: %s\n", result.Secret, result.Uri)
+			cmd.Print("Add the secret to your authenticator app and confirm it with a code")
+		},
+	}
+	return cmd
+}
+
+// confirmTOTP creates a command enabling two-factor authentication with a code from the authenticator app.
+// It prints the one-time recovery codes, which cannot be retrieved again.
+func confirmTOTP(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm",
+		Short: "Enable two-factor authentication",
+		Long:  `Enable two-factor authentication with a code from the authenticator app and print the recovery codes.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			code, err := cmd.Flags().GetString("code")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Users.ConfirmTOTP(newCtx, &pb.TOTPCodeRequest{Code: code})
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+			cmd.Println("Two-factor authentication enabled. Store these recovery codes, each works once:")
+			for _, c := range result.RecoveryCodes {
+				cmd.Println(c)
+			}
+		},
+	}
+	cmd.Flags().StringP("code", "c", "", "Code from the authenticator app")
+	err := cmd.MarkFlagRequired("code")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// disableTOTP creates a command disabling two-factor authentication with a TOTP or recovery code.
+func disableTOTP(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable",
+		Short: "Disable two-factor authentication",
+		Long:  `Disable two-factor authentication; a code from the authenticator app or a recovery code is required.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			code, err := cmd.Flags().GetString("code")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			_, err = client.Users.DisableTOTP(newCtx, &pb.TOTPCodeRequest{Code: code})
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Two-factor authentication disabled")
+			}
+		},
+	}
+	cmd.Flags().StringP("code", "c", "", "Code from the authenticator app or a recovery code")
+	err := cmd.MarkFlagRequired("code")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// verifySecondFactor completes a login challenge with the code from --code,
+// prompting for it on the standard input when the flag is empty.
+func verifySecondFactor(cmd *cobra.Command, client *proto.GothKeeperClient, challenge string) (*pb.LoginResponse, error) {
+	code, err := cmd.Flags().GetString("code")
+	if err != nil {
+		return nil, err
+	}
+
+	if code == "" {
+		cmd.Print("Two-factor code: ")
+		line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+		if err != nil && line == "" {
+			return nil, err
+		}
+		code = strings.TrimSpace(line)
+	}
+
+	return client.Users.VerifySecondFactor(cmd.Context(), &pb.SecondFactorRequest{
+		Challenge: challenge,
+		Code:      code,
+	})
+}
@@ -0,0 +1,205 @@
+package cli
+
+import (
+	"fmt"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+	"main/internal/client/app/proto"
+	pb "main/proto"
+	"time"
+)
+
+// trashKinds maps the record kinds accepted by the --kind flag to their protobuf form.
+var trashKinds = map[string]pb.ItemKind{
+	"password": pb.ItemKind_ITEM_KIND_PASSWORD,
+	"card":     pb.ItemKind_ITEM_KIND_CARD,
+	"binary":   pb.ItemKind_ITEM_KIND_BINARY,
+	"note":     pb.ItemKind_ITEM_KIND_NOTE,
+	"otp":      pb.ItemKind_ITEM_KIND_OTP,
+	"ssh-key":  pb.ItemKind_ITEM_KIND_SSH_KEY,
+}
+
+// trashKindNames maps protobuf record kinds to the names printed by the trash commands.
+var trashKindNames = map[pb.ItemKind]string{
+	pb.ItemKind_ITEM_KIND_PASSWORD: "password",
+	pb.ItemKind_ITEM_KIND_CARD:     "card",
+	pb.ItemKind_ITEM_KIND_BINARY:   "binary",
+	pb.ItemKind_ITEM_KIND_NOTE:     "note",
+	pb.ItemKind_ITEM_KIND_OTP:      "otp",
+	pb.ItemKind_ITEM_KIND_SSH_KEY:  "ssh-key",
+}
+
+// SetupTrashCommand configures the top-level command for managing deleted records.
+// Deleted passwords, cards, binaries, notes, authenticator secrets and SSH keys wait in the trash
+// until they are restored or purged.
+// No error handling needed at this level as it merely organizes sub-commands.
+func SetupTrashCommand(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trash",
+		Short: "Processing of deleted records",
+		Long: `Processing of deleted records.
+		Includes methods for listing, restoring, and permanently removing deleted records.`,
+	}
+	cmd.AddCommand(listTrash(client))
+	cmd.AddCommand(restoreTrash(client))
+	cmd.AddCommand(purgeTrash(client))
+	return cmd
+}
+
+// listTrash prints the deleted records as kind, title, deletion date and purge date lines.
+// Records of every kind are listed unless --kind is given.
+// Possible errors include an unknown kind or an invalid token (`Unauthenticated`).
+func listTrash(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List deleted records",
+		Long:  `List deleted records together with the date they will be removed permanently.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			kind, err := trashKindFlag(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			cond := pb.TrashListRequest{
+				Kind: kind,
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Trash.ListTrash(newCtx, &cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+				return
+			}
+			if len(result.Items) == 0 {
+				cmd.Print("Trash is empty")
+				return
+			}
+			for _, item := range result.Items {
+				cmd.Printf("%s\t%s\t%s\t%s\n", trashKindNames[item.Kind], item.Title,
+					item.DeletedAt.AsTime().Local().Format(time.DateTime),
+					item.PurgeAt.AsTime().Local().Format(time.DateTime))
+			}
+		},
+	}
+	cmd.Flags().String("kind", "", "Record kind: password, card, binary, note, otp or ssh-key")
+	return cmd
+}
+
+// restoreTrash moves a deleted record back among the stored records under its title.
+// Possible errors include a record missing from the trash (`NotFound`), a stored record
+// of the same title (`AlreadyExists`) or an invalid token (`Unauthenticated`).
+func restoreTrash(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore a deleted record",
+		Long:  `Restore a deleted record from the trash.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cond, err := newTrashRequest(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Trash.Restore(newCtx, cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Successfully restored: ", result.Title)
+			}
+		},
+	}
+	cmd.Flags().String("kind", "", "Record kind: password, card, binary, note, otp or ssh-key")
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	err := cmd.MarkFlagRequired("kind")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	err = cmd.MarkFlagRequired("title")
+	if err != nil {
+		cmd.PrintErr(err)
+	}
+	return cmd
+}
+
+// purgeTrash permanently removes a deleted record, or with --all every deleted record of the given kind
+// or of every kind. Purged records stay restorable from the history within its retention.
+// Possible errors include a record missing from the trash (`NotFound`) or an invalid token (`Unauthenticated`).
+func purgeTrash(client *proto.GothKeeperClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purge",
+		Short: "Permanently remove deleted records",
+		Long:  `Permanently remove a deleted record, or empty the trash with --all.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			all, err := cmd.Flags().GetBool("all")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+			cond, err := newTrashRequest(cmd)
+			if err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+			if all == (cond.Title != "") {
+				cmd.PrintErr("Either --title or --all is required")
+				return
+			}
+			if cond.Title != "" && cond.Kind == pb.ItemKind_ITEM_KIND_UNSPECIFIED {
+				cmd.PrintErr("--kind is required together with --title")
+				return
+			}
+
+			ctx := cmd.Context()
+			newCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("token", client.Token))
+
+			result, err := client.Trash.Purge(newCtx, cond)
+			if err != nil {
+				dispatchErrors(cmd, err)
+			} else {
+				cmd.Print("Records purged: ", result.Purged)
+			}
+		},
+	}
+	cmd.Flags().String("kind", "", "Record kind: password, card, binary, note, otp or ssh-key")
+	cmd.Flags().StringP("title", "t", "", "Record title")
+	cmd.Flags().Bool("all", false, "Purge every deleted record of the kind, or of every kind")
+	return cmd
+}
+
+// newTrashRequest builds a TrashRequest from the kind and title flags.
+func newTrashRequest(cmd *cobra.Command) (*pb.TrashRequest, error) {
+	kind, err := trashKindFlag(cmd)
+	if err != nil {
+		return nil, err
+	}
+	title, err := cmd.Flags().GetString("title")
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TrashRequest{
+		Kind:  kind,
+		Title: title,
+	}, nil
+}
+
+// trashKindFlag parses the --kind flag; an empty kind selects every kind.
+func trashKindFlag(cmd *cobra.Command) (pb.ItemKind, error) {
+	name, err := cmd.Flags().GetString("kind")
+	if err != nil {
+		return pb.ItemKind_ITEM_KIND_UNSPECIFIED, err
+	}
+	if name == "" {
+		return pb.ItemKind_ITEM_KIND_UNSPECIFIED, nil
+	}
+	kind, ok := trashKinds[name]
+	if !ok {
+		return pb.ItemKind_ITEM_KIND_UNSPECIFIED, fmt.Errorf("unknown record kind: %s", name)
+	}
+	return kind, nil
+}
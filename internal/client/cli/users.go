@@ -3,6 +3,7 @@ package cli
 import (
 	"github.com/spf13/cobra"
 	"main/internal/client/app/proto"
+	"main/internal/client/vault"
 	pb "main/proto"
 )
 
@@ -13,17 +14,27 @@ func SetupUserCommand(client *proto.GothKeeperClient) *cobra.Command {
 		Use:   "user",
 		Short: "User authorization and registration",
 		Long: `The section contains methods for user 
-		authorization and registration based on JWT tokens`,
+		authorization and registration based on JWT tokens,
+		as well as management of login sessions`,
 	}
 	cmd.AddCommand(registerUser(client))
 	cmd.AddCommand(loginUser(client))
+	cmd.AddCommand(logoutUser(client))
+	cmd.AddCommand(whoami(client))
+	cmd.AddCommand(listSessions(client))
+	cmd.AddCommand(revokeSession(client))
+	cmd.AddCommand(twoFactorCommand(client))
+	cmd.AddCommand(certificateCommand(client))
+	cmd.AddCommand(historyRetention(client))
 	return cmd
 }
 
 // registerUser creates a new Cobra command to handle user registration.
 // It retrieves flags from the CLI input, constructs a RegisterRequest protobuf message,
 // sends it to the gRPC server, and handles potential errors including GRPC-specific ones like AlreadyExists.
-// If successful, it prints a success message and stores the returned token in the client instance.
+// With --vault the account is created in zero-knowledge mode: the key derivation parameters are generated
+// here, and every record is encrypted on the client with a key derived from the master password.
+// If successful, it prints a success message and persists the returned tokens for later invocations.
 func registerUser(client *proto.GothKeeperClient) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register",
@@ -39,22 +50,37 @@ func registerUser(client *proto.GothKeeperClient) *cobra.Command {
 				cmd.PrintErr(err)
 			}
 
+			useVault, err := cmd.Flags().GetBool("vault")
+			if err != nil {
+				cmd.PrintErr(err)
+			}
+
 			cond := pb.RegisterRequest{
 				Login:    username,
 				Password: password,
 			}
 
+			var v *vault.Vault
+			if useVault {
+				if v, cond.Vault, err = newVault(cmd); err != nil {
+					dispatchErrors(cmd, err)
+					return
+				}
+			}
+
 			result, err := client.Users.Register(cmd.Context(), &cond)
 			if err != nil {
 				dispatchErrors(cmd, err)
 			} else {
-				client.Token = result.Token
+				client.Vault = v
+				saveSession(cmd, client, username, result.Token, result.RefreshToken, result.ExpiresAt)
 				cmd.Print("Successfully registered")
 			}
 		},
 	}
 	cmd.Flags().StringP("username", "u", "", "Username")
 	cmd.Flags().StringP("password", "p", "", "Password")
+	cmd.Flags().Bool("vault", false, "Encrypt records on the client with a key derived from the master password")
 	err := cmd.MarkFlagRequired("username")
 	if err != nil {
 		cmd.PrintErr(err)
@@ -69,7 +95,10 @@ func registerUser(client *proto.GothKeeperClient) *cobra.Command {
 // loginUser creates a new Cobra command to handle user login.
 // It retrieves flags from the CLI input, constructs a LoginRequest protobuf message,
 // sends it to the gRPC server, and handles potential errors including GRPC-specific ones like NotFound and Unauthenticated.
-// If successful, it prints a success message and stores the returned token in the client instance.
+// If the account has two-factor authentication, the code is taken from --code or prompted for,
+// and the login challenge is completed with it.
+// For vault accounts the master password is verified and the vault key is derived as well.
+// If successful, it prints a success message and persists the returned tokens for later invocations.
 func loginUser(client *proto.GothKeeperClient) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "login",
@@ -91,16 +120,26 @@ func loginUser(client *proto.GothKeeperClient) *cobra.Command {
 			}
 
 			result, err := client.Users.Login(cmd.Context(), &cond)
+			if err == nil && result.Challenge != "" {
+				result, err = verifySecondFactor(cmd, client, result.Challenge)
+			}
 			if err != nil {
 				dispatchErrors(cmd, err)
 			} else {
-				client.Token = result.Token
-				cmd.Print("Successfully registered")
+				if result.Vault != nil {
+					if err := openVault(cmd, client, result.Vault); err != nil {
+						dispatchErrors(cmd, err)
+						return
+					}
+				}
+				saveSession(cmd, client, username, result.Token, result.RefreshToken, result.ExpiresAt)
+				cmd.Print("Successfully logged in")
 			}
 		},
 	}
 	cmd.Flags().StringP("username", "u", "", "Username")
 	cmd.Flags().StringP("password", "p", "", "Password")
+	cmd.Flags().StringP("code", "c", "", "Two-factor authentication or recovery code; prompted for if required and omitted")
 	err := cmd.MarkFlagRequired("username")
 	if err != nil {
 		cmd.PrintErr(err)
@@ -111,3 +150,20 @@ func loginUser(client *proto.GothKeeperClient) *cobra.Command {
 	}
 	return cmd
 }
+
+// newVault generates key derivation parameters for a new vault and derives its key from the master password.
+func newVault(cmd *cobra.Command) (*vault.Vault, *pb.VaultParams, error) {
+	password, err := masterPassword(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	params, err := vault.NewParams()
+	if err != nil {
+		return nil, nil, err
+	}
+	v, err := vault.Unlock(password, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, params, nil
+}
@@ -0,0 +1,186 @@
+package cli
+
+import (
+	"errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"main/internal/client/app/proto"
+	"main/internal/client/vault"
+	pb "main/proto"
+	"os"
+)
+
+// masterPasswordEnv names the environment variable consulted when --master-password is not given.
+const masterPasswordEnv = "GOPHKEEPER_MASTER_PASSWORD"
+
+// errMasterPasswordRequired is reported when a vault account is used without a master password.
+var errMasterPasswordRequired = errors.New("master password required: pass --master-password or set " + masterPasswordEnv)
+
+// masterPassword returns the master password from the persistent flag or the environment.
+func masterPassword(cmd *cobra.Command) (string, error) {
+	password, err := cmd.Flags().GetString("master-password")
+	if err != nil {
+		return "", err
+	}
+	if password == "" {
+		password = os.Getenv(masterPasswordEnv)
+	}
+	if password == "" {
+		return "", errMasterPasswordRequired
+	}
+	return password, nil
+}
+
+// openVault derives the vault key from the master password and keeps it in the client.
+func openVault(cmd *cobra.Command, client *proto.GothKeeperClient, params *pb.VaultParams) error {
+	password, err := masterPassword(cmd)
+	if err != nil {
+		return err
+	}
+	v, err := vault.Open(password, params)
+	if err != nil {
+		return err
+	}
+	client.Vault = v
+	return nil
+}
+
+// unlockVault returns the vault of the logged-in account, asking the server for its parameters
+// on first use. It returns nil if the account relies on server-side encryption.
+func unlockVault(cmd *cobra.Command, client *proto.GothKeeperClient) (*vault.Vault, error) {
+	if client.Vault != nil {
+		return client.Vault, nil
+	}
+
+	ctx := metadata.NewOutgoingContext(cmd.Context(), metadata.Pairs("token", client.Token))
+	result, err := client.Users.Vault(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	if result.Vault == nil {
+		return nil, nil
+	}
+
+	if err := openVault(cmd, client, result.Vault); err != nil {
+		return nil, err
+	}
+	return client.Vault, nil
+}
+
+// recordID returns the ID the fields of the record of the given kind and title are bound to if the account uses
+// a vault, and zero otherwise. The ID of a new record is reserved by the server; create tells whether the command
+// creates the record or writes an existing one, and a record in the other state is reported as the server would.
+// Errors are reported to the user; false means the command must stop.
+func recordID(cmd *cobra.Command, client *proto.GothKeeperClient, kind pb.ItemKind, title string, create bool) (int64, bool) {
+	v, err := unlockVault(cmd, client)
+	if err != nil {
+		dispatchErrors(cmd, err)
+		return 0, false
+	}
+	if v == nil {
+		return 0, true
+	}
+
+	ctx := metadata.NewOutgoingContext(cmd.Context(), metadata.Pairs("token", client.Token))
+	result, err := client.Users.RecordID(ctx, &pb.RecordRequest{
+		Kind:  kind,
+		Title: title,
+	})
+	if err != nil {
+		dispatchErrors(cmd, err)
+		return 0, false
+	}
+
+	switch {
+	case create && !result.Reserved:
+		dispatchErrors(cmd, status.Errorf(codes.AlreadyExists, "Record with title '%s' already exists.", title))
+		return 0, false
+	case !create && result.Reserved:
+		dispatchErrors(cmd, status.Errorf(codes.NotFound, "Record with title '%s' was not found.", title))
+		return 0, false
+	}
+	return result.Id, true
+}
+
+// sealText encrypts the text fields of the record with the given ID in place, keyed by field name,
+// if the account uses a vault. Errors are reported to the user; false means the command must stop.
+func sealText(cmd *cobra.Command, client *proto.GothKeeperClient, id int64, fields map[string]*string) bool {
+	v, err := unlockVault(cmd, client)
+	if err != nil {
+		dispatchErrors(cmd, err)
+		return false
+	}
+	if v == nil {
+		return true
+	}
+
+	for name, value := range fields {
+		if *value, err = v.SealString(id, name, *value); err != nil {
+			dispatchErrors(cmd, err)
+			return false
+		}
+	}
+	return true
+}
+
+// openText decrypts the text fields of the record with the given ID in place, keyed by field name,
+// if the account uses a vault. Errors are reported to the user; false means the command must stop.
+func openText(cmd *cobra.Command, client *proto.GothKeeperClient, id int64, fields map[string]*string) bool {
+	v, err := unlockVault(cmd, client)
+	if err != nil {
+		dispatchErrors(cmd, err)
+		return false
+	}
+	if v == nil {
+		return true
+	}
+
+	for name, value := range fields {
+		if *value, err = v.OpenString(id, name, *value); err != nil {
+			dispatchErrors(cmd, err)
+			return false
+		}
+	}
+	return true
+}
+
+// sealBytes encrypts binary data of the record with the given ID in place if the account uses a vault.
+// Errors are reported to the user; false means the command must stop.
+func sealBytes(cmd *cobra.Command, client *proto.GothKeeperClient, id int64, field string, data *[]byte) bool {
+	v, err := unlockVault(cmd, client)
+	if err != nil {
+		dispatchErrors(cmd, err)
+		return false
+	}
+	if v == nil {
+		return true
+	}
+
+	if *data, err = v.Seal(id, field, *data); err != nil {
+		dispatchErrors(cmd, err)
+		return false
+	}
+	return true
+}
+
+// openBytes decrypts binary data of the record with the given ID in place if the account uses a vault.
+// Errors are reported to the user; false means the command must stop.
+func openBytes(cmd *cobra.Command, client *proto.GothKeeperClient, id int64, field string, data *[]byte) bool {
+	v, err := unlockVault(cmd, client)
+	if err != nil {
+		dispatchErrors(cmd, err)
+		return false
+	}
+	if v == nil {
+		return true
+	}
+
+	if *data, err = v.Open(id, field, *data); err != nil {
+		dispatchErrors(cmd, err)
+		return false
+	}
+	return true
+}
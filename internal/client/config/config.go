@@ -3,21 +3,39 @@ package config
 import (
 	"github.com/caarlos0/env/v6"
 	"go.uber.org/zap"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 )
 
 // DefaultGRPCAddr Predefined constants for default values across different configurations.
 const (
 	DefaultGRPCAddr = "127.0.0.1:5050" // Default gRPC server listening.
+	AppDirName      = "gophkeeper"     // Name of the application directory inside the user's configuration directory.
 )
 
 // Config encapsulates application-wide configuration parameters derived from environment variables and command-line arguments.
 type Config struct {
-	GRPCAddr string // Port where the gRPC server.
+	GRPCAddr      string   // Port where the gRPC server.
+	SessionDir    string   // Directory persisting the login session; empty if it cannot be determined.
+	TLS           bool     // Connects over TLS; implied by any other TLS setting.
+	TLSCAFile     string   // CA bundle verifying the server; empty uses the system roots.
+	TLSServerName string   // Name the server certificate is verified against instead of the dialed host.
+	TLSPins       []string // Base64 SHA-256 hashes of accepted server public keys; empty disables pinning.
+	TLSCertFile   string   // Client certificate for mutual TLS.
+	TLSKeyFile    string   // Private key of the client certificate.
 }
 
 // envConfig captures configuration properties extracted directly from environment variables.
 type envConfig struct {
-	GRPCAddr string `env:"GRPC_SERVER_ADDRESS"` // Environment variable defining the gRPC server.
+	GRPCAddr      string `env:"GRPC_SERVER_ADDRESS"` // Environment variable defining the gRPC server.
+	TLS           string `env:"GRPC_TLS"`            // Environment variable enabling TLS.
+	TLSCAFile     string `env:"TLS_CA_FILE"`         // Environment variable pointing to the CA bundle.
+	TLSServerName string `env:"TLS_SERVER_NAME"`     // Environment variable overriding the verified server name.
+	TLSPins       string `env:"TLS_PINS"`            // Environment variable listing pinned public key hashes separated by commas.
+	TLSCertFile   string `env:"TLS_CERT_FILE"`       // Environment variable pointing to the client certificate.
+	TLSKeyFile    string `env:"TLS_KEY_FILE"`        // Environment variable pointing to the client private key.
 }
 
 // Parse consolidates configuration from multiple sources like environment variables and command-line flags into a unified Config object.
@@ -30,13 +48,40 @@ func Parse(logger *zap.SugaredLogger) *Config {
 	}
 
 	if envCfg.GRPCAddr == "" {
-		logger.Infow("Invalid GRPC addr", "error", err.Error())
+		logger.Infow("GRPC addr is empty")
 		logger.Infow("Using default GRPC:", "addr", DefaultGRPCAddr)
 		cfg.GRPCAddr = DefaultGRPCAddr
 	} else {
 		cfg.GRPCAddr = envCfg.GRPCAddr
 	}
 
+	if envCfg.TLS != "" {
+		cfg.TLS, err = strconv.ParseBool(envCfg.TLS)
+		if err != nil {
+			logger.Infow("Invalid TLS flag", "error", err.Error())
+			cfg.TLS = false
+		}
+	}
+	cfg.TLSCAFile = envCfg.TLSCAFile
+	cfg.TLSServerName = envCfg.TLSServerName
+	for _, pin := range strings.Split(envCfg.TLSPins, ",") {
+		if pin = strings.TrimSpace(pin); pin != "" {
+			cfg.TLSPins = append(cfg.TLSPins, pin)
+		}
+	}
+	cfg.TLSCertFile = envCfg.TLSCertFile
+	cfg.TLSKeyFile = envCfg.TLSKeyFile
+	if cfg.TLSCAFile != "" || cfg.TLSServerName != "" || len(cfg.TLSPins) > 0 || cfg.TLSCertFile != "" {
+		cfg.TLS = true
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		logger.Infow("Session will not be persisted", "error", err.Error())
+	} else {
+		cfg.SessionDir = filepath.Join(dir, AppDirName)
+	}
+
 	return cfg
 }
 
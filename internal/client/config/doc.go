@@ -5,5 +5,12 @@
 //
 //	type Config struct {
 //	    GRPCAddr      string        // Port for the gRPC server.
+//	    SessionDir    string        // Directory persisting the login session ($XDG_CONFIG_HOME/gophkeeper).
+//	    TLS           bool          // Connects over TLS.
+//	    TLSCAFile     string        // CA bundle verifying the server.
+//	    TLSServerName string        // Server name override.
+//	    TLSPins       []string      // Pinned server public key hashes.
+//	    TLSCertFile   string        // Client certificate for mutual TLS.
+//	    TLSKeyFile    string        // Private key of the client certificate.
 //	}
 package config
@@ -0,0 +1,10 @@
+// Package session persists the login session of the CLI between invocations.
+// The credentials are stored unencrypted under $XDG_CONFIG_HOME/gophkeeper. Their only protection is the
+// file mode 0600 of the credentials file: anyone able to read files as the user, or a backup copying them,
+// can use the session. Files other users can access are refused.
+//
+// Main components:
+//
+//   - Credentials: The server address, login and token pair of a session.
+//   - Store: Loads, saves and clears the credentials file.
+package session
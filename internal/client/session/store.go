@@ -0,0 +1,121 @@
+package session
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// File names and permissions inside the session directory.
+const (
+	credentialsFile = "credentials" // Credentials in plain JSON.
+	dirMode         = 0o700         // Permissions of the session directory.
+	fileMode        = 0o600         // Permissions of the credentials file.
+)
+
+// Error definitions for session store operations.
+var (
+	ErrNoSession           = errors.New("not logged in")                             // No credentials are stored.
+	ErrInsecurePermissions = errors.New("session file is accessible by other users") // The file is readable or writable by group or others.
+	ErrCorruptSession      = errors.New("session file is corrupt")                   // The credentials cannot be parsed.
+)
+
+// Credentials is the persisted state of a login session.
+type Credentials struct {
+	Server    string    `json:"server"`     // Address of the server the session belongs to.
+	Login     string    `json:"login"`      // Login of the user.
+	Token     string    `json:"token"`      // Access token.
+	Refresh   string    `json:"refresh"`    // Refresh token.
+	ExpiresAt time.Time `json:"expires_at"` // Expiration time of the access token.
+}
+
+// Store keeps the credentials of the current session in a directory.
+type Store struct {
+	dir string // Directory holding the credentials file.
+}
+
+// NewStore creates a store persisting credentials in the given directory.
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Load reads the stored credentials.
+// It returns ErrNoSession if nothing is stored.
+func (s *Store) Load() (*Credentials, error) {
+	data, err := s.read(credentialsFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNoSession
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result Credentials
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, ErrCorruptSession
+	}
+	return &result, nil
+}
+
+// Save stores the credentials, creating the directory on first use.
+// The file is replaced atomically, so an interrupted write never leaves a truncated session.
+func (s *Store) Save(c Credentials) error {
+	if err := os.MkdirAll(s.dir, dirMode); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return s.write(credentialsFile, data)
+}
+
+// Clear removes the stored credentials.
+func (s *Store) Clear() error {
+	err := os.Remove(filepath.Join(s.dir, credentialsFile))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// read returns the content of a file in the session directory, refusing files other users can access.
+func (s *Store) read(name string) ([]byte, error) {
+	path := filepath.Join(s.dir, name)
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode().Perm()&0o077 != 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInsecurePermissions, path)
+	}
+	return os.ReadFile(path)
+}
+
+// write atomically replaces a file in the session directory with owner-only permissions.
+func (s *Store) write(name string, data []byte) error {
+	tmp, err := os.CreateTemp(s.dir, name+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(fileMode); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(s.dir, name))
+}
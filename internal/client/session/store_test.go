@@ -0,0 +1,82 @@
+package session
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStoreRoundTrip(t *testing.T) {
+	s := NewStore(filepath.Join(t.TempDir(), "gophkeeper"))
+	want := Credentials{
+		Server:    "localhost:3200",
+		Login:     "alice",
+		Token:     "access",
+		Refresh:   "refresh",
+		ExpiresAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	if _, err := s.Load(); !errors.Is(err, ErrNoSession) {
+		t.Fatalf("Load() before Save error = %v, want %v", err, ErrNoSession)
+	}
+	if err := s.Save(want); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := os.Stat(filepath.Join(s.dir, credentialsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != fileMode {
+		t.Errorf("credentials file mode = %o, want %o", info.Mode().Perm(), fileMode)
+	}
+
+	got, err := s.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != want {
+		t.Errorf("Load() = %+v, want %+v", *got, want)
+	}
+
+	if err := s.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Load(); !errors.Is(err, ErrNoSession) {
+		t.Errorf("Load() after Clear error = %v, want %v", err, ErrNoSession)
+	}
+	if err := s.Clear(); err != nil {
+		t.Errorf("Clear() without a session error = %v", err)
+	}
+}
+
+func TestStoreLoadRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		mode    os.FileMode
+		wantErr error
+	}{
+		{"readable by group", `{"login":"alice"}`, 0o640, ErrInsecurePermissions},
+		{"readable by others", `{"login":"alice"}`, 0o604, ErrInsecurePermissions},
+		{"not JSON", "\x01\x02", fileMode, ErrCorruptSession},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, credentialsFile)
+			if err := os.WriteFile(path, []byte(tt.data), tt.mode); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Chmod(path, tt.mode); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := NewStore(dir).Load(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Load() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+// Package vault implements the client side of the zero-knowledge vault mode.
+// A vault key is derived from the user's master password with Argon2id, using a per-user salt
+// and parameters stored on the server. Every record field is encrypted with AES-256-GCM before
+// it is sent, so the server only stores opaque blobs and never learns the key.
+//
+// Main components:
+//
+//   - NewParams: Generates fresh key derivation parameters with a random salt.
+//   - Unlock: Derives the vault key from the master password and creates the check value.
+//   - Open: Derives the vault key and verifies it against the stored check value.
+//   - Vault: Encrypts and decrypts record fields bound to the user, the record ID and the field name.
+//     The server reserves the ID of a new record before it is encrypted.
+package vault
@@ -0,0 +1,205 @@
+package vault
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/argon2"
+	"io"
+	"main/internal/stream"
+	pb "main/proto"
+)
+
+// Default Argon2id parameters for new vaults.
+const (
+	DefaultTime    = 3         // Number of passes over the memory.
+	DefaultMemory  = 64 * 1024 // Memory size in KiB.
+	DefaultThreads = 4         // Degree of parallelism.
+	SaltSize       = 16        // Length of the random salt in bytes.
+	KeySize        = 32        // Length of the derived key, selecting AES-256.
+)
+
+// checkValue is the known plaintext encrypted into the check value of a vault.
+const checkValue = "gophkeeper-vault"
+
+// boundStream marks streams whose key is bound to the user and record; it precedes the stream header,
+// whose version byte never takes this value, so a stream of another format is rejected up front.
+const boundStream = 0x80
+
+// Error definitions for vault operations.
+var (
+	ErrWrongMasterPassword = errors.New("wrong master password")        // The derived key does not open the check value.
+	ErrCiphertextTooShort  = errors.New("vault ciphertext too short")   // The data to decrypt cannot even hold a nonce.
+	ErrInvalidParams       = errors.New("invalid vault key parameters") // The server returned unusable parameters.
+)
+
+// Vault encrypts and decrypts record fields with a key derived from the master password.
+// Every ciphertext is bound to the user, the record and the field it belongs to.
+type Vault struct {
+	key    []byte      // Derived vault key, from which stream keys are derived.
+	gcm    cipher.AEAD // AES-GCM instance keyed with the derived vault key.
+	userID int64       // ID of the user owning the vault, as reported by the server.
+}
+
+// NewParams generates key derivation parameters with a random salt and the default costs.
+// The check value is left empty; Unlock fills it in.
+func NewParams() (*pb.VaultParams, error) {
+	salt := make([]byte, SaltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+	return &pb.VaultParams{
+		Salt:    salt,
+		Time:    DefaultTime,
+		Memory:  DefaultMemory,
+		Threads: DefaultThreads,
+	}, nil
+}
+
+// Unlock derives the vault key for new parameters and stores the matching check value in them.
+func Unlock(masterPassword string, params *pb.VaultParams) (*Vault, error) {
+	v, err := derive(masterPassword, params)
+	if err != nil {
+		return nil, err
+	}
+	params.Check, err = v.seal(fieldData("check"), []byte(checkValue))
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Open derives the vault key from the master password and verifies it against the check value.
+// ErrWrongMasterPassword is returned if the password does not match the one the vault was created with.
+func Open(masterPassword string, params *pb.VaultParams) (*Vault, error) {
+	v, err := derive(masterPassword, params)
+	if err != nil {
+		return nil, err
+	}
+	check, err := v.open(fieldData("check"), params.Check)
+	if err != nil || string(check) != checkValue {
+		return nil, ErrWrongMasterPassword
+	}
+	return v, nil
+}
+
+// derive runs Argon2id with the given parameters and sets up AES-GCM with the resulting key.
+func derive(masterPassword string, params *pb.VaultParams) (*Vault, error) {
+	if params == nil || len(params.Salt) == 0 || params.Time == 0 || params.Memory == 0 ||
+		params.Threads == 0 || params.Threads > 255 {
+		return nil, ErrInvalidParams
+	}
+
+	key := argon2.IDKey([]byte(masterPassword), params.Salt, params.Time, params.Memory, uint8(params.Threads), KeySize)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Vault{key: key, gcm: gcm, userID: params.UserId}, nil
+}
+
+// Seal encrypts data of the named field of the record with the given ID. The user ID, the record ID and
+// the field name are authenticated as associated data, so a ciphertext moved into another field,
+// record or account fails to decrypt.
+func (v *Vault) Seal(id int64, field string, data []byte) ([]byte, error) {
+	return v.seal(v.recordData(id, field), data)
+}
+
+// Open decrypts data of the named field of the record with the given ID produced by Seal.
+func (v *Vault) Open(id int64, field string, ciphertext []byte) ([]byte, error) {
+	return v.open(v.recordData(id, field), ciphertext)
+}
+
+// SealString encrypts a text field and encodes the ciphertext with base64 for string protobuf fields.
+func (v *Vault) SealString(id int64, field, s string) (string, error) {
+	res, err := v.Seal(id, field, []byte(s))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(res), nil
+}
+
+// OpenString decodes and decrypts a text field produced by SealString.
+func (v *Vault) OpenString(id int64, field, s string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	res, err := v.Open(id, field, raw)
+	if err != nil {
+		return "", err
+	}
+	return string(res), nil
+}
+
+// SealStream returns a writer encrypting the content of the named field of the record with the given ID
+// into w segment by segment, for content too large to hold in memory. Close must be called to finish the stream.
+func (v *Vault) SealStream(id int64, field string, w io.Writer) (*stream.Writer, error) {
+	key, err := v.streamKey(v.recordData(id, field))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write([]byte{boundStream}); err != nil {
+		return nil, err
+	}
+	return stream.NewWriter(w, key)
+}
+
+// OpenStream returns a reader decrypting content of the named field of the record with the given ID
+// produced by SealStream. A stream without the marker SealStream writes is rejected with stream.ErrInvalidHeader.
+func (v *Vault) OpenStream(id int64, field string, r io.Reader) (*stream.Reader, error) {
+	var first [1]byte
+	if _, err := io.ReadFull(r, first[:]); err != nil || first[0] != boundStream {
+		return nil, stream.ErrInvalidHeader
+	}
+
+	key, err := v.streamKey(v.recordData(id, field))
+	if err != nil {
+		return nil, err
+	}
+	return stream.NewReader(r, key)
+}
+
+// seal encrypts data with the given associated data.
+func (v *Vault) seal(ad, data []byte) ([]byte, error) {
+	nonce := make([]byte, v.gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return v.gcm.Seal(nonce, nonce, data, ad), nil
+}
+
+// open decrypts data produced by seal with the same associated data.
+func (v *Vault) open(ad, ciphertext []byte) ([]byte, error) {
+	nonceSize := v.gcm.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, ErrCiphertextTooShort
+	}
+	return v.gcm.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], ad)
+}
+
+// streamKey derives the stream key bound to the given associated data from the vault key.
+func (v *Vault) streamKey(ad []byte) ([]byte, error) {
+	return hkdf.Key(sha256.New, v.key, nil, string(ad), stream.KeySize)
+}
+
+// recordData returns the associated data binding a ciphertext to the user, the record and the field.
+func (v *Vault) recordData(id int64, field string) []byte {
+	return fmt.Appendf(nil, "gophkeeper-vault/%d/%d/%s", v.userID, id, field)
+}
+
+// fieldData returns the associated data binding a ciphertext to its field only, as used for the check value,
+// which belongs to no record.
+func fieldData(field string) []byte {
+	return []byte("gophkeeper-vault/" + field)
+}
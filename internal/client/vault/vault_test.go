@@ -0,0 +1,179 @@
+package vault
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"main/internal/stream"
+	pb "main/proto"
+	"testing"
+)
+
+// testParams returns cheap key derivation parameters of the given user, so the tests do not spend
+// the default memory and passes.
+func testParams(userID int64) *pb.VaultParams {
+	return &pb.VaultParams{
+		Salt:    []byte("0123456789abcdef"),
+		Time:    1,
+		Memory:  64,
+		Threads: 1,
+		UserId:  userID,
+	}
+}
+
+// testVault unlocks a vault of the given user with a fixed master password.
+func testVault(t *testing.T, userID int64) *Vault {
+	t.Helper()
+
+	v, err := Unlock("master", testParams(userID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestOpen(t *testing.T) {
+	params := testParams(1)
+	if _, err := Unlock("master", params); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		params   *pb.VaultParams
+		wantErr  error
+	}{
+		{"right password", "master", params, nil},
+		{"wrong password", "Master", params, ErrWrongMasterPassword},
+		{"missing check value", "master", testParams(1), ErrWrongMasterPassword},
+		{"missing parameters", "master", nil, ErrInvalidParams},
+		{"missing salt", "master", &pb.VaultParams{Time: 1, Memory: 64, Threads: 1}, ErrInvalidParams},
+		{"too many threads", "master", &pb.VaultParams{Salt: params.Salt, Time: 1, Memory: 64, Threads: 256}, ErrInvalidParams},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Open(tt.password, tt.params)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Open() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSealOpen(t *testing.T) {
+	v := testVault(t, 1)
+	other := testVault(t, 2)
+
+	ct, err := v.Seal(42, "password", []byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		vault   *Vault
+		id      int64
+		field   string
+		data    []byte
+		wantErr bool
+	}{
+		{"same record and field", v, 42, "password", ct, false},
+		{"other record", v, 43, "password", ct, true},
+		{"other field", v, 42, "login", ct, true},
+		{"other user", other, 42, "password", ct, true},
+		{"tampered", v, 42, "password", append(bytes.Clone(ct[:len(ct)-1]), ct[len(ct)-1]^1), true},
+		{"too short", v, 42, "password", ct[:4], true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.vault.Open(tt.id, tt.field, tt.data)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Open() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Open() error = %v", err)
+			}
+			if string(got) != "secret" {
+				t.Errorf("Open() = %q, want %q", got, "secret")
+			}
+		})
+	}
+}
+
+func TestSealOpenString(t *testing.T) {
+	v := testVault(t, 1)
+
+	s, err := v.SealString(42, "body", "note")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := v.OpenString(42, "body", s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "note" {
+		t.Errorf("OpenString() = %q, want %q", got, "note")
+	}
+	if _, err := v.OpenString(42, "body", "not base64!"); err == nil {
+		t.Error("OpenString() accepted invalid base64")
+	}
+}
+
+func TestSealOpenStream(t *testing.T) {
+	v := testVault(t, 1)
+	other := testVault(t, 2)
+	content := bytes.Repeat([]byte("content"), 10000)
+
+	var sealed bytes.Buffer
+	w, err := v.SealStream(42, "data", &sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	ct := sealed.Bytes()
+
+	tests := []struct {
+		name    string
+		vault   *Vault
+		id      int64
+		field   string
+		data    []byte
+		wantErr error
+	}{
+		{"same record and field", v, 42, "data", ct, nil},
+		{"other record", v, 43, "data", ct, stream.ErrInvalidStream},
+		{"other field", v, 42, "name", ct, stream.ErrInvalidStream},
+		{"other user", other, 42, "data", ct, stream.ErrInvalidStream},
+		{"without marker", v, 42, "data", ct[1:], stream.ErrInvalidHeader},
+		{"empty", v, 42, "data", nil, stream.ErrInvalidHeader},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := openStream(tt.vault, tt.id, tt.field, tt.data)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("OpenStream() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !bytes.Equal(got, content) {
+				t.Error("OpenStream() returned other content")
+			}
+		})
+	}
+}
+
+// openStream decrypts a whole stream, reporting errors of opening and of reading alike.
+func openStream(v *Vault, id int64, field string, data []byte) ([]byte, error) {
+	r, err := v.OpenStream(id, field, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
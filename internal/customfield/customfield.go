@@ -0,0 +1,105 @@
+package customfield
+
+import (
+	"errors"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Supported field types.
+const (
+	Text   = "text"   // Plain text shown as is.
+	Hidden = "hidden" // Secret text masked unless revealed, such as a PIN or a security answer.
+	URL    = "url"    // Absolute URL, such as the sign-in page of the site.
+	Email  = "email"  // Email address.
+)
+
+// Limits of the custom fields of a single entry.
+const (
+	MaxFields  = 64  // Most custom fields an entry may carry.
+	MaxNameLen = 255 // Longest field name in bytes.
+)
+
+// Types lists the supported field types.
+var Types = []string{Text, Hidden, URL, Email}
+
+// Errors returned for malformed fields.
+var (
+	ErrUnknownType   = errors.New("unknown custom field type")   // The type is not one of Types.
+	ErrInvalidName   = errors.New("invalid custom field name")   // The name is empty or too long.
+	ErrInvalidValue  = errors.New("invalid custom field value")  // The value does not match the field type.
+	ErrTooManyFields = errors.New("too many custom fields")      // The entry carries more than MaxFields fields.
+	ErrDuplicateName = errors.New("duplicate custom field name") // Two fields of the entry share a name.
+)
+
+// Field is a single named value of a password entry.
+type Field struct {
+	Name  string // Name of the field, unique within the entry.
+	Type  string // One of Types.
+	Value string // Value of the field.
+}
+
+// ValidType reports whether typ is a supported field type.
+func ValidType(typ string) bool {
+	return slices.Contains(Types, typ)
+}
+
+// Validate checks the name and value of a field against its type.
+func Validate(f Field) error {
+	if !ValidType(f.Type) {
+		return ErrUnknownType
+	}
+	if strings.TrimSpace(f.Name) == "" || len(f.Name) > MaxNameLen {
+		return ErrInvalidName
+	}
+
+	switch f.Type {
+	case URL:
+		u, err := url.Parse(f.Value)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return ErrInvalidValue
+		}
+	case Email:
+		addr, err := mail.ParseAddress(f.Value)
+		if err != nil || addr.Address != f.Value {
+			return ErrInvalidValue
+		}
+	}
+	return nil
+}
+
+// ValidateAll checks every field of an entry, their number and that their names are unique.
+func ValidateAll(fields []Field) error {
+	if len(fields) > MaxFields {
+		return ErrTooManyFields
+	}
+
+	names := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if err := Validate(f); err != nil {
+			return err
+		}
+		if names[f.Name] {
+			return ErrDuplicateName
+		}
+		names[f.Name] = true
+	}
+	return nil
+}
+
+// Parse parses a field given as name=value or type:name=value, where type is one of Types;
+// fields without a type prefix are text fields. The value may contain further equal signs.
+func Parse(s string) (Field, error) {
+	name, value, ok := strings.Cut(s, "=")
+	if !ok {
+		return Field{}, ErrInvalidName
+	}
+
+	f := Field{Name: name, Type: Text, Value: value}
+	if typ, rest, ok := strings.Cut(name, ":"); ok && ValidType(typ) {
+		f.Type, f.Name = typ, rest
+	}
+	return f, Validate(f)
+}
@@ -0,0 +1,91 @@
+package customfield
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   Field
+		wantErr error
+	}{
+		{"text", Field{Name: "PIN", Type: Text, Value: "anything goes"}, nil},
+		{"empty text", Field{Name: "note", Type: Text}, nil},
+		{"hidden", Field{Name: "answer", Type: Hidden, Value: "Rex"}, nil},
+		{"url", Field{Name: "login", Type: URL, Value: "https://example.com/login"}, nil},
+		{"email", Field{Name: "recovery", Type: Email, Value: "alice@example.com"}, nil},
+		{"unknown type", Field{Name: "x", Type: "number", Value: "1"}, ErrUnknownType},
+		{"blank name", Field{Name: "  ", Type: Text}, ErrInvalidName},
+		{"long name", Field{Name: strings.Repeat("n", MaxNameLen+1), Type: Text}, ErrInvalidName},
+		{"relative url", Field{Name: "login", Type: URL, Value: "/login"}, ErrInvalidValue},
+		{"url without host", Field{Name: "login", Type: URL, Value: "mailto:alice@example.com"}, ErrInvalidValue},
+		{"email with display name", Field{Name: "recovery", Type: Email, Value: "Alice <alice@example.com>"}, ErrInvalidValue},
+		{"not an email", Field{Name: "recovery", Type: Email, Value: "alice"}, ErrInvalidValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(tt.field); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAll(t *testing.T) {
+	many := make([]Field, MaxFields+1)
+	for i := range many {
+		many[i] = Field{Name: fmt.Sprint("field", i), Type: Text}
+	}
+
+	tests := []struct {
+		name    string
+		fields  []Field
+		wantErr error
+	}{
+		{"none", nil, nil},
+		{"most fields", many[:MaxFields], nil},
+		{"too many fields", many, ErrTooManyFields},
+		{"duplicate name", []Field{{Name: "PIN", Type: Text}, {Name: "PIN", Type: Hidden}}, ErrDuplicateName},
+		{"invalid field", []Field{{Name: "PIN", Type: Text}, {Name: "", Type: Text}}, ErrInvalidName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateAll(tt.fields); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateAll() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Field
+		wantErr error
+	}{
+		{"text without prefix", "PIN=1234", Field{Name: "PIN", Type: Text, Value: "1234"}, nil},
+		{"typed", "hidden:answer=Rex", Field{Name: "answer", Type: Hidden, Value: "Rex"}, nil},
+		{"value with equal signs", "url:login=https://example.com/?a=b", Field{Name: "login", Type: URL, Value: "https://example.com/?a=b"}, nil},
+		{"colon in a text name", "time:zone=UTC", Field{Name: "time:zone", Type: Text, Value: "UTC"}, nil},
+		{"empty value", "note=", Field{Name: "note", Type: Text}, nil},
+		{"missing equal sign", "PIN", Field{}, ErrInvalidName},
+		{"missing name", "=1234", Field{Name: "", Type: Text, Value: "1234"}, ErrInvalidName},
+		{"value not matching type", "email:recovery=alice", Field{Name: "recovery", Type: Email, Value: "alice"}, ErrInvalidValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+// Package customfield describes the typed custom fields password entries carry besides their login
+// and password, such as the site URL, answers to security questions or an account number.
+//
+// The package is shared by the server, which validates the fields of regular accounts, and the client,
+// which parses fields given on the command line and validates them before a vault account encrypts them.
+package customfield
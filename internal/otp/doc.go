@@ -0,0 +1,8 @@
+// Package otp implements time-based one-time passwords (TOTP, RFC 6238) for the authenticator
+// secrets users keep in their vault, as well as the otpauth:// URIs those secrets are shared in.
+// Unlike the second factor of the server, which always issues 6-digit HMAC-SHA1 codes, stored
+// secrets come from third-party services and may use SHA-256 or SHA-512, 6 to 8 digits and any period.
+//
+// The package is shared by the server, which generates codes of regular accounts, and the client,
+// which parses URIs and generates codes of zero-knowledge vault accounts the server cannot decrypt.
+package otp
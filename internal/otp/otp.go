@@ -0,0 +1,188 @@
+package otp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Supported HMAC algorithms, named as in otpauth:// URIs.
+const (
+	SHA1   = "SHA1"
+	SHA256 = "SHA256"
+	SHA512 = "SHA512"
+)
+
+// Defaults applied to parameters missing from an otpauth:// URI, and the accepted ranges.
+const (
+	DefaultAlgorithm = SHA1 // HMAC algorithm used by most services.
+	DefaultDigits    = 6    // Number of digits in a code.
+	DefaultPeriod    = 30   // Lifetime of a code in seconds.
+	MinDigits        = 6    // Fewest digits RFC 4226 allows.
+	MaxDigits        = 8    // Most digits authenticator apps support.
+	MaxPeriod        = 300  // Longest accepted code lifetime in seconds.
+)
+
+// Errors returned for malformed secrets and URIs.
+var (
+	ErrInvalidURI        = errors.New("invalid otpauth URI")             // The URI is not an otpauth://totp URI.
+	ErrInvalidSecret     = errors.New("invalid OTP secret")              // The secret is not base32 or is empty.
+	ErrInvalidParameters = errors.New("unsupported OTP parameters")      // The algorithm, digits or period are out of range.
+	ErrCounterBased      = errors.New("counter-based OTP not supported") // The URI describes an HOTP secret.
+)
+
+// secretEncoding is the unpadded base32 encoding secrets are exchanged in.
+var secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Key describes an authenticator secret together with the parameters its codes are generated with.
+type Key struct {
+	Issuer    string // Service the secret belongs to.
+	Account   string // Account name within the service.
+	Secret    string // Base32-encoded secret.
+	Algorithm string // HMAC algorithm: SHA1, SHA256 or SHA512.
+	Digits    int    // Number of digits in a code.
+	Period    int    // Lifetime of a code in seconds.
+}
+
+// ParseURI parses an otpauth://totp URI as produced by the QR codes of authenticator enrollments.
+// The issuer is taken from the issuer parameter or else from the label prefix;
+// missing parameters fall back to the defaults.
+func ParseURI(uri string) (*Key, error) {
+	u, err := url.Parse(strings.TrimSpace(uri))
+	if err != nil || u.Scheme != "otpauth" {
+		return nil, ErrInvalidURI
+	}
+	switch strings.ToLower(u.Host) {
+	case "totp":
+	case "hotp":
+		return nil, ErrCounterBased
+	default:
+		return nil, ErrInvalidURI
+	}
+
+	key := &Key{
+		Algorithm: DefaultAlgorithm,
+		Digits:    DefaultDigits,
+		Period:    DefaultPeriod,
+	}
+
+	label := strings.TrimPrefix(u.Path, "/")
+	if issuer, account, ok := strings.Cut(label, ":"); ok {
+		key.Issuer = strings.TrimSpace(issuer)
+		key.Account = strings.TrimSpace(account)
+	} else {
+		key.Account = strings.TrimSpace(label)
+	}
+
+	q := u.Query()
+	if issuer := q.Get("issuer"); issuer != "" {
+		key.Issuer = issuer
+	}
+	key.Secret = q.Get("secret")
+	if _, err := DecodeSecret(key.Secret); err != nil {
+		return nil, err
+	}
+	if algorithm := q.Get("algorithm"); algorithm != "" {
+		key.Algorithm = strings.ToUpper(algorithm)
+	}
+	if digits := q.Get("digits"); digits != "" {
+		if key.Digits, err = strconv.Atoi(digits); err != nil {
+			return nil, ErrInvalidParameters
+		}
+	}
+	if period := q.Get("period"); period != "" {
+		if key.Period, err = strconv.Atoi(period); err != nil {
+			return nil, ErrInvalidParameters
+		}
+	}
+
+	if err := Validate(key.Algorithm, key.Digits, key.Period); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Validate checks that codes can be generated with the given parameters.
+func Validate(algorithm string, digits, period int) error {
+	if _, err := newHash(algorithm); err != nil {
+		return err
+	}
+	if digits < MinDigits || digits > MaxDigits || period < 1 || period > MaxPeriod {
+		return ErrInvalidParameters
+	}
+	return nil
+}
+
+// DecodeSecret decodes a base32 secret, tolerating lowercase letters, spaces and padding.
+func DecodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	raw, err := secretEncoding.DecodeString(strings.TrimRight(secret, "="))
+	if err != nil || len(raw) == 0 {
+		return nil, ErrInvalidSecret
+	}
+	return raw, nil
+}
+
+// Code computes the code valid at t and the time left until it expires.
+func Code(secret []byte, algorithm string, digits, period int, t time.Time) (string, time.Duration, error) {
+	if err := Validate(algorithm, digits, period); err != nil {
+		return "", 0, err
+	}
+
+	step := t.Unix() / int64(period)
+	expires := time.Unix((step+1)*int64(period), 0)
+
+	code, err := HOTP(secret, algorithm, digits, uint64(step))
+	if err != nil {
+		return "", 0, err
+	}
+	return code, expires.Sub(t), nil
+}
+
+// HOTP computes the code of the given counter as defined by RFC 4226; TOTP uses the time step as counter.
+func HOTP(secret []byte, algorithm string, digits int, counter uint64) (string, error) {
+	h, err := newHash(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(h, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod), nil
+}
+
+// newHash returns the hash constructor of an HMAC algorithm.
+func newHash(algorithm string) (func() hash.Hash, error) {
+	switch algorithm {
+	case SHA1:
+		return sha1.New, nil
+	case SHA256:
+		return sha256.New, nil
+	case SHA512:
+		return sha512.New, nil
+	default:
+		return nil, ErrInvalidParameters
+	}
+}
@@ -0,0 +1,160 @@
+package otp
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Shared secrets of the RFC 6238 test vectors for each algorithm.
+var rfcSecrets = map[string][]byte{
+	SHA1:   []byte("12345678901234567890"),
+	SHA256: []byte("12345678901234567890123456789012"),
+	SHA512: []byte("1234567890123456789012345678901234567890123456789012345678901234"),
+}
+
+func TestCode(t *testing.T) {
+	// Eight-digit TOTP values of RFC 6238, appendix B.
+	tests := []struct {
+		unix      int64
+		algorithm string
+		want      string
+	}{
+		{59, SHA1, "94287082"},
+		{59, SHA256, "46119246"},
+		{59, SHA512, "90693936"},
+		{1111111109, SHA1, "07081804"},
+		{1111111109, SHA256, "68084774"},
+		{1111111109, SHA512, "25091201"},
+		{1234567890, SHA1, "89005924"},
+		{1234567890, SHA256, "91819424"},
+		{1234567890, SHA512, "93441116"},
+		{20000000000, SHA1, "65353130"},
+		{20000000000, SHA256, "77737706"},
+		{20000000000, SHA512, "47863826"},
+	}
+	for _, tt := range tests {
+		code, left, err := Code(rfcSecrets[tt.algorithm], tt.algorithm, 8, 30, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("Code(%d, %s) error = %v", tt.unix, tt.algorithm, err)
+		}
+		if code != tt.want {
+			t.Errorf("Code(%d, %s) = %s, want %s", tt.unix, tt.algorithm, code, tt.want)
+		}
+		if want := time.Duration(30-tt.unix%30) * time.Second; left != want {
+			t.Errorf("Code(%d, %s) expires in %s, want %s", tt.unix, tt.algorithm, left, want)
+		}
+	}
+}
+
+func TestCodeInvalidParameters(t *testing.T) {
+	if _, _, err := Code(rfcSecrets[SHA1], "MD5", 6, 30, time.Now()); !errors.Is(err, ErrInvalidParameters) {
+		t.Errorf("Code() error = %v, want %v", err, ErrInvalidParameters)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		algorithm string
+		digits    int
+		period    int
+		wantErr   bool
+	}{
+		{"defaults", DefaultAlgorithm, DefaultDigits, DefaultPeriod, false},
+		{"longest codes", SHA512, MaxDigits, MaxPeriod, false},
+		{"unknown algorithm", "MD5", 6, 30, true},
+		{"lowercase algorithm", "sha1", 6, 30, true},
+		{"too few digits", SHA1, MinDigits - 1, 30, true},
+		{"too many digits", SHA1, MaxDigits + 1, 30, true},
+		{"zero period", SHA1, 6, 0, true},
+		{"too long period", SHA1, 6, MaxPeriod + 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.algorithm, tt.digits, tt.period)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecodeSecret(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  string
+		want    string
+		wantErr bool
+	}{
+		{"unpadded", "GEZDGNBVGY3TQOJQ", "1234567890", false},
+		{"lowercase with spaces", "gezd gnbv gy3t qojq", "1234567890", false},
+		{"padded", "GEZDGNBV======", "12345", false},
+		{"not base32", "GEZDGNB1", "", true},
+		{"empty", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeSecret(tt.secret)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidSecret) {
+					t.Errorf("DecodeSecret() error = %v, want %v", err, ErrInvalidSecret)
+				}
+				return
+			}
+			if err != nil || string(got) != tt.want {
+				t.Errorf("DecodeSecret() = %q, %v; want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseURI(t *testing.T) {
+	tests := []struct {
+		name    string
+		uri     string
+		want    Key
+		wantErr error
+	}{
+		{"defaults", "otpauth://totp/alice?secret=GEZDGNBVGY3TQOJQ",
+			Key{Account: "alice", Secret: "GEZDGNBVGY3TQOJQ", Algorithm: SHA1, Digits: 6, Period: 30}, nil},
+		{"issuer from label", "otpauth://totp/Example:alice@example.com?secret=GEZDGNBVGY3TQOJQ",
+			Key{Issuer: "Example", Account: "alice@example.com", Secret: "GEZDGNBVGY3TQOJQ", Algorithm: SHA1, Digits: 6, Period: 30}, nil},
+		{"issuer parameter wins", "otpauth://totp/Label:alice?secret=GEZDGNBVGY3TQOJQ&issuer=Example",
+			Key{Issuer: "Example", Account: "alice", Secret: "GEZDGNBVGY3TQOJQ", Algorithm: SHA1, Digits: 6, Period: 30}, nil},
+		{"all parameters", "  otpauth://TOTP/Example:alice?secret=GEZDGNBVGY3TQOJQ&algorithm=sha256&digits=8&period=60  ",
+			Key{Issuer: "Example", Account: "alice", Secret: "GEZDGNBVGY3TQOJQ", Algorithm: SHA256, Digits: 8, Period: 60}, nil},
+		{"counter based", "otpauth://hotp/alice?secret=GEZDGNBVGY3TQOJQ&counter=1", Key{}, ErrCounterBased},
+		{"other scheme", "https://totp/alice?secret=GEZDGNBVGY3TQOJQ", Key{}, ErrInvalidURI},
+		{"other type", "otpauth://motp/alice?secret=GEZDGNBVGY3TQOJQ", Key{}, ErrInvalidURI},
+		{"missing secret", "otpauth://totp/alice", Key{}, ErrInvalidSecret},
+		{"unknown algorithm", "otpauth://totp/alice?secret=GEZDGNBVGY3TQOJQ&algorithm=MD5", Key{}, ErrInvalidParameters},
+		{"malformed digits", "otpauth://totp/alice?secret=GEZDGNBVGY3TQOJQ&digits=six", Key{}, ErrInvalidParameters},
+		{"malformed period", "otpauth://totp/alice?secret=GEZDGNBVGY3TQOJQ&period=1m", Key{}, ErrInvalidParameters},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseURI(tt.uri)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseURI() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && *got != tt.want {
+				t.Errorf("ParseURI() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHOTPDigits(t *testing.T) {
+	for digits := MinDigits; digits <= MaxDigits; digits++ {
+		code, err := HOTP(rfcSecrets[SHA1], SHA1, digits, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The codes of one counter differ only in how many trailing digits of the same value they keep.
+		if len(code) != digits || !strings.HasSuffix("84755224", code) {
+			t.Errorf("HOTP(%d digits) = %s", digits, code)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+// Package passgen generates passwords and diceware passphrases from the system's cryptographically
+// secure random source only. A policy sets the length and character classes of a password, whether
+// characters easily mistaken for one another are left out, or the number of words of a passphrase;
+// named presets cover the rules common sites impose.
+//
+// The package is shared by the server, which generates passwords for regular accounts and may store
+// them without sending them back, and the client, which generates the passwords of vault accounts
+// before encrypting them.
+package passgen
@@ -0,0 +1,213 @@
+package passgen
+
+import (
+	"crypto/rand"
+	_ "embed"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+)
+
+// Character classes a password draws from.
+const (
+	Lower   = "lower"   // Lowercase letters.
+	Upper   = "upper"   // Uppercase letters.
+	Digits  = "digits"  // Decimal digits.
+	Symbols = "symbols" // ASCII punctuation.
+)
+
+// Limits of a policy.
+const (
+	MinLength       = 4   // Shortest password generated.
+	MaxLength       = 128 // Longest password generated.
+	MinWords        = 3   // Fewest words of a passphrase.
+	MaxWords        = 20  // Most words of a passphrase.
+	MaxSeparatorLen = 8   // Longest separator between the words of a passphrase, in bytes.
+)
+
+// DefaultPreset is the preset applied when none is named.
+const DefaultPreset = "default"
+
+// Classes lists the character classes.
+var Classes = []string{Lower, Upper, Digits, Symbols}
+
+// Errors returned for unusable policies.
+var (
+	ErrInvalidPolicy = errors.New("invalid generator policy") // A setting is out of range; wraps the reason.
+	ErrUnknownPreset = errors.New("unknown generator preset") // The preset is not one of Presets.
+)
+
+// classChars are the characters of each class.
+var classChars = map[string]string{
+	Lower:   "abcdefghijklmnopqrstuvwxyz",
+	Upper:   "ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	Digits:  "0123456789",
+	Symbols: "!#$%&()*+,-./:;<=>?@[]^_{}~",
+}
+
+// ambiguous are the characters easily mistaken for one another when read or typed.
+const ambiguous = "0Oo1lI"
+
+//go:embed words.txt
+var wordList string
+
+// words are the words passphrases are made of.
+var words = strings.Fields(wordList)
+
+// Policy tells what a generated password looks like.
+type Policy struct {
+	Length           int      `json:"length,omitempty"`            // Characters of a password; ignored for passphrases.
+	Classes          []string `json:"classes,omitempty"`           // Character classes of a password, each used at least once.
+	ExcludeAmbiguous bool     `json:"exclude_ambiguous,omitempty"` // Leave out characters such as 0 and O or 1, l and I.
+	Words            int      `json:"words,omitempty"`             // Words of a passphrase; zero generates a password of characters.
+	Separator        string   `json:"separator,omitempty"`         // Put between the words of a passphrase.
+}
+
+// Presets are named policies for the rules sites commonly impose.
+var Presets = map[string]Policy{
+	DefaultPreset: {Length: 20, Classes: Classes},
+	"strong":      {Length: 32, Classes: Classes},
+	"alnum":       {Length: 16, Classes: []string{Lower, Upper, Digits}},
+	"short":       {Length: 12, Classes: Classes, ExcludeAmbiguous: true},
+	"pin":         {Length: 6, Classes: []string{Digits}},
+	"passphrase":  {Words: 6, Separator: "-"},
+}
+
+// Resolve starts from a preset, the default one if the name is empty, and applies the non-zero settings
+// of override on top of it. Asking for words turns the policy into a passphrase one.
+func Resolve(preset string, override Policy) (Policy, error) {
+	if preset == "" {
+		preset = DefaultPreset
+	}
+	policy, ok := Presets[preset]
+	if !ok {
+		return Policy{}, fmt.Errorf("%w: %s", ErrUnknownPreset, preset)
+	}
+	policy.Classes = slices.Clone(policy.Classes)
+
+	if override.Length != 0 {
+		policy.Length = override.Length
+	}
+	if len(override.Classes) > 0 {
+		policy.Classes = slices.Clone(override.Classes)
+	}
+	if override.ExcludeAmbiguous {
+		policy.ExcludeAmbiguous = true
+	}
+	if override.Words != 0 {
+		policy.Words = override.Words
+	}
+	if override.Separator != "" {
+		policy.Separator = override.Separator
+	}
+	return policy, nil
+}
+
+// Validate checks the settings of a passphrase policy if it asks for words, and of a password policy otherwise.
+func (p Policy) Validate() error {
+	if p.Words != 0 {
+		if p.Words < MinWords || p.Words > MaxWords {
+			return fmt.Errorf("%w: a passphrase must have %d to %d words", ErrInvalidPolicy, MinWords, MaxWords)
+		}
+		if len(p.Separator) > MaxSeparatorLen {
+			return fmt.Errorf("%w: the separator must not exceed %d bytes", ErrInvalidPolicy, MaxSeparatorLen)
+		}
+		return nil
+	}
+
+	if p.Length < MinLength || p.Length > MaxLength {
+		return fmt.Errorf("%w: the length must be %d to %d", ErrInvalidPolicy, MinLength, MaxLength)
+	}
+	if len(p.Classes) == 0 {
+		return fmt.Errorf("%w: no character class", ErrInvalidPolicy)
+	}
+	for i, class := range p.Classes {
+		if _, ok := classChars[class]; !ok {
+			return fmt.Errorf("%w: unknown character class %q", ErrInvalidPolicy, class)
+		}
+		if slices.Contains(p.Classes[:i], class) {
+			return fmt.Errorf("%w: duplicate character class %q", ErrInvalidPolicy, class)
+		}
+	}
+	if p.Length < len(p.Classes) {
+		return fmt.Errorf("%w: the length is below the number of character classes", ErrInvalidPolicy)
+	}
+	return nil
+}
+
+// EntropyBits returns the strength of the passwords of a valid policy, in bits, assuming the policy is known
+// to the attacker. Guaranteeing every class a character lowers it slightly, which is not accounted for.
+func (p Policy) EntropyBits() float64 {
+	if p.Words != 0 {
+		return float64(p.Words) * math.Log2(float64(len(words)))
+	}
+	return float64(p.Length) * math.Log2(float64(len(p.alphabet(p.Classes))))
+}
+
+// Generate returns a password or passphrase following a policy.
+// A password has at least one character of each of its classes, in random positions.
+func Generate(p Policy) (string, error) {
+	if err := p.Validate(); err != nil {
+		return "", err
+	}
+
+	if p.Words != 0 {
+		phrase := make([]string, p.Words)
+		for i := range phrase {
+			n, err := randIndex(len(words))
+			if err != nil {
+				return "", err
+			}
+			phrase[i] = words[n]
+		}
+		return strings.Join(phrase, p.Separator), nil
+	}
+
+	all := p.alphabet(p.Classes)
+	password := make([]byte, p.Length)
+	for i := range password {
+		chars := all
+		if i < len(p.Classes) {
+			chars = p.alphabet(p.Classes[i : i+1])
+		}
+		n, err := randIndex(len(chars))
+		if err != nil {
+			return "", err
+		}
+		password[i] = chars[n]
+	}
+
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randIndex(i + 1)
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+	return string(password), nil
+}
+
+// alphabet returns the characters of the given classes, without the ambiguous ones if the policy leaves them out.
+func (p Policy) alphabet(classes []string) string {
+	var b strings.Builder
+	for _, class := range classes {
+		for _, c := range classChars[class] {
+			if !p.ExcludeAmbiguous || !strings.ContainsRune(ambiguous, c) {
+				b.WriteRune(c)
+			}
+		}
+	}
+	return b.String()
+}
+
+// randIndex returns a uniformly distributed random number in [0, n) drawn from crypto/rand.
+func randIndex(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(i.Int64()), nil
+}
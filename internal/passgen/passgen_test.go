@@ -0,0 +1,170 @@
+package passgen
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name     string
+		preset   string
+		override Policy
+		want     Policy
+		wantErr  error
+	}{
+		{"default preset", "", Policy{}, Presets[DefaultPreset], nil},
+		{"named preset", "pin", Policy{}, Presets["pin"], nil},
+		{"length override", "alnum", Policy{Length: 24},
+			Policy{Length: 24, Classes: []string{Lower, Upper, Digits}}, nil},
+		{"class override", "", Policy{Classes: []string{Digits}}, Policy{Length: 20, Classes: []string{Digits}}, nil},
+		{"ambiguous override", "pin", Policy{ExcludeAmbiguous: true},
+			Policy{Length: 6, Classes: []string{Digits}, ExcludeAmbiguous: true}, nil},
+		{"words turn a password preset into a passphrase", "", Policy{Words: 4, Separator: " "},
+			Policy{Length: 20, Classes: Classes, Words: 4, Separator: " "}, nil},
+		{"unknown preset", "nope", Policy{}, Policy{}, ErrUnknownPreset},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Resolve(tt.preset, tt.override)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Resolve() error = %v, want %v", err, tt.wantErr)
+			}
+			if got.Length != tt.want.Length || !slices.Equal(got.Classes, tt.want.Classes) ||
+				got.ExcludeAmbiguous != tt.want.ExcludeAmbiguous || got.Words != tt.want.Words ||
+				got.Separator != tt.want.Separator {
+				t.Errorf("Resolve() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveDoesNotShareClasses(t *testing.T) {
+	p, err := Resolve("alnum", Policy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Classes[0] = Symbols
+	if Presets["alnum"].Classes[0] != Lower {
+		t.Error("changing a resolved policy changed its preset")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  Policy
+		wantErr bool
+	}{
+		{"shortest password", Policy{Length: MinLength, Classes: []string{Lower}}, false},
+		{"too short", Policy{Length: MinLength - 1, Classes: []string{Lower}}, true},
+		{"too long", Policy{Length: MaxLength + 1, Classes: []string{Lower}}, true},
+		{"no class", Policy{Length: 10}, true},
+		{"unknown class", Policy{Length: 10, Classes: []string{"emoji"}}, true},
+		{"duplicate class", Policy{Length: 10, Classes: []string{Lower, Lower}}, true},
+		{"passphrase", Policy{Words: MinWords}, false},
+		{"too few words", Policy{Words: MinWords - 1}, true},
+		{"too many words", Policy{Words: MaxWords + 1}, true},
+		{"long separator", Policy{Words: 6, Separator: strings.Repeat("-", MaxSeparatorLen+1)}, true},
+	}
+	for name, p := range Presets {
+		if err := p.Validate(); err != nil {
+			t.Errorf("preset %s: Validate() error = %v", name, err)
+		}
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.wantErr && !errors.Is(err, ErrInvalidPolicy) {
+				t.Errorf("Validate() error = %v, want %v", err, ErrInvalidPolicy)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Validate() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestEntropyBits(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy Policy
+		want   float64
+	}{
+		{"digits", Policy{Length: 6, Classes: []string{Digits}}, 6 * math.Log2(10)},
+		{"letters", Policy{Length: 10, Classes: []string{Lower, Upper}}, 10 * math.Log2(52)},
+		{"without ambiguous digits", Policy{Length: 8, Classes: []string{Digits}, ExcludeAmbiguous: true}, 8 * math.Log2(8)},
+		{"every class", Policy{Length: 20, Classes: Classes}, 20 * math.Log2(26+26+10+27)},
+		{"passphrase", Policy{Words: 6}, 6 * math.Log2(float64(len(words)))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.EntropyBits(); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("EntropyBits() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy Policy
+	}{
+		{"default", Presets[DefaultPreset]},
+		{"short without ambiguous characters", Presets["short"]},
+		{"pin", Presets["pin"]},
+		{"as many characters as classes", Policy{Length: 4, Classes: Classes}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alphabet := tt.policy.alphabet(tt.policy.Classes)
+			for range 100 {
+				got, err := Generate(tt.policy)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(got) != tt.policy.Length {
+					t.Fatalf("Generate() = %q, want %d characters", got, tt.policy.Length)
+				}
+				for _, c := range got {
+					if !strings.ContainsRune(alphabet, c) {
+						t.Fatalf("Generate() = %q, which has %q outside the alphabet", got, c)
+					}
+				}
+				for _, class := range tt.policy.Classes {
+					if !strings.ContainsAny(got, tt.policy.alphabet([]string{class})) {
+						t.Fatalf("Generate() = %q, which has no %s character", got, class)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratePassphrase(t *testing.T) {
+	p := Policy{Words: 5, Separator: "+"}
+
+	got, err := Generate(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(got, p.Separator)
+	if len(parts) != p.Words {
+		t.Fatalf("Generate() = %q, want %d words", got, p.Words)
+	}
+	for _, w := range parts {
+		if !slices.Contains(words, w) {
+			t.Errorf("Generate() = %q, which has %q outside the word list", got, w)
+		}
+	}
+}
+
+func TestGenerateInvalid(t *testing.T) {
+	if _, err := Generate(Policy{Length: 2, Classes: []string{Lower}}); !errors.Is(err, ErrInvalidPolicy) {
+		t.Errorf("Generate() error = %v, want %v", err, ErrInvalidPolicy)
+	}
+}
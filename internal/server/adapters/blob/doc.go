@@ -0,0 +1,6 @@
+// Package blob groups the BlobStore implementations keeping the encrypted content of binaries
+// outside the database: package local stores blobs as files, package s3 in an S3-compatible
+// object storage such as MinIO or Amazon S3.
+//
+// Blobs are written once and never modified; a blob is only visible after it was stored completely.
+package blob
@@ -0,0 +1,2 @@
+// Package local implements a BlobStore on the local filesystem.
+package local
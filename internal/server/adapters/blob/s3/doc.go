@@ -0,0 +1,2 @@
+// Package s3 implements a BlobStore on S3-compatible object storage such as MinIO or Amazon S3.
+package s3
@@ -0,0 +1,107 @@
+package s3
+
+import (
+	"context"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"io"
+	"main/internal/server/services"
+	"time"
+)
+
+// partSize is the size of the parts blobs are uploaded in. Content is streamed without a known
+// length, so each part is buffered in memory; it also bounds blobs to 10000 parts.
+const partSize = 16 * 1024 * 1024
+
+// Options configures the connection to the object storage.
+type Options struct {
+	Endpoint  string // Host and optional port of the storage, without a scheme.
+	Bucket    string // Bucket holding the blobs; created if missing.
+	Region    string // Region of the bucket; may be empty for MinIO.
+	AccessKey string // Access key ID.
+	SecretKey string // Secret access key.
+	UseSSL    bool   // Connect over HTTPS.
+}
+
+// Store keeps blobs as objects of a bucket, named by their keys.
+type Store struct {
+	client *minio.Client // Client of the object storage.
+	bucket string        // Bucket holding the blobs.
+}
+
+// NewStore connects to the object storage and makes sure the bucket exists.
+func NewStore(ctx context.Context, o Options) (*Store, error) {
+	client, err := minio.New(o.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(o.AccessKey, o.SecretKey, ""),
+		Secure: o.UseSSL,
+		Region: o.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := client.BucketExists(ctx, o.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		err := client.MakeBucket(ctx, o.Bucket, minio.MakeBucketOptions{Region: o.Region})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Store{
+		client: client,
+		bucket: o.Bucket,
+	}, nil
+}
+
+// Put uploads the content read from r as a multipart upload, which the storage aborts
+// if reading fails, so a failed write leaves no object behind.
+func (s *Store) Put(ctx context.Context, key string, r io.Reader) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, -1, minio.PutObjectOptions{
+		ContentType: "application/octet-stream",
+		PartSize:    partSize,
+	})
+	return err
+}
+
+// Open opens the object of a blob.
+func (s *Store) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// GetObject is lazy; ask for the object info to detect a missing object right away.
+	if _, err := obj.Stat(); err != nil {
+		obj.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, services.ErrBlobNotFound
+		}
+		return nil, err
+	}
+	return obj, nil
+}
+
+// Delete removes the object of a blob.
+func (s *Store) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
+
+// Walk calls fn for every object of the bucket.
+func (s *Store) Walk(ctx context.Context, fn func(key string, modified time.Time) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Recursive: true}) {
+		if obj.Err != nil {
+			return obj.Err
+		}
+		if err := fn(obj.Key, obj.LastModified); err != nil {
+			return err
+		}
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,90 @@
+package breach
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// prefixLen is the number of hexadecimal characters of a SHA-1 hash that name its range file.
+const prefixLen = 5
+
+// rangeExts are the extensions range files are looked up with, as the downloaders store them with or without one.
+var rangeExts = []string{".txt", ""}
+
+// Corpus looks passwords up in the range files below a directory.
+type Corpus struct {
+	dir string // Directory holding the range files.
+}
+
+// NewCorpus opens the corpus in dir, which must be an existing directory.
+func NewCorpus(dir string) (*Corpus, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("breach corpus %s is not a directory", dir)
+	}
+	return &Corpus{
+		dir: dir,
+	}, nil
+}
+
+// Count returns how many times the password was seen in breaches, reading the range file of its hash prefix.
+// A missing range file counts as a bucket without breached passwords, so a partial corpus can be used;
+// padding entries with a zero count, which the range API may add, count as not breached.
+func (c *Corpus) Count(ctx context.Context, password []byte) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	sum := sha1.Sum(password)
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:prefixLen], hash[prefixLen:]
+
+	f, err := c.open(prefix)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		candidate, count, ok := strings.Cut(line, ":")
+		if !ok || !strings.EqualFold(candidate, suffix) {
+			continue
+		}
+		n, err := strconv.ParseInt(count, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("breach corpus range %s: %w", prefix, err)
+		}
+		return n, nil
+	}
+	return 0, scanner.Err()
+}
+
+// open opens the range file of a hash prefix under any of the names it may be stored with.
+func (c *Corpus) open(prefix string) (*os.File, error) {
+	for _, name := range []string{prefix, strings.ToLower(prefix)} {
+		for _, ext := range rangeExts {
+			f, err := os.Open(filepath.Join(c.dir, name+ext))
+			if !errors.Is(err, fs.ErrNotExist) {
+				return f, err
+			}
+		}
+	}
+	return nil, fs.ErrNotExist
+}
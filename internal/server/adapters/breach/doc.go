@@ -0,0 +1,8 @@
+// Package breach implements a BreachCorpus on a local copy of the Pwned Passwords dataset, so stored
+// passwords can be checked against known breaches without anything leaving the server.
+//
+// The dataset is split the way the k-anonymity range API serves it: one file per five hexadecimal
+// characters of a SHA-1 hash, named after that prefix, listing the remaining 35 characters of every
+// breached hash in the bucket with the number of times it was seen, one "SUFFIX:COUNT" line each.
+// A lookup reads the single file of its prefix, so the corpus is never loaded into memory.
+package breach
@@ -1,11 +1,9 @@
 package psql
 
 import (
-	"context"
 	"database/sql"
 	"fmt"
 	"net/url"
-	"time"
 )
 
 // DB encapsulates a SQL database connection for interacting with a PostgresSQL backend.
@@ -48,15 +46,3 @@ func (p *DB) Ping() error {
 	err := p.Conn.Ping()
 	return err
 }
-
-// Migrate applies database schema migrations using the provided context and connection.
-func (p *DB) Migrate() error {
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
-	defer cancel()
-
-	_, err := p.Conn.ExecContext(ctx, createTables)
-	if err != nil {
-		return err
-	}
-	return nil
-}
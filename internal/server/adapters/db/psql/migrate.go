@@ -0,0 +1,310 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// migrationsLockID is the advisory lock key serializing schema changes across server replicas.
+const migrationsLockID = 7_294_117_350_001
+
+// MigrateTimeout bounds the time a single migration run may take, including waiting for the lock.
+const MigrateTimeout = 5 * time.Minute
+
+// ErrMigrationsInconsistent is returned when the database records a version unknown to this binary.
+var ErrMigrationsInconsistent = errors.New("database schema is newer than the embedded migrations")
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// Migration describes a single numbered schema change with its forward and rollback scripts.
+type Migration struct {
+	Version int64  // Sequential version number taken from the file name prefix.
+	Name    string // Human-readable name taken from the file name.
+	Up      string // Script applying the change.
+	Down    string // Script reverting the change.
+}
+
+// MigrationStatus reports whether an embedded migration has been applied to the database.
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time // Time the migration was applied; nil if it is pending.
+}
+
+const (
+	createMigrationsTable = `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT PRIMARY KEY,
+			name TEXT NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+		);` // Create the table tracking applied migrations
+
+	selectAppliedMigrations = `
+		SELECT version, applied_at
+		FROM schema_migrations
+		ORDER BY version` // List applied migrations in order
+
+	insertAppliedMigration = `
+		INSERT INTO schema_migrations (version, name)
+		VALUES ($1, $2)` // Record a migration as applied
+
+	deleteAppliedMigration = `
+		DELETE
+		FROM schema_migrations
+		WHERE version = $1` // Forget a reverted migration
+
+	lockMigrations   = `SELECT pg_advisory_lock($1)`   // Wait for exclusive access to the schema
+	unlockMigrations = `SELECT pg_advisory_unlock($1)` // Release exclusive access to the schema
+)
+
+// Migrate applies all pending migrations. It is safe to call concurrently from several replicas.
+func (p *DB) Migrate() error {
+	ctx, cancel := context.WithTimeout(context.Background(), MigrateTimeout)
+	defer cancel()
+
+	_, err := p.MigrateUp(ctx)
+	return err
+}
+
+// MigrateUp applies every pending migration in version order and returns the applied ones.
+// Each migration runs in its own transaction while the migrations advisory lock is held.
+func (p *DB) MigrateUp(ctx context.Context) ([]Migration, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Migration
+	err = p.withMigrationsLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := checkKnown(applied, migrations); err != nil {
+			return err
+		}
+
+		for _, m := range migrations {
+			if _, ok := applied[m.Version]; ok {
+				continue
+			}
+			err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, m.Up); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx, insertAppliedMigration, m.Version, m.Name)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("apply migration %04d_%s: %w", m.Version, m.Name, err)
+			}
+			result = append(result, m)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// MigrateDown reverts up to steps most recently applied migrations and returns the reverted ones.
+func (p *DB) MigrateDown(ctx context.Context, steps int) ([]Migration, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Migration
+	err = p.withMigrationsLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := checkKnown(applied, migrations); err != nil {
+			return err
+		}
+
+		for i := len(migrations) - 1; i >= 0 && len(result) < steps; i-- {
+			m := migrations[i]
+			if _, ok := applied[m.Version]; !ok {
+				continue
+			}
+			err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, m.Down); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx, deleteAppliedMigration, m.Version)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("revert migration %04d_%s: %w", m.Version, m.Name, err)
+			}
+			result = append(result, m)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// MigrationStatus lists every embedded migration together with the time it was applied, if any.
+func (p *DB) MigrationStatus(ctx context.Context) ([]MigrationStatus, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []MigrationStatus
+	err = p.withMigrationsLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, m := range migrations {
+			status := MigrationStatus{Migration: m}
+			if at, ok := applied[m.Version]; ok {
+				status.AppliedAt = &at
+			}
+			result = append(result, status)
+		}
+		return checkKnown(applied, migrations)
+	})
+	return result, err
+}
+
+// withMigrationsLock runs fn on a dedicated connection holding the migrations advisory lock.
+// The tracking table is created first so fn can rely on its existence.
+func (p *DB) withMigrationsLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := p.Conn.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, lockMigrations, migrationsLockID); err != nil {
+		return fmt.Errorf("acquire migrations lock: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), unlockMigrations, migrationsLockID)
+
+	if _, err := conn.ExecContext(ctx, createMigrationsTable); err != nil {
+		return err
+	}
+	return fn(conn)
+}
+
+// inTx executes fn inside a transaction on conn, committing on success and rolling back otherwise.
+func inTx(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// appliedMigrations returns the applied migration versions mapped to their application time.
+func appliedMigrations(ctx context.Context, conn *sql.Conn) (map[int64]time.Time, error) {
+	rows, err := conn.QueryContext(ctx, selectAppliedMigrations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[int64]time.Time)
+	for rows.Next() {
+		var (
+			version int64
+			at      time.Time
+		)
+		if err := rows.Scan(&version, &at); err != nil {
+			return nil, err
+		}
+		result[version] = at
+	}
+	return result, rows.Err()
+}
+
+// checkKnown ensures every applied version has a matching embedded migration.
+func checkKnown(applied map[int64]time.Time, migrations []Migration) error {
+	known := make(map[int64]bool, len(migrations))
+	for _, m := range migrations {
+		known[m.Version] = true
+	}
+	for version := range applied {
+		if !known[version] {
+			return fmt.Errorf("%w: version %d", ErrMigrationsInconsistent, version)
+		}
+	}
+	return nil
+}
+
+// loadMigrations reads the embedded migration scripts and pairs them by version.
+// Files are named <version>_<name>.up.sql and <version>_<name>.down.sql.
+func loadMigrations() ([]Migration, error) {
+	entries, err := fs.ReadDir(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, e := range entries {
+		file := e.Name()
+
+		var direction string
+		switch {
+		case strings.HasSuffix(file, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(file, ".down.sql"):
+			direction = "down"
+		default:
+			return nil, fmt.Errorf("unexpected migration file: %s", file)
+		}
+
+		base := strings.TrimSuffix(file, "."+direction+".sql")
+		prefix, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("migration file without name: %s", file)
+		}
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version: %s", file)
+		}
+
+		body, err := migrationFiles.ReadFile(path.Join("migrations", file))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		} else if m.Name != name {
+			return nil, fmt.Errorf("conflicting names for migration version %d", version)
+		}
+		if direction == "up" {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	result := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s must have both up and down scripts", m.Version, m.Name)
+		}
+		result = append(result, *m)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+	return result, nil
+}
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS binaries;
+DROP TABLE IF EXISTS cards;
+DROP TABLE IF EXISTS passwords;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS binaries_user_id_created_at_idx;
+ALTER TABLE binaries DROP COLUMN IF EXISTS created_at;
+
+DROP INDEX IF EXISTS cards_user_id_created_at_idx;
+ALTER TABLE cards DROP COLUMN IF EXISTS created_at;
+
+DROP INDEX IF EXISTS passwords_user_id_created_at_idx;
+ALTER TABLE passwords DROP COLUMN IF EXISTS created_at;
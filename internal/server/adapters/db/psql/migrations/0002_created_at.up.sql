@@ -0,0 +1,11 @@
+ALTER TABLE passwords ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE INDEX IF NOT EXISTS passwords_user_id_created_at_idx
+ON passwords (user_id, created_at, id);
+
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE INDEX IF NOT EXISTS cards_user_id_created_at_idx
+ON cards (user_id, created_at, id);
+
+ALTER TABLE binaries ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE INDEX IF NOT EXISTS binaries_user_id_created_at_idx
+ON binaries (user_id, created_at, id);
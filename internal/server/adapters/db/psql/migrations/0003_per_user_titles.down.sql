@@ -0,0 +1,4 @@
+-- Fails if several users already share a title, which is the expected outcome of a rollback.
+ALTER TABLE binaries ADD CONSTRAINT binaries_title_key UNIQUE (title);
+ALTER TABLE cards ADD CONSTRAINT cards_title_key UNIQUE (title);
+ALTER TABLE passwords ADD CONSTRAINT passwords_title_key UNIQUE (title);
@@ -0,0 +1,4 @@
+-- Titles only have to be unique per user; the per-user unique indexes already enforce that.
+ALTER TABLE passwords DROP CONSTRAINT IF EXISTS passwords_title_key;
+ALTER TABLE cards DROP CONSTRAINT IF EXISTS cards_title_key;
+ALTER TABLE binaries DROP CONSTRAINT IF EXISTS binaries_title_key;
@@ -0,0 +1,7 @@
+-- Per-user data-encryption keys, stored wrapped by the master key-encryption key.
+-- Deleting a user removes the key and thereby renders all of their encrypted records unreadable.
+CREATE TABLE IF NOT EXISTS user_keys (
+	user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+	wrapped_key BYTEA NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
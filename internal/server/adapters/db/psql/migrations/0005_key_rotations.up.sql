@@ -0,0 +1,14 @@
+-- Background jobs moving stored ciphertexts to a new master key; at most one may be unfinished.
+CREATE TABLE IF NOT EXISTS key_rotations (
+	id SERIAL PRIMARY KEY,
+	master_key_id BIGINT NOT NULL,
+	phase VARCHAR(32) NOT NULL,
+	last_id BIGINT NOT NULL DEFAULT 0,
+	processed BIGINT NOT NULL DEFAULT 0,
+	skipped BIGINT NOT NULL DEFAULT 0,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	finished_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS key_rotations_unfinished_idx
+ON key_rotations ((finished_at IS NULL)) WHERE finished_at IS NULL;
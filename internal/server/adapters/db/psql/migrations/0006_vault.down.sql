@@ -0,0 +1,8 @@
+ALTER TABLE users
+	DROP CONSTRAINT IF EXISTS users_vault_params_check,
+	DROP COLUMN IF EXISTS vault_check,
+	DROP COLUMN IF EXISTS vault_threads,
+	DROP COLUMN IF EXISTS vault_memory,
+	DROP COLUMN IF EXISTS vault_time,
+	DROP COLUMN IF EXISTS vault_salt,
+	DROP COLUMN IF EXISTS vault;
@@ -0,0 +1,13 @@
+-- Zero-knowledge vault accounts encrypt their records on the client with a key derived by Argon2id;
+-- the server only keeps the KDF parameters and never sees the key.
+ALTER TABLE users
+	ADD COLUMN IF NOT EXISTS vault BOOLEAN NOT NULL DEFAULT false,
+	ADD COLUMN IF NOT EXISTS vault_salt BYTEA,
+	ADD COLUMN IF NOT EXISTS vault_time INTEGER,
+	ADD COLUMN IF NOT EXISTS vault_memory INTEGER,
+	ADD COLUMN IF NOT EXISTS vault_threads SMALLINT,
+	ADD COLUMN IF NOT EXISTS vault_check BYTEA;
+ALTER TABLE users ADD CONSTRAINT users_vault_params_check CHECK (
+	NOT vault OR (vault_salt IS NOT NULL AND vault_time IS NOT NULL AND vault_memory IS NOT NULL
+		AND vault_threads IS NOT NULL AND vault_check IS NOT NULL)
+);
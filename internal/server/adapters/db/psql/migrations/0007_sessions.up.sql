@@ -0,0 +1,17 @@
+-- Login sessions backing refresh tokens; access tokens carry the session ID and stop working once it is revoked.
+CREATE TABLE IF NOT EXISTS sessions (
+	id BIGSERIAL PRIMARY KEY,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	refresh_hash BYTEA NOT NULL UNIQUE,
+	previous_hash BYTEA,
+	user_agent TEXT NOT NULL DEFAULT '',
+	address TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	last_used_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	expires_at TIMESTAMPTZ NOT NULL,
+	revoked_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS sessions_user_id_idx
+ON sessions (user_id, created_at);
+CREATE INDEX IF NOT EXISTS sessions_previous_hash_idx
+ON sessions (previous_hash);
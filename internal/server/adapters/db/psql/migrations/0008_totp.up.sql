@@ -0,0 +1,29 @@
+-- TOTP second factor: the secret is encrypted with the user's data key; last_step prevents code replay.
+CREATE TABLE IF NOT EXISTS user_totp (
+	user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+	secret BYTEA NOT NULL,
+	confirmed_at TIMESTAMPTZ,
+	last_step BIGINT NOT NULL DEFAULT 0,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- One-time recovery codes, stored as hashes.
+CREATE TABLE IF NOT EXISTS recovery_codes (
+	id SERIAL PRIMARY KEY,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	code_hash BYTEA NOT NULL,
+	used_at TIMESTAMPTZ,
+	UNIQUE (user_id, code_hash)
+);
+
+-- Pending logins waiting for the second factor.
+CREATE TABLE IF NOT EXISTS login_challenges (
+	id SERIAL PRIMARY KEY,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	token_hash BYTEA NOT NULL UNIQUE,
+	attempts INTEGER NOT NULL DEFAULT 0,
+	expires_at TIMESTAMPTZ NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS login_challenges_expires_at_idx
+ON login_challenges (expires_at);
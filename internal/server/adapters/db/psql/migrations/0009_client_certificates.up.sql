@@ -0,0 +1,11 @@
+-- Client certificate subjects accepted in place of an access token under mutual TLS. Each binding is backed
+-- by a session of its own, so revoking or expiring the session stops the certificate from authenticating.
+CREATE TABLE IF NOT EXISTS client_certificates (
+	id SERIAL PRIMARY KEY,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	session_id BIGINT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+	subject TEXT NOT NULL UNIQUE,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS client_certificates_user_id_idx
+ON client_certificates (user_id);
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS binary_chunks;
+DELETE FROM binaries WHERE data IS NULL;
+ALTER TABLE binaries DROP CONSTRAINT IF EXISTS binaries_content_check;
+ALTER TABLE binaries
+	DROP COLUMN IF EXISTS sha256,
+	DROP COLUMN IF EXISTS size,
+	DROP COLUMN IF EXISTS data_key;
+ALTER TABLE binaries ALTER COLUMN data SET NOT NULL;
@@ -0,0 +1,15 @@
+-- Streamed binaries keep their ciphertext in chunks, encrypted under a per-binary content key.
+ALTER TABLE binaries ALTER COLUMN data DROP NOT NULL;
+ALTER TABLE binaries
+	ADD COLUMN IF NOT EXISTS data_key BYTEA,
+	ADD COLUMN IF NOT EXISTS size BIGINT,
+	ADD COLUMN IF NOT EXISTS sha256 BYTEA;
+ALTER TABLE binaries
+	ADD CONSTRAINT binaries_content_check CHECK ((data IS NULL) <> (data_key IS NULL));
+
+CREATE TABLE IF NOT EXISTS binary_chunks (
+	binary_id INTEGER NOT NULL REFERENCES binaries(id) ON DELETE CASCADE,
+	seq INTEGER NOT NULL,
+	data BYTEA NOT NULL,
+	PRIMARY KEY (binary_id, seq)
+);
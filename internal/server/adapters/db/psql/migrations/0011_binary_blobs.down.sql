@@ -0,0 +1,11 @@
+-- Content held in the blob store cannot be brought back into the database.
+DELETE FROM binaries WHERE blob_key IS NOT NULL;
+
+ALTER TABLE binaries DROP CONSTRAINT IF EXISTS binaries_content_check;
+ALTER TABLE binaries
+	ADD CONSTRAINT binaries_content_check CHECK ((data IS NULL) <> (data_key IS NULL));
+
+DROP INDEX IF EXISTS binaries_blob_key_idx;
+ALTER TABLE binaries
+	DROP COLUMN IF EXISTS streamed,
+	DROP COLUMN IF EXISTS blob_key;
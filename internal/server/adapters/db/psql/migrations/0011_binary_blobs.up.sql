@@ -0,0 +1,17 @@
+-- Binary content moves to the blob store; rows keep the metadata, the content hash and the blob key.
+-- Inline data and chunks written earlier stay readable until moved with "server blobs migrate".
+-- Blob keys are not unique: a restored revision points the binary back at the blob it was archived with,
+-- which other revisions and binaries may still reference. A blob is only collected once nothing references it.
+ALTER TABLE binaries
+	ADD COLUMN IF NOT EXISTS blob_key TEXT,
+	ADD COLUMN IF NOT EXISTS streamed BOOLEAN NOT NULL DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS binaries_blob_key_idx
+ON binaries (blob_key);
+
+UPDATE binaries SET streamed = TRUE WHERE data_key IS NOT NULL;
+
+ALTER TABLE binaries DROP CONSTRAINT IF EXISTS binaries_content_check;
+ALTER TABLE binaries
+	ADD CONSTRAINT binaries_content_check CHECK (
+		(data IS NULL) <> (data_key IS NULL) AND (blob_key IS NULL OR data_key IS NOT NULL)
+	);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS binary_history;
+DROP TABLE IF EXISTS card_history;
+DROP TABLE IF EXISTS password_history;
+
+ALTER TABLE users DROP COLUMN IF EXISTS history_retention;
@@ -0,0 +1,53 @@
+-- Every update and delete archives the previous encrypted version of a record under the next revision
+-- number of its title. Archived ciphertexts stay bound to the table and ID of the record they came from.
+ALTER TABLE users
+	ADD COLUMN IF NOT EXISTS history_retention INTEGER NOT NULL DEFAULT 10
+		CHECK (history_retention BETWEEN 0 AND 100);
+
+CREATE TABLE IF NOT EXISTS password_history (
+	id SERIAL PRIMARY KEY,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	record_id INTEGER NOT NULL,
+	title VARCHAR(255) NOT NULL,
+	revision INTEGER NOT NULL,
+	deleted BOOLEAN NOT NULL DEFAULT FALSE,
+	login BYTEA NOT NULL,
+	password BYTEA NOT NULL,
+	archived_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	UNIQUE (user_id, title, revision)
+);
+
+CREATE TABLE IF NOT EXISTS card_history (
+	id SERIAL PRIMARY KEY,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	record_id INTEGER NOT NULL,
+	title VARCHAR(255) NOT NULL,
+	revision INTEGER NOT NULL,
+	deleted BOOLEAN NOT NULL DEFAULT FALSE,
+	bank BYTEA NOT NULL,
+	number BYTEA NOT NULL,
+	data_end BYTEA NOT NULL,
+	secret_code BYTEA NOT NULL,
+	archived_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	UNIQUE (user_id, title, revision)
+);
+
+-- Archived binaries only keep the content key and the metadata; the content stays in its blob,
+-- which is collected once no live or archived binary references it.
+CREATE TABLE IF NOT EXISTS binary_history (
+	id SERIAL PRIMARY KEY,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	record_id INTEGER NOT NULL,
+	title VARCHAR(255) NOT NULL,
+	revision INTEGER NOT NULL,
+	deleted BOOLEAN NOT NULL DEFAULT FALSE,
+	data_key BYTEA NOT NULL,
+	size BIGINT NOT NULL,
+	sha256 BYTEA NOT NULL,
+	blob_key TEXT NOT NULL,
+	streamed BOOLEAN NOT NULL,
+	archived_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	UNIQUE (user_id, title, revision)
+);
+CREATE INDEX IF NOT EXISTS binary_history_blob_key_idx
+ON binary_history (blob_key);
@@ -0,0 +1,20 @@
+-- Records in the trash are dropped; titles become unique across all records again.
+DELETE FROM passwords WHERE deleted_at IS NOT NULL;
+DELETE FROM cards WHERE deleted_at IS NOT NULL;
+DELETE FROM binaries WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS passwords_trash_idx;
+DROP INDEX IF EXISTS passwords_user_id_title_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS passwords_user_id_title_idx ON passwords (user_id, title);
+
+DROP INDEX IF EXISTS cards_trash_idx;
+DROP INDEX IF EXISTS cards_user_id_title_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS cards_user_id_title_idx ON cards (user_id, title);
+
+DROP INDEX IF EXISTS binaries_trash_idx;
+DROP INDEX IF EXISTS binaries_user_id_title_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS binaries_user_id_title_idx ON binaries (user_id, title);
+
+ALTER TABLE passwords DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE cards DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE binaries DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,23 @@
+-- Deleted records stay in their table as trash until they are restored or purged.
+-- Titles stay unique among live records; each title keeps at most one record in the trash.
+ALTER TABLE passwords ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE binaries ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+DROP INDEX IF EXISTS passwords_user_id_title_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS passwords_user_id_title_idx
+ON passwords (user_id, title) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS passwords_trash_idx
+ON passwords (user_id, title) WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS cards_user_id_title_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS cards_user_id_title_idx
+ON cards (user_id, title) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS cards_trash_idx
+ON cards (user_id, title) WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS binaries_user_id_title_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS binaries_user_id_title_idx
+ON binaries (user_id, title) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS binaries_trash_idx
+ON binaries (user_id, title) WHERE deleted_at IS NOT NULL;
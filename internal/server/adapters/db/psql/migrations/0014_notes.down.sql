@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS note_history;
+DROP TABLE IF EXISTS notes;
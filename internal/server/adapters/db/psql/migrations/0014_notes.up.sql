@@ -0,0 +1,27 @@
+-- Secure notes hold free-form text, such as recovery instructions or license keys, in a single encrypted body.
+CREATE TABLE IF NOT EXISTS notes (
+	id SERIAL PRIMARY KEY,
+	title VARCHAR(255) NOT NULL,
+	user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+	body BYTEA NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	deleted_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS notes_user_id_title_idx
+ON notes (user_id, title) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS notes_trash_idx
+ON notes (user_id, title) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS notes_user_id_created_at_idx
+ON notes (user_id, created_at, id);
+
+CREATE TABLE IF NOT EXISTS note_history (
+	id SERIAL PRIMARY KEY,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	record_id INTEGER NOT NULL,
+	title VARCHAR(255) NOT NULL,
+	revision INTEGER NOT NULL,
+	deleted BOOLEAN NOT NULL DEFAULT FALSE,
+	body BYTEA NOT NULL,
+	archived_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	UNIQUE (user_id, title, revision)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS otp_history;
+DROP TABLE IF EXISTS otp_entries;
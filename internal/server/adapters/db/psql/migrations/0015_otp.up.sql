@@ -0,0 +1,44 @@
+-- Authenticator secrets, imported from otpauth:// URIs. The issuer, account and secret are encrypted;
+-- the code parameters are not sensitive. An entry may point to the password it is the second factor of.
+CREATE TABLE IF NOT EXISTS otp_entries (
+	id SERIAL PRIMARY KEY,
+	title VARCHAR(255) NOT NULL,
+	user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+	password_id INTEGER REFERENCES passwords(id) ON DELETE SET NULL,
+	issuer BYTEA NOT NULL,
+	account BYTEA NOT NULL,
+	secret BYTEA NOT NULL,
+	algorithm VARCHAR(16) NOT NULL,
+	digits SMALLINT NOT NULL,
+	period INTEGER NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	deleted_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS otp_entries_user_id_title_idx
+ON otp_entries (user_id, title) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS otp_entries_trash_idx
+ON otp_entries (user_id, title) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS otp_entries_user_id_created_at_idx
+ON otp_entries (user_id, created_at, id);
+CREATE INDEX IF NOT EXISTS otp_entries_password_id_idx
+ON otp_entries (password_id);
+
+-- Archived revisions keep the linked password ID without a foreign key; a link to a purged
+-- password is dropped when the revision is restored.
+CREATE TABLE IF NOT EXISTS otp_history (
+	id SERIAL PRIMARY KEY,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	record_id INTEGER NOT NULL,
+	title VARCHAR(255) NOT NULL,
+	revision INTEGER NOT NULL,
+	deleted BOOLEAN NOT NULL DEFAULT FALSE,
+	password_id INTEGER,
+	issuer BYTEA NOT NULL,
+	account BYTEA NOT NULL,
+	secret BYTEA NOT NULL,
+	algorithm VARCHAR(16) NOT NULL,
+	digits SMALLINT NOT NULL,
+	period INTEGER NOT NULL,
+	archived_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	UNIQUE (user_id, title, revision)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ssh_key_history;
+DROP TABLE IF EXISTS ssh_keys;
@@ -0,0 +1,31 @@
+-- SSH keys keep the private key encrypted, while the public key and its fingerprint stay readable for listing.
+CREATE TABLE IF NOT EXISTS ssh_keys (
+	id SERIAL PRIMARY KEY,
+	title VARCHAR(255) NOT NULL,
+	user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+	public_key TEXT NOT NULL,
+	fingerprint VARCHAR(64) NOT NULL,
+	private_key BYTEA NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	deleted_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS ssh_keys_user_id_title_idx
+ON ssh_keys (user_id, title) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS ssh_keys_trash_idx
+ON ssh_keys (user_id, title) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS ssh_keys_user_id_created_at_idx
+ON ssh_keys (user_id, created_at, id);
+
+CREATE TABLE IF NOT EXISTS ssh_key_history (
+	id SERIAL PRIMARY KEY,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	record_id INTEGER NOT NULL,
+	title VARCHAR(255) NOT NULL,
+	revision INTEGER NOT NULL,
+	deleted BOOLEAN NOT NULL DEFAULT FALSE,
+	public_key TEXT NOT NULL,
+	fingerprint VARCHAR(64) NOT NULL,
+	private_key BYTEA NOT NULL,
+	archived_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	UNIQUE (user_id, title, revision)
+);
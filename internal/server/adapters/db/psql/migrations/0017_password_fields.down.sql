@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS password_field_history;
+DROP TABLE IF EXISTS password_fields;
@@ -0,0 +1,25 @@
+-- Custom fields of password entries. The name and value of each field are encrypted on their own,
+-- bound to the field ID; the type stays readable.
+CREATE TABLE IF NOT EXISTS password_fields (
+	id SERIAL PRIMARY KEY,
+	password_id INTEGER NOT NULL REFERENCES passwords(id) ON DELETE CASCADE,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	position INTEGER NOT NULL,
+	name BYTEA NOT NULL,
+	type VARCHAR(16) NOT NULL,
+	value BYTEA NOT NULL,
+	UNIQUE (password_id, position)
+);
+
+-- Fields archived along with a password revision; record_id is the ID of the archived field.
+CREATE TABLE IF NOT EXISTS password_field_history (
+	id SERIAL PRIMARY KEY,
+	history_id INTEGER NOT NULL REFERENCES password_history(id) ON DELETE CASCADE,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	record_id INTEGER NOT NULL,
+	position INTEGER NOT NULL,
+	name BYTEA NOT NULL,
+	type VARCHAR(16) NOT NULL,
+	value BYTEA NOT NULL,
+	UNIQUE (history_id, position)
+);
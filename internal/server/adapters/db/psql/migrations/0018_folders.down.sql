@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS item_tags;
+ALTER TABLE ssh_keys DROP COLUMN IF EXISTS folder_id;
+ALTER TABLE otp_entries DROP COLUMN IF EXISTS folder_id;
+ALTER TABLE notes DROP COLUMN IF EXISTS folder_id;
+ALTER TABLE binaries DROP COLUMN IF EXISTS folder_id;
+ALTER TABLE cards DROP COLUMN IF EXISTS folder_id;
+ALTER TABLE passwords DROP COLUMN IF EXISTS folder_id;
+DROP TABLE IF EXISTS folders;
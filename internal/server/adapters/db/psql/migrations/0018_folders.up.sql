@@ -0,0 +1,31 @@
+-- Folders form a per-user tree; root folders have no parent. Names are unique among siblings.
+CREATE TABLE IF NOT EXISTS folders (
+	id SERIAL PRIMARY KEY,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	parent_id INTEGER REFERENCES folders(id) ON DELETE CASCADE,
+	name VARCHAR(255) NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS folders_user_id_parent_id_name_idx
+ON folders (user_id, COALESCE(parent_id, 0), name);
+CREATE INDEX IF NOT EXISTS folders_parent_id_idx
+ON folders (parent_id);
+
+-- Items of every kind may be placed in a folder; trashed items of a removed folder end up at the root.
+ALTER TABLE passwords ADD COLUMN IF NOT EXISTS folder_id INTEGER REFERENCES folders(id) ON DELETE SET NULL;
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS folder_id INTEGER REFERENCES folders(id) ON DELETE SET NULL;
+ALTER TABLE binaries ADD COLUMN IF NOT EXISTS folder_id INTEGER REFERENCES folders(id) ON DELETE SET NULL;
+ALTER TABLE notes ADD COLUMN IF NOT EXISTS folder_id INTEGER REFERENCES folders(id) ON DELETE SET NULL;
+ALTER TABLE otp_entries ADD COLUMN IF NOT EXISTS folder_id INTEGER REFERENCES folders(id) ON DELETE SET NULL;
+ALTER TABLE ssh_keys ADD COLUMN IF NOT EXISTS folder_id INTEGER REFERENCES folders(id) ON DELETE SET NULL;
+
+-- Tags of items of every kind; kind is the name of the table holding the item.
+CREATE TABLE IF NOT EXISTS item_tags (
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	kind VARCHAR(32) NOT NULL,
+	item_id INTEGER NOT NULL,
+	tag VARCHAR(64) NOT NULL,
+	PRIMARY KEY (kind, item_id, tag)
+);
+CREATE INDEX IF NOT EXISTS item_tags_user_id_tag_idx
+ON item_tags (user_id, tag);
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS ssh_keys_user_id_updated_at_idx;
+DROP INDEX IF EXISTS otp_entries_user_id_updated_at_idx;
+DROP INDEX IF EXISTS notes_user_id_updated_at_idx;
+DROP INDEX IF EXISTS binaries_user_id_updated_at_idx;
+DROP INDEX IF EXISTS cards_user_id_updated_at_idx;
+DROP INDEX IF EXISTS passwords_user_id_updated_at_idx;
+ALTER TABLE ssh_keys DROP COLUMN IF EXISTS access_count, DROP COLUMN IF EXISTS last_accessed_at, DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE otp_entries DROP COLUMN IF EXISTS access_count, DROP COLUMN IF EXISTS last_accessed_at, DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE notes DROP COLUMN IF EXISTS access_count, DROP COLUMN IF EXISTS last_accessed_at, DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE binaries DROP COLUMN IF EXISTS access_count, DROP COLUMN IF EXISTS last_accessed_at, DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE cards DROP COLUMN IF EXISTS access_count, DROP COLUMN IF EXISTS last_accessed_at, DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE passwords DROP COLUMN IF EXISTS access_count, DROP COLUMN IF EXISTS last_accessed_at, DROP COLUMN IF EXISTS updated_at;
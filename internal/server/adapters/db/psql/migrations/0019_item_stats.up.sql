@@ -0,0 +1,43 @@
+-- Every item keeps the time its content was last replaced, the time it was last read and how often it was read.
+-- Existing items count as unchanged since they were created and as never read.
+ALTER TABLE passwords ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ;
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ;
+ALTER TABLE binaries ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ;
+ALTER TABLE notes ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ;
+ALTER TABLE otp_entries ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ;
+ALTER TABLE ssh_keys ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ;
+
+UPDATE passwords SET updated_at = created_at WHERE updated_at IS NULL;
+UPDATE cards SET updated_at = created_at WHERE updated_at IS NULL;
+UPDATE binaries SET updated_at = created_at WHERE updated_at IS NULL;
+UPDATE notes SET updated_at = created_at WHERE updated_at IS NULL;
+UPDATE otp_entries SET updated_at = created_at WHERE updated_at IS NULL;
+UPDATE ssh_keys SET updated_at = created_at WHERE updated_at IS NULL;
+
+ALTER TABLE passwords ALTER COLUMN updated_at SET DEFAULT now(), ALTER COLUMN updated_at SET NOT NULL;
+ALTER TABLE cards ALTER COLUMN updated_at SET DEFAULT now(), ALTER COLUMN updated_at SET NOT NULL;
+ALTER TABLE binaries ALTER COLUMN updated_at SET DEFAULT now(), ALTER COLUMN updated_at SET NOT NULL;
+ALTER TABLE notes ALTER COLUMN updated_at SET DEFAULT now(), ALTER COLUMN updated_at SET NOT NULL;
+ALTER TABLE otp_entries ALTER COLUMN updated_at SET DEFAULT now(), ALTER COLUMN updated_at SET NOT NULL;
+ALTER TABLE ssh_keys ALTER COLUMN updated_at SET DEFAULT now(), ALTER COLUMN updated_at SET NOT NULL;
+
+ALTER TABLE passwords ADD COLUMN IF NOT EXISTS last_accessed_at TIMESTAMPTZ, ADD COLUMN IF NOT EXISTS access_count BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS last_accessed_at TIMESTAMPTZ, ADD COLUMN IF NOT EXISTS access_count BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE binaries ADD COLUMN IF NOT EXISTS last_accessed_at TIMESTAMPTZ, ADD COLUMN IF NOT EXISTS access_count BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE notes ADD COLUMN IF NOT EXISTS last_accessed_at TIMESTAMPTZ, ADD COLUMN IF NOT EXISTS access_count BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE otp_entries ADD COLUMN IF NOT EXISTS last_accessed_at TIMESTAMPTZ, ADD COLUMN IF NOT EXISTS access_count BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE ssh_keys ADD COLUMN IF NOT EXISTS last_accessed_at TIMESTAMPTZ, ADD COLUMN IF NOT EXISTS access_count BIGINT NOT NULL DEFAULT 0;
+
+-- Listings ordered by update time, the kind used to find items not rotated for a while.
+CREATE INDEX IF NOT EXISTS passwords_user_id_updated_at_idx
+ON passwords (user_id, updated_at, id);
+CREATE INDEX IF NOT EXISTS cards_user_id_updated_at_idx
+ON cards (user_id, updated_at, id);
+CREATE INDEX IF NOT EXISTS binaries_user_id_updated_at_idx
+ON binaries (user_id, updated_at, id);
+CREATE INDEX IF NOT EXISTS notes_user_id_updated_at_idx
+ON notes (user_id, updated_at, id);
+CREATE INDEX IF NOT EXISTS otp_entries_user_id_updated_at_idx
+ON otp_entries (user_id, updated_at, id);
+CREATE INDEX IF NOT EXISTS ssh_keys_user_id_updated_at_idx
+ON ssh_keys (user_id, updated_at, id);
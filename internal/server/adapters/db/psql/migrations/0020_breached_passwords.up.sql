@@ -0,0 +1,3 @@
+-- Password entries found in the configured breach corpus are flagged when they are written or audited.
+-- Existing entries count as not compromised until then.
+ALTER TABLE passwords ADD COLUMN IF NOT EXISTS compromised BOOLEAN NOT NULL DEFAULT false;
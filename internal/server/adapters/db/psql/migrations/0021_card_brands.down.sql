@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS card_reveals;
+ALTER TABLE card_history DROP COLUMN IF EXISTS brand;
+ALTER TABLE cards DROP COLUMN IF EXISTS brand;
@@ -0,0 +1,17 @@
+-- The brand of a card is kept in cleartext for listings and archived with its revisions; cards stored before
+-- it was detected have none until they are next written.
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS brand TEXT NOT NULL DEFAULT '';
+ALTER TABLE card_history ADD COLUMN IF NOT EXISTS brand TEXT NOT NULL DEFAULT '';
+
+-- Every reveal of the full number and security code of a card is recorded. Reveals outlive the card they were
+-- made on: like archived revisions they keep the ID of the record and, for the reader of the log, its title at
+-- the time, so purging the card from the trash does not erase them.
+CREATE TABLE IF NOT EXISTS card_reveals (
+	id SERIAL PRIMARY KEY,
+	card_id INTEGER NOT NULL,
+	title VARCHAR(255) NOT NULL DEFAULT '',
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	revealed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS card_reveals_card_id_idx
+ON card_reveals (card_id, revealed_at);
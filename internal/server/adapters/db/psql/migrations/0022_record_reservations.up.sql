@@ -0,0 +1,11 @@
+-- IDs reserved for records a vault account is about to create; the client binds the ciphertexts of the record
+-- to the ID before it sends them, and the reservation is consumed when the record is stored under it.
+CREATE TABLE IF NOT EXISTS record_reservations (
+	table_name TEXT NOT NULL,
+	id BIGINT NOT NULL,
+	user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	reserved_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	PRIMARY KEY (table_name, id)
+);
+CREATE INDEX IF NOT EXISTS record_reservations_user_id_idx
+ON record_reservations (user_id, reserved_at);
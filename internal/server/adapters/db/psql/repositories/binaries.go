@@ -7,6 +7,7 @@ import (
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5/pgconn"
 	_ "github.com/jackc/pgx/v5/stdlib"
+	"io"
 	"main/internal/server/adapters/db/psql"
 	"main/internal/server/models"
 	"main/internal/server/services"
@@ -26,23 +27,69 @@ func NewBinariesRepository(db *psql.DB) *BinariesRepository {
 
 // Get retrieves binary data by title and user ID from the database
 func (r *BinariesRepository) Get(ctx context.Context, title string, UserID int64) (*models.BinaryData, error) {
-	var result models.BinaryData
+	var (
+		result  models.BinaryData
+		size    sql.NullInt64
+		blobKey sql.NullString
+	)
 
-	err := r.db.Conn.QueryRowContext(ctx, stmt.binary.get, title, UserID).Scan(&result.ID, &result.Title, &result.Data)
+	err := r.db.Conn.QueryRowContext(ctx, stmt.binary.get, title, UserID).
+		Scan(&result.ID, &result.Title, &result.UserID, &result.Data, &result.DataKey, &size, &result.SHA256,
+			&blobKey, &result.Streamed,
+			&result.Stats.CreatedAt, &result.Stats.UpdatedAt, &result.Stats.AccessedAt, &result.Stats.AccessCount)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, services.ErrBinaryNotFound
 		}
 		return nil, err
 	}
+	result.Size, result.BlobKey = size.Int64, blobKey.String
 	return &result, nil
 }
 
-// Add stores new binary data in the database
+// Touch counts a read of binary data by ID and stamps its time
+func (r *BinariesRepository) Touch(ctx context.Context, id int64) error {
+	_, err := r.db.Conn.ExecContext(ctx, stmt.binary.touch, id)
+	return err
+}
+
+// NextID reserves an ID for a new binary data entry from the table sequence
+func (r *BinariesRepository) NextID(ctx context.Context) (int64, error) {
+	var id int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.binary.nextID).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// GetID resolves the ID of a binary data entry by title and user ID
+func (r *BinariesRepository) GetID(ctx context.Context, title string, UserID int64) (int64, error) {
+	var id int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.binary.getID, title, UserID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, services.ErrBinaryNotFound
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+// Add stores the metadata and the placement of new binary data, whose content is already in its blob
 func (r *BinariesRepository) Add(ctx context.Context, cond models.BinaryData) (string, error) {
 	var title string
 
-	err := r.db.Conn.QueryRowContext(ctx, stmt.binary.add, cond.Title, cond.UserID, cond.Data).Scan(&title)
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, stmt.binary.add, cond.ID, cond.Title, cond.UserID, cond.DataKey,
+		cond.Size, cond.SHA256, cond.BlobKey, cond.Streamed).Scan(&title)
 
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
@@ -52,25 +99,194 @@ func (r *BinariesRepository) Add(ctx context.Context, cond models.BinaryData) (s
 	if err != nil {
 		return "", err
 	}
+
+	if err := place(ctx, tx, models.TableBinaries, cond.ID, cond.UserID, cond.Placement); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
 	return title, nil
 }
 
-// Update modifies existing binary data in the database
+// Update points existing binary data to a new blob, dropping any content kept in the database.
+// The previous version is archived first; its content must already be in a blob. The binary is moved and retagged
+// only as far as its placement asks for it.
 func (r *BinariesRepository) Update(ctx context.Context, cond models.BinaryData) (string, error) {
 	var title string
 
-	err := r.db.Conn.QueryRowContext(ctx, stmt.binary.update, cond.Data, cond.Title, cond.UserID).Scan(&title)
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
 	if err != nil {
 		return "", err
 	}
+	defer tx.Rollback()
+
+	id, err := archive(ctx, tx, stmt.binary.history, cond.Title, cond.UserID, false)
+	if errors.Is(err, sql.ErrNoRows) || err == nil && id != cond.ID {
+		return "", services.ErrBinaryNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.QueryRowContext(ctx, stmt.binary.update, cond.ID, cond.UserID, cond.DataKey,
+		cond.Size, cond.SHA256, cond.BlobKey, cond.Streamed).Scan(&title)
+	if err != nil {
+		return "", err
+	}
+
+	if err := place(ctx, tx, models.TableBinaries, cond.ID, cond.UserID, cond.Placement); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
 	return title, nil
 }
 
-// Delete removes binary data from the database by title and user ID
+// Rename gives binary data found by ID or title a new title (see rename)
+func (r *BinariesRepository) Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error) {
+	result, err := rename(ctx, r.db, stmt.binary.history, stmt.binary.rename, cond)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
+		return nil, services.ErrBinaryAlreadyExists
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrBinaryNotFound
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// Delete moves binary data to the trash by title and user ID.
+// Its content must already be in a blob, since a record purged from the trash is archived.
 func (r *BinariesRepository) Delete(ctx context.Context, title string, UserID int64) error {
-	_, err := r.db.Conn.ExecContext(ctx, stmt.binary.delete, title, UserID)
+	ok, err := moveToTrash(ctx, r.db, stmt.binary.history, stmt.binary.trash, title, UserID)
 	if err != nil {
 		return err
 	}
+	if !ok {
+		return services.ErrBinaryNotFound
+	}
 	return nil
 }
+
+// List returns a page of binary data entries belonging to the user, ordered and filtered as requested
+func (r *BinariesRepository) List(ctx context.Context, filter models.ListFilter) ([]models.ListItem, error) {
+	return list(ctx, r.db, stmt.binary.list, filter)
+}
+
+// OpenChunks returns the concatenated ciphertext chunks of binary data streamed before the blob store
+func (r *BinariesRepository) OpenChunks(ctx context.Context, id int64) (io.ReadCloser, error) {
+	rows, err := r.db.Conn.QueryContext(ctx, stmt.binary.chunks, id)
+	if err != nil {
+		return nil, err
+	}
+	return &chunkReader{rows: rows}, nil
+}
+
+// Legacy returns up to limit binary data entries whose content is still stored in the database
+func (r *BinariesRepository) Legacy(ctx context.Context, limit int) ([]models.BinaryData, error) {
+	rows, err := r.db.Conn.QueryContext(ctx, stmt.binary.legacy, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.BinaryData
+	for rows.Next() {
+		var (
+			item models.BinaryData
+			size sql.NullInt64
+		)
+		err := rows.Scan(&item.ID, &item.Title, &item.UserID, &item.Data, &item.DataKey, &size, &item.SHA256)
+		if err != nil {
+			return nil, err
+		}
+		item.Size = size.Int64
+		result = append(result, item)
+	}
+	return result, rows.Err()
+}
+
+// Offload points binary data whose content is stored in the database to the blob now holding it.
+// It reports false if the entry was moved or deleted in the meantime.
+func (r *BinariesRepository) Offload(ctx context.Context, cond models.BinaryData) (bool, error) {
+	res, err := r.db.Conn.ExecContext(ctx, stmt.binary.offload, cond.ID, cond.DataKey, cond.Size, cond.SHA256, cond.BlobKey)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// HasBlob reports whether a blob is referenced by any binary data entry or archived revision
+func (r *BinariesRepository) HasBlob(ctx context.Context, key string) (bool, error) {
+	var ok bool
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.binary.hasBlob, key).Scan(&ok)
+	if err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+// History lists the archived revisions of a binary data title, newest first
+func (r *BinariesRepository) History(ctx context.Context, title string, UserID int64) ([]models.Revision, error) {
+	return history(ctx, r.db, stmt.binary.history, title, UserID)
+}
+
+// GetRevision retrieves an archived revision of binary data; its ID is the one of the archived record
+func (r *BinariesRepository) GetRevision(ctx context.Context, title string, UserID int64, revision int) (*models.BinaryData, error) {
+	var result models.BinaryData
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.binary.history.get, title, UserID, revision).
+		Scan(&result.ID, &result.Title, &result.UserID, &result.DataKey, &result.Size, &result.SHA256,
+			&result.BlobKey, &result.Streamed)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrRevisionNotFound
+		}
+		return nil, err
+	}
+	return &result, nil
+}
+
+// chunkReader reads the chunks of a streamed binary data entry one row at a time
+type chunkReader struct {
+	rows *sql.Rows // Pending chunk rows
+	buf  []byte    // Unread rest of the current chunk
+}
+
+// Read copies the next bytes of the chunks into p
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for len(c.buf) == 0 {
+		if !c.rows.Next() {
+			if err := c.rows.Err(); err != nil {
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		if err := c.rows.Scan(&c.buf); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+// Close releases the chunk rows
+func (c *chunkReader) Close() error {
+	return c.rows.Close()
+}
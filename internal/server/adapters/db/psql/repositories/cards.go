@@ -28,7 +28,8 @@ func NewCardsRepository(db *psql.DB) *CardsRepository {
 func (r *CardsRepository) Get(ctx context.Context, title string, UserID int64) (*models.Card, error) {
 	var result models.Card
 
-	err := r.db.Conn.QueryRowContext(ctx, stmt.card.get, title, UserID).Scan(&result.ID, &result.Title, &result.Bank, &result.Number, &result.DataEnd, &result.SecretCode)
+	err := r.db.Conn.QueryRowContext(ctx, stmt.card.get, title, UserID).Scan(&result.ID, &result.Title, &result.UserID, &result.Bank, &result.Number, &result.DataEnd, &result.SecretCode,
+		&result.Brand, &result.Stats.CreatedAt, &result.Stats.UpdatedAt, &result.Stats.AccessedAt, &result.Stats.AccessCount, &result.Reveals, &result.RevealedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, services.ErrCardNotFound
@@ -38,11 +39,54 @@ func (r *CardsRepository) Get(ctx context.Context, title string, UserID int64) (
 	return &result, nil
 }
 
-// Add stores new credit card information in the database
+// Touch counts a read of a credit card by ID and stamps its time
+func (r *CardsRepository) Touch(ctx context.Context, id int64) error {
+	_, err := r.db.Conn.ExecContext(ctx, stmt.card.touch, id)
+	return err
+}
+
+// Reveal records a reveal of the full number and security code of a credit card by ID
+func (r *CardsRepository) Reveal(ctx context.Context, id int64, UserID int64) error {
+	_, err := r.db.Conn.ExecContext(ctx, stmt.card.reveal, id, UserID)
+	return err
+}
+
+// NextID reserves an ID for a new credit card from the table sequence
+func (r *CardsRepository) NextID(ctx context.Context) (int64, error) {
+	var id int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.card.nextID).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// GetID resolves the ID of a credit card by title and user ID
+func (r *CardsRepository) GetID(ctx context.Context, title string, UserID int64) (int64, error) {
+	var id int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.card.getID, title, UserID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, services.ErrCardNotFound
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+// Add stores new credit card information in the database, placed in its folder with its tags
 func (r *CardsRepository) Add(ctx context.Context, cond models.Card) (string, error) {
 	var title string
 
-	err := r.db.Conn.QueryRowContext(ctx, stmt.card.add, cond.Title, cond.UserID, cond.Bank, cond.Number, cond.DataEnd, cond.SecretCode).Scan(&title)
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, stmt.card.add, cond.ID, cond.Title, cond.UserID, cond.Bank, cond.Number, cond.DataEnd, cond.SecretCode, cond.Brand).Scan(&title)
 
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
@@ -52,25 +96,101 @@ func (r *CardsRepository) Add(ctx context.Context, cond models.Card) (string, er
 	if err != nil {
 		return "", err
 	}
+
+	if err := place(ctx, tx, models.TableCards, cond.ID, cond.UserID, cond.Placement); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
 	return title, nil
 }
 
-// Update modifies existing credit card information in the database
+// Update modifies existing credit card information and its placement in the database, archiving the previous version first
 func (r *CardsRepository) Update(ctx context.Context, cond models.Card) (string, error) {
 	var title string
 
-	err := r.db.Conn.QueryRowContext(ctx, stmt.card.update, cond.Bank, cond.Number, cond.DataEnd, cond.SecretCode, cond.Title, cond.UserID).Scan(&title)
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	id, err := archive(ctx, tx, stmt.card.history, cond.Title, cond.UserID, false)
+	if errors.Is(err, sql.ErrNoRows) || err == nil && id != cond.ID {
+		return "", services.ErrCardNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.QueryRowContext(ctx, stmt.card.update, cond.Bank, cond.Number, cond.DataEnd, cond.SecretCode, cond.ID, cond.UserID, cond.Brand).Scan(&title)
 	if err != nil {
 		return "", err
 	}
+
+	if err := place(ctx, tx, models.TableCards, cond.ID, cond.UserID, cond.Placement); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
 	return title, nil
 }
 
-// Delete removes credit card information from the database by title and user ID
+// Rename gives a credit card found by ID or title a new title (see rename)
+func (r *CardsRepository) Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error) {
+	result, err := rename(ctx, r.db, stmt.card.history, stmt.card.rename, cond)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
+		return nil, services.ErrCardAlreadyExists
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrCardNotFound
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// Delete moves credit card information to the trash by title and user ID
 func (r *CardsRepository) Delete(ctx context.Context, title string, UserID int64) error {
-	_, err := r.db.Conn.ExecContext(ctx, stmt.card.delete, title, UserID)
+	ok, err := moveToTrash(ctx, r.db, stmt.card.history, stmt.card.trash, title, UserID)
 	if err != nil {
 		return err
 	}
+	if !ok {
+		return services.ErrCardNotFound
+	}
 	return nil
 }
+
+// List returns a page of credit card entries belonging to the user, ordered and filtered as requested
+func (r *CardsRepository) List(ctx context.Context, filter models.ListFilter) ([]models.ListItem, error) {
+	return list(ctx, r.db, stmt.card.list, filter)
+}
+
+// History lists the archived revisions of a credit card title, newest first
+func (r *CardsRepository) History(ctx context.Context, title string, UserID int64) ([]models.Revision, error) {
+	return history(ctx, r.db, stmt.card.history, title, UserID)
+}
+
+// GetRevision retrieves an archived revision of a credit card; its ID is the one of the archived record
+func (r *CardsRepository) GetRevision(ctx context.Context, title string, UserID int64, revision int) (*models.Card, error) {
+	var result models.Card
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.card.history.get, title, UserID, revision).
+		Scan(&result.ID, &result.Title, &result.UserID, &result.Bank, &result.Number, &result.DataEnd, &result.SecretCode, &result.Brand)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrRevisionNotFound
+		}
+		return nil, err
+	}
+	return &result, nil
+}
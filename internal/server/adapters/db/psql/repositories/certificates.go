@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/models"
+	"main/internal/server/services"
+)
+
+// CertificatesRepository implements the client certificate mapping data access layer for PostgreSQL
+type CertificatesRepository struct {
+	db *psql.DB // Database connection
+}
+
+// NewCertificatesRepository creates a new CertificatesRepository instance
+func NewCertificatesRepository(db *psql.DB) *CertificatesRepository {
+	return &CertificatesRepository{
+		db: db,
+	}
+}
+
+// Add maps a client certificate subject to the user and the session backing the mapping.
+// A mapping of the subject whose session was revoked or expired is taken over.
+func (r *CertificatesRepository) Add(ctx context.Context, userID int64, subject string, sessionID int64) error {
+	res, err := r.db.Conn.ExecContext(ctx, stmt.certificate.add, userID, subject, sessionID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return services.ErrCertificateAlreadyBound
+	}
+	return nil
+}
+
+// Delete removes a client certificate subject mapped to the user, returning the session backing the mapping
+func (r *CertificatesRepository) Delete(ctx context.Context, userID int64, subject string) (int64, error) {
+	var sessionID int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.certificate.delete, userID, subject).Scan(&sessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, services.ErrCertificateNotBound
+		}
+		return -1, err
+	}
+	return sessionID, nil
+}
+
+// UserID resolves the user and the active session a client certificate subject is mapped to
+func (r *CertificatesRepository) UserID(ctx context.Context, subject string) (int64, int64, error) {
+	var userID, sessionID int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.certificate.user, subject).Scan(&userID, &sessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, -1, services.ErrCertificateNotBound
+		}
+		return -1, -1, err
+	}
+	return userID, sessionID, nil
+}
+
+// List returns the client certificate subjects mapped to the user whose sessions are active, newest first
+func (r *CertificatesRepository) List(ctx context.Context, userID int64) ([]models.Certificate, error) {
+	rows, err := r.db.Conn.QueryContext(ctx, stmt.certificate.list, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.Certificate
+	for rows.Next() {
+		var c models.Certificate
+		if err := rows.Scan(&c.Subject, &c.SessionID, &c.CreatedAt, &c.ExpiresAt); err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+	return result, rows.Err()
+}
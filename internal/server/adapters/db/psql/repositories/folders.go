@@ -0,0 +1,253 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/models"
+	"main/internal/server/services"
+)
+
+// FoldersRepository implements the folder tree and item tags data access layer for PostgreSQL.
+// Items of every kind are placed in folders through the folder_id column of their table,
+// while their tags are kept in a table shared by all kinds.
+type FoldersRepository struct {
+	db *psql.DB // Database connection
+}
+
+// NewFoldersRepository creates a new FoldersRepository instance
+func NewFoldersRepository(db *psql.DB) *FoldersRepository {
+	return &FoldersRepository{
+		db: db,
+	}
+}
+
+// List returns every folder of the user
+func (r *FoldersRepository) List(ctx context.Context, userID int64) ([]models.Folder, error) {
+	rows, err := r.db.Conn.QueryContext(ctx, stmt.folder.list, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.Folder
+	for rows.Next() {
+		var folder models.Folder
+		if err := rows.Scan(&folder.ID, &folder.UserID, &folder.ParentID, &folder.Name); err != nil {
+			return nil, err
+		}
+		result = append(result, folder)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Add creates a folder and returns its ID
+func (r *FoldersRepository) Add(ctx context.Context, cond models.Folder) (int64, error) {
+	var id int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.folder.add, cond.UserID, cond.ParentID, cond.Name).Scan(&id)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
+		return 0, services.ErrFolderAlreadyExists
+	}
+
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// Move renames a folder and places it under a new parent
+func (r *FoldersRepository) Move(ctx context.Context, cond models.Folder) error {
+	result, err := r.db.Conn.ExecContext(ctx, stmt.folder.move, cond.ID, cond.UserID, cond.ParentID, cond.Name)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
+		return services.ErrFolderAlreadyExists
+	}
+
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return services.ErrFolderNotFound
+	}
+	return nil
+}
+
+// Remove deletes an empty folder of the user. Folders holding subfolders or live items of any kind are kept
+// and ErrFolderNotEmpty is returned; trashed items of a removed folder are moved to the root.
+func (r *FoldersRepository) Remove(ctx context.Context, id, userID int64) error {
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, stmt.folder.lock, id, userID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return services.ErrFolderNotFound
+		}
+		return err
+	}
+
+	queries := []string{stmt.folder.children}
+	for _, kind := range models.TrashKinds {
+		q, err := placementTable(kind)
+		if err != nil {
+			return err
+		}
+		queries = append(queries, q.used)
+	}
+	for _, query := range queries {
+		used, err := exists(ctx, tx, query, id)
+		if err != nil {
+			return err
+		}
+		if used {
+			return services.ErrFolderNotEmpty
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, stmt.folder.remove, id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Place moves the live item of the given kind and title to a folder and replaces its tags,
+// each only if the placement asks for it; ErrItemNotFound is returned if there is no such item
+func (r *FoldersRepository) Place(ctx context.Context, kind, title string, userID int64, p models.Placement) error {
+	if !p.ReplaceFolder && !p.ReplaceTags {
+		return nil
+	}
+
+	q, err := placementTable(kind)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var id int64
+	err = tx.QueryRowContext(ctx, q.lock, title, userID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return services.ErrItemNotFound
+		}
+		return err
+	}
+
+	if err := place(ctx, tx, kind, id, userID, p); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Placement returns the folder ID and the tags of the item of the given kind and ID
+func (r *FoldersRepository) Placement(ctx context.Context, kind string, id int64) (*models.Placement, error) {
+	q, err := placementTable(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var result models.Placement
+
+	err = r.db.Conn.QueryRowContext(ctx, q.folder, id).Scan(&result.FolderID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Conn.QueryContext(ctx, stmt.folder.tags, kind, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		result.Tags = append(result.Tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// place moves the item of the given kind and ID to a folder and replaces its tags within tx, each only if
+// the placement asks for it. Items are placed in the transaction writing them, so both are rolled back together.
+func place(ctx context.Context, tx *sql.Tx, kind string, id, userID int64, p models.Placement) error {
+	if !p.ReplaceFolder && !p.ReplaceTags {
+		return nil
+	}
+
+	q, err := placementTable(kind)
+	if err != nil {
+		return err
+	}
+
+	if p.ReplaceFolder {
+		if _, err := tx.ExecContext(ctx, q.move, id, p.FolderID); err != nil {
+			return err
+		}
+	}
+	if p.ReplaceTags {
+		if _, err := tx.ExecContext(ctx, stmt.folder.clearTags, kind, id); err != nil {
+			return err
+		}
+		if len(p.Tags) > 0 {
+			if _, err := tx.ExecContext(ctx, stmt.folder.addTags, userID, kind, id, p.Tags); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// exists runs a query checking for the existence of rows within tx.
+func exists(ctx context.Context, tx *sql.Tx, query string, args ...any) (bool, error) {
+	var result bool
+
+	err := tx.QueryRowContext(ctx, query, args...).Scan(&result)
+	return result, err
+}
+
+// placementTable resolves the placement queries of the table holding items of the given kind.
+func placementTable(kind string) (placementQueries, error) {
+	switch kind {
+	case models.TablePasswords:
+		return stmt.password.placement, nil
+	case models.TableCards:
+		return stmt.card.placement, nil
+	case models.TableBinaries:
+		return stmt.binary.placement, nil
+	case models.TableNotes:
+		return stmt.note.placement, nil
+	case models.TableOTP:
+		return stmt.otp.placement, nil
+	case models.TableSSHKeys:
+		return stmt.sshKey.placement, nil
+	default:
+		return placementQueries{}, services.ErrUnknownKind
+	}
+}
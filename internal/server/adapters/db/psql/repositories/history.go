@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/models"
+)
+
+// archive locks the live or, if purged is set, the trashed record with the given title within tx and copies
+// its current version into the history under the next revision number of the title, together with its related rows,
+// then drops the revisions beyond the owner's retention. Purged records are archived as deleted.
+// It returns the ID of the archived record, or sql.ErrNoRows if the user has no such record.
+func archive(ctx context.Context, tx *sql.Tx, q historyQueries, title string, userID int64, purged bool) (int64, error) {
+	var id int64
+
+	if err := tx.QueryRowContext(ctx, q.lock, title, userID, purged).Scan(&id); err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, q.archive, id, userID, purged); err != nil {
+		return 0, err
+	}
+	if q.related != "" {
+		if _, err := tx.ExecContext(ctx, q.related, id, userID); err != nil {
+			return 0, err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, q.prune, title, userID); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// history lists the archived revisions of a title, newest first.
+func history(ctx context.Context, db *psql.DB, q historyQueries, title string, userID int64) ([]models.Revision, error) {
+	rows, err := db.Conn.QueryContext(ctx, q.list, title, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.Revision
+	for rows.Next() {
+		var item models.Revision
+		if err := rows.Scan(&item.Revision, &item.Deleted, &item.ArchivedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
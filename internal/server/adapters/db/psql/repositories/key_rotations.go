@@ -0,0 +1,194 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/models"
+	"main/internal/server/services"
+)
+
+// KeyRotationsRepository persists master key rotation jobs and walks the encrypted tables on their behalf.
+type KeyRotationsRepository struct {
+	db *psql.DB // Database connection
+}
+
+// NewKeyRotationsRepository creates a new KeyRotationsRepository instance
+func NewKeyRotationsRepository(db *psql.DB) *KeyRotationsRepository {
+	return &KeyRotationsRepository{
+		db: db,
+	}
+}
+
+// Start creates a rotation job moving every ciphertext to the given master key.
+// Only one job may be unfinished at a time.
+func (r *KeyRotationsRepository) Start(ctx context.Context, masterKeyID uint32) (*models.KeyRotation, error) {
+	result, err := scanRotation(r.db.Conn.QueryRowContext(ctx, stmt.rotation.start, masterKeyID, stmt.rotation.phases[0].name))
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
+		return nil, services.ErrRotationInProgress
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// List returns up to limit of the most recent rotation jobs, newest first
+func (r *KeyRotationsRepository) List(ctx context.Context, limit int) ([]models.KeyRotation, error) {
+	rows, err := r.db.Conn.QueryContext(ctx, stmt.rotation.list, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.KeyRotation
+	for rows.Next() {
+		job, err := scanRotation(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *job)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Advance processes the next batch of the unfinished job targeting the given master key.
+// Within a single transaction it locks the job and the next rows of its current phase, passes
+// every row to fn, stores the rows fn changed, and persists the new job position, moving on to
+// the next phase once a table is exhausted. Rows for which fn reports services.ErrDecryptionFailed
+// are counted as skipped; any other error aborts the batch. Returns services.ErrNoPendingRotation
+// when there is no job to advance or another replica is currently advancing it.
+func (r *KeyRotationsRepository) Advance(ctx context.Context, masterKeyID uint32, batch int, fn func(ctx context.Context, phase string, rec *models.EncryptedRecord) (bool, error)) (*models.KeyRotation, error) {
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	job, err := scanRotation(tx.QueryRowContext(ctx, stmt.rotation.lock, masterKeyID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrNoPendingRotation
+		}
+		return nil, err
+	}
+
+	idx := -1
+	for i, p := range stmt.rotation.phases {
+		if p.name == job.Phase {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		return nil, errors.New("unknown key rotation phase: " + job.Phase)
+	}
+	phase := stmt.rotation.phases[idx]
+
+	records, err := nextRotationBatch(ctx, tx, phase, job.LastID, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range records {
+		rec := &records[i]
+		job.LastID = rec.ID
+
+		changed, err := fn(ctx, phase.name, rec)
+		if errors.Is(err, services.ErrDecryptionFailed) {
+			job.Skipped++
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			continue
+		}
+
+		args := make([]any, 0, len(phase.columns)+1)
+		args = append(args, rec.ID)
+		for _, c := range phase.columns {
+			args = append(args, rec.Fields[c])
+		}
+		if _, err := tx.ExecContext(ctx, phase.update, args...); err != nil {
+			return nil, err
+		}
+		job.Processed++
+	}
+
+	finished := false
+	if len(records) < batch {
+		if idx+1 < len(stmt.rotation.phases) {
+			job.Phase = stmt.rotation.phases[idx+1].name
+			job.LastID = 0
+		} else {
+			finished = true
+		}
+	}
+
+	err = tx.QueryRowContext(ctx, stmt.rotation.save, job.ID, job.Phase, job.LastID, job.Processed, job.Skipped, finished).
+		Scan(&job.UpdatedAt, &job.FinishedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// nextRotationBatch locks and reads the next batch of rows of the phase table after the given key.
+func nextRotationBatch(ctx context.Context, tx *sql.Tx, phase rotationPhase, after int64, limit int) ([]models.EncryptedRecord, error) {
+	rows, err := tx.QueryContext(ctx, phase.batch, after, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.EncryptedRecord
+	for rows.Next() {
+		rec := models.EncryptedRecord{Table: phase.table, Fields: make(map[string][]byte, len(phase.columns))}
+		values := make([][]byte, len(phase.columns))
+
+		dest := make([]any, 0, len(phase.columns)+3)
+		dest = append(dest, &rec.ID, &rec.UserID, &rec.RecordID)
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		for i, c := range phase.columns {
+			rec.Fields[c] = values[i]
+		}
+		result = append(result, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// scanRotation reads a rotation job from a row holding all job columns.
+func scanRotation(row interface{ Scan(dest ...any) error }) (*models.KeyRotation, error) {
+	var result models.KeyRotation
+
+	err := row.Scan(&result.ID, &result.MasterKeyID, &result.Phase, &result.LastID, &result.Processed,
+		&result.Skipped, &result.CreatedAt, &result.UpdatedAt, &result.FinishedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
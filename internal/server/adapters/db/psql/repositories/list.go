@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"context"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/models"
+	"time"
+)
+
+// list executes the pagination query matching the filter ordering and collects the resulting page.
+// Records are returned strictly after the filter cursor, at most filter.Limit of them, restricted to
+// the folder subtree and tags of the filter and to the records not changed or read since its times.
+// Records never read are ordered by access time as if they were read at the Unix epoch.
+func list(ctx context.Context, db *psql.DB, q listQueries, f models.ListFilter) ([]models.ListItem, error) {
+	var (
+		cursorID       int64
+		cursorTitle    string
+		cursorDate     time.Time
+		cursorUpdated  time.Time
+		cursorAccessed = time.Unix(0, 0)
+	)
+	if f.After != nil {
+		cursorID = f.After.ID
+		cursorTitle = f.After.Title
+		cursorDate = f.After.CreatedAt
+		cursorUpdated = f.After.UpdatedAt
+		if !f.After.AccessedAt.IsZero() {
+			cursorAccessed = f.After.AccessedAt
+		}
+	}
+
+	var (
+		query string
+		key   any
+	)
+	switch f.SortBy {
+	case models.SortByDate:
+		query, key = q.dateAsc, cursorDate
+		if f.Descending {
+			query = q.dateDesc
+		}
+	case models.SortByUpdated:
+		query, key = q.updatedAsc, cursorUpdated
+		if f.Descending {
+			query = q.updatedDesc
+		}
+	case models.SortByAccessed:
+		query, key = q.accessedAsc, cursorAccessed
+		if f.Descending {
+			query = q.accessedDesc
+		}
+	default:
+		query, key = q.titleAsc, cursorTitle
+		if f.Descending {
+			query = q.titleDesc
+		}
+	}
+
+	rows, err := db.Conn.QueryContext(ctx, query, f.UserID, f.TitlePrefix, cursorID, key, f.Limit, f.FolderID, f.Tags,
+		timeOrNil(f.UpdatedBefore), timeOrNil(f.AccessedBefore))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]models.ListItem, 0, f.Limit)
+	for rows.Next() {
+		var item models.ListItem
+		if err := rows.Scan(listFields(&item)...); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// listFields returns the scan destinations of the listing columns of a record and its label, see listColumns.
+func listFields(item *models.ListItem) []any {
+	return []any{&item.ID, &item.Title, &item.Stats.CreatedAt, &item.Stats.UpdatedAt, &item.Stats.AccessedAt, &item.Stats.AccessCount, &item.Label}
+}
+
+// timeOrNil passes a zero time to a query as NULL.
+func timeOrNil(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
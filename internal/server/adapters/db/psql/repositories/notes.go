@@ -0,0 +1,190 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/models"
+	"main/internal/server/services"
+)
+
+// NotesRepository implements the notes data access layer for PostgreSQL
+type NotesRepository struct {
+	db *psql.DB
+}
+
+// NewNotesRepository creates a new NotesRepository instance
+func NewNotesRepository(db *psql.DB) *NotesRepository {
+	return &NotesRepository{
+		db: db,
+	}
+}
+
+// Get retrieves a note by title and user ID from the database
+func (r *NotesRepository) Get(ctx context.Context, title string, UserID int64) (*models.Note, error) {
+	var result models.Note
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.note.get, title, UserID).Scan(&result.ID, &result.Title, &result.UserID, &result.Body,
+		&result.Stats.CreatedAt, &result.Stats.UpdatedAt, &result.Stats.AccessedAt, &result.Stats.AccessCount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrNoteNotFound
+		}
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Touch counts a read of a note by ID and stamps its time
+func (r *NotesRepository) Touch(ctx context.Context, id int64) error {
+	_, err := r.db.Conn.ExecContext(ctx, stmt.note.touch, id)
+	return err
+}
+
+// NextID reserves an ID for a new note from the table sequence
+func (r *NotesRepository) NextID(ctx context.Context) (int64, error) {
+	var id int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.note.nextID).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// GetID resolves the ID of a note by title and user ID
+func (r *NotesRepository) GetID(ctx context.Context, title string, UserID int64) (int64, error) {
+	var id int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.note.getID, title, UserID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, services.ErrNoteNotFound
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+// Add stores a new note in the database, placed in its folder with its tags
+func (r *NotesRepository) Add(ctx context.Context, cond models.Note) (string, error) {
+	var title string
+
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, stmt.note.add, cond.ID, cond.Title, cond.UserID, cond.Body).Scan(&title)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
+		return "", services.ErrNoteAlreadyExists
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if err := place(ctx, tx, models.TableNotes, cond.ID, cond.UserID, cond.Placement); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+	return title, nil
+}
+
+// Update replaces the body and the placement of an existing note in the database, archiving the previous version first
+func (r *NotesRepository) Update(ctx context.Context, cond models.Note) (string, error) {
+	var title string
+
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	id, err := archive(ctx, tx, stmt.note.history, cond.Title, cond.UserID, false)
+	if errors.Is(err, sql.ErrNoRows) || err == nil && id != cond.ID {
+		return "", services.ErrNoteNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.QueryRowContext(ctx, stmt.note.update, cond.Body, cond.ID, cond.UserID).Scan(&title)
+	if err != nil {
+		return "", err
+	}
+
+	if err := place(ctx, tx, models.TableNotes, cond.ID, cond.UserID, cond.Placement); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+	return title, nil
+}
+
+// Rename gives a note found by ID or title a new title (see rename)
+func (r *NotesRepository) Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error) {
+	result, err := rename(ctx, r.db, stmt.note.history, stmt.note.rename, cond)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
+		return nil, services.ErrNoteAlreadyExists
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrNoteNotFound
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// Delete moves a note to the trash by title and user ID
+func (r *NotesRepository) Delete(ctx context.Context, title string, UserID int64) error {
+	ok, err := moveToTrash(ctx, r.db, stmt.note.history, stmt.note.trash, title, UserID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return services.ErrNoteNotFound
+	}
+	return nil
+}
+
+// List returns a page of notes belonging to the user, ordered and filtered as requested
+func (r *NotesRepository) List(ctx context.Context, filter models.ListFilter) ([]models.ListItem, error) {
+	return list(ctx, r.db, stmt.note.list, filter)
+}
+
+// History lists the archived revisions of a note title, newest first
+func (r *NotesRepository) History(ctx context.Context, title string, UserID int64) ([]models.Revision, error) {
+	return history(ctx, r.db, stmt.note.history, title, UserID)
+}
+
+// GetRevision retrieves an archived revision of a note; its ID is the one of the archived record
+func (r *NotesRepository) GetRevision(ctx context.Context, title string, UserID int64, revision int) (*models.Note, error) {
+	var result models.Note
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.note.history.get, title, UserID, revision).
+		Scan(&result.ID, &result.Title, &result.UserID, &result.Body)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrRevisionNotFound
+		}
+		return nil, err
+	}
+	return &result, nil
+}
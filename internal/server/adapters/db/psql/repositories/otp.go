@@ -0,0 +1,204 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/models"
+	"main/internal/server/services"
+)
+
+// OTPRepository implements the authenticator secrets data access layer for PostgreSQL
+type OTPRepository struct {
+	db *psql.DB
+}
+
+// NewOTPRepository creates a new OTPRepository instance
+func NewOTPRepository(db *psql.DB) *OTPRepository {
+	return &OTPRepository{
+		db: db,
+	}
+}
+
+// Get retrieves an authenticator secret by title and user ID from the database, with the title of its linked password
+func (r *OTPRepository) Get(ctx context.Context, title string, UserID int64) (*models.OTP, error) {
+	var (
+		result        models.OTP
+		passwordID    sql.NullInt64
+		passwordTitle sql.NullString
+	)
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.otp.get, title, UserID).Scan(&result.ID, &result.Title, &result.UserID, &passwordID, &passwordTitle,
+		&result.Issuer, &result.Account, &result.Secret, &result.Algorithm, &result.Digits, &result.Period,
+		&result.Stats.CreatedAt, &result.Stats.UpdatedAt, &result.Stats.AccessedAt, &result.Stats.AccessCount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrOTPNotFound
+		}
+		return nil, err
+	}
+	result.PasswordID = passwordID.Int64
+	result.PasswordTitle = passwordTitle.String
+	return &result, nil
+}
+
+// Touch counts a read of an authenticator secret by ID and stamps its time
+func (r *OTPRepository) Touch(ctx context.Context, id int64) error {
+	_, err := r.db.Conn.ExecContext(ctx, stmt.otp.touch, id)
+	return err
+}
+
+// NextID reserves an ID for a new authenticator secret from the table sequence
+func (r *OTPRepository) NextID(ctx context.Context) (int64, error) {
+	var id int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.otp.nextID).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// GetID resolves the ID of an authenticator secret by title and user ID
+func (r *OTPRepository) GetID(ctx context.Context, title string, UserID int64) (int64, error) {
+	var id int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.otp.getID, title, UserID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, services.ErrOTPNotFound
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+// Add stores a new authenticator secret in the database, placed in its folder with its tags; a link to a password that no longer exists is dropped
+func (r *OTPRepository) Add(ctx context.Context, cond models.OTP) (string, error) {
+	var title string
+
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, stmt.otp.add, cond.ID, cond.Title, cond.UserID, cond.PasswordID,
+		cond.Issuer, cond.Account, cond.Secret, cond.Algorithm, cond.Digits, cond.Period).Scan(&title)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
+		return "", services.ErrOTPAlreadyExists
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if err := place(ctx, tx, models.TableOTP, cond.ID, cond.UserID, cond.Placement); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+	return title, nil
+}
+
+// Update replaces an existing authenticator secret and its placement in the database, archiving the previous version first
+func (r *OTPRepository) Update(ctx context.Context, cond models.OTP) (string, error) {
+	var title string
+
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	id, err := archive(ctx, tx, stmt.otp.history, cond.Title, cond.UserID, false)
+	if errors.Is(err, sql.ErrNoRows) || err == nil && id != cond.ID {
+		return "", services.ErrOTPNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.QueryRowContext(ctx, stmt.otp.update, cond.PasswordID, cond.Issuer, cond.Account, cond.Secret,
+		cond.Algorithm, cond.Digits, cond.Period, cond.ID, cond.UserID).Scan(&title)
+	if err != nil {
+		return "", err
+	}
+
+	if err := place(ctx, tx, models.TableOTP, cond.ID, cond.UserID, cond.Placement); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+	return title, nil
+}
+
+// Rename gives an authenticator secret found by ID or title a new title (see rename)
+func (r *OTPRepository) Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error) {
+	result, err := rename(ctx, r.db, stmt.otp.history, stmt.otp.rename, cond)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
+		return nil, services.ErrOTPAlreadyExists
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrOTPNotFound
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// Delete moves an authenticator secret to the trash by title and user ID
+func (r *OTPRepository) Delete(ctx context.Context, title string, UserID int64) error {
+	ok, err := moveToTrash(ctx, r.db, stmt.otp.history, stmt.otp.trash, title, UserID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return services.ErrOTPNotFound
+	}
+	return nil
+}
+
+// List returns a page of authenticator secrets belonging to the user, ordered and filtered as requested
+func (r *OTPRepository) List(ctx context.Context, filter models.ListFilter) ([]models.ListItem, error) {
+	return list(ctx, r.db, stmt.otp.list, filter)
+}
+
+// History lists the archived revisions of an authenticator secret title, newest first
+func (r *OTPRepository) History(ctx context.Context, title string, UserID int64) ([]models.Revision, error) {
+	return history(ctx, r.db, stmt.otp.history, title, UserID)
+}
+
+// GetRevision retrieves an archived revision of an authenticator secret; its ID is the one of the archived record
+func (r *OTPRepository) GetRevision(ctx context.Context, title string, UserID int64, revision int) (*models.OTP, error) {
+	var (
+		result     models.OTP
+		passwordID sql.NullInt64
+	)
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.otp.history.get, title, UserID, revision).
+		Scan(&result.ID, &result.Title, &result.UserID, &passwordID, &result.Issuer, &result.Account, &result.Secret,
+			&result.Algorithm, &result.Digits, &result.Period)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrRevisionNotFound
+		}
+		return nil, err
+	}
+	result.PasswordID = passwordID.Int64
+	return &result, nil
+}
@@ -28,21 +28,86 @@ func NewPasswordsRepository(db *psql.DB) *PasswordsRepository {
 func (r *PasswordsRepository) Get(ctx context.Context, title string, UserID int64) (*models.Password, error) {
 	var result models.Password
 
-	err := r.db.Conn.QueryRowContext(ctx, stmt.password.get, title, UserID).Scan(&result.ID, &result.Title, &result.UserID, &result.Login, &result.Password)
+	err := r.db.Conn.QueryRowContext(ctx, stmt.password.get, title, UserID).Scan(&result.ID, &result.Title, &result.UserID, &result.Login, &result.Password,
+		&result.Compromised, &result.Stats.CreatedAt, &result.Stats.UpdatedAt, &result.Stats.AccessedAt, &result.Stats.AccessCount)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, services.ErrPasswordNotFound
 		}
 		return nil, err
 	}
+
+	result.Fields, err = r.scanFields(ctx, stmt.password.fields.get, result.ID, UserID)
+	if err != nil {
+		return nil, err
+	}
 	return &result, nil
 }
 
-// Add stores new password information in the database
+// Touch counts a read of a password entry by ID and stamps its time
+func (r *PasswordsRepository) Touch(ctx context.Context, id int64) error {
+	_, err := r.db.Conn.ExecContext(ctx, stmt.password.touch, id)
+	return err
+}
+
+// NextID reserves an ID for a new password entry from the table sequence
+func (r *PasswordsRepository) NextID(ctx context.Context) (int64, error) {
+	var id int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.password.nextID).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// NextFieldIDs reserves IDs for n new custom fields from the table sequence
+func (r *PasswordsRepository) NextFieldIDs(ctx context.Context, n int) ([]int64, error) {
+	rows, err := r.db.Conn.QueryContext(ctx, stmt.password.fields.nextIDs, n)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]int64, 0, n)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetID resolves the ID of a password entry by title and user ID
+func (r *PasswordsRepository) GetID(ctx context.Context, title string, UserID int64) (int64, error) {
+	var id int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.password.getID, title, UserID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, services.ErrPasswordNotFound
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+// Add stores new password information together with its custom fields and placement in the database
 func (r *PasswordsRepository) Add(ctx context.Context, cond models.Password) (string, error) {
 	var title string
 
-	err := r.db.Conn.QueryRowContext(ctx, stmt.password.add, cond.Title, cond.UserID, cond.Login, cond.Password).Scan(&title)
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, stmt.password.add, cond.ID, cond.Title, cond.UserID, cond.Login, cond.Password, cond.Compromised).Scan(&title)
 
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
@@ -52,25 +117,183 @@ func (r *PasswordsRepository) Add(ctx context.Context, cond models.Password) (st
 	if err != nil {
 		return "", err
 	}
+
+	if err := addFields(ctx, tx, cond); err != nil {
+		return "", err
+	}
+
+	if err := place(ctx, tx, models.TablePasswords, cond.ID, cond.UserID, cond.Placement); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
 	return title, nil
 }
 
-// Update modifies existing password information in the database
+// Update modifies existing password information in the database, archiving the previous version first.
+// The custom fields are replaced only if ReplaceFields is set, the folder and tags only as far as the placement asks for it
 func (r *PasswordsRepository) Update(ctx context.Context, cond models.Password) (string, error) {
 	var title string
 
-	err := r.db.Conn.QueryRowContext(ctx, stmt.password.update, cond.Login, cond.Password, cond.Title, cond.UserID).Scan(&title)
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	id, err := archive(ctx, tx, stmt.password.history, cond.Title, cond.UserID, false)
+	if errors.Is(err, sql.ErrNoRows) || err == nil && id != cond.ID {
+		return "", services.ErrPasswordNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.QueryRowContext(ctx, stmt.password.update, cond.Login, cond.Password, cond.ID, cond.UserID, cond.Compromised).Scan(&title)
 	if err != nil {
 		return "", err
 	}
+
+	if cond.ReplaceFields {
+		if _, err := tx.ExecContext(ctx, stmt.password.fields.clear, cond.ID, cond.UserID); err != nil {
+			return "", err
+		}
+		if err := addFields(ctx, tx, cond); err != nil {
+			return "", err
+		}
+	}
+
+	if err := place(ctx, tx, models.TablePasswords, cond.ID, cond.UserID, cond.Placement); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
 	return title, nil
 }
 
-// Delete removes password information from the database by title and user ID
+// Rename gives a password entry found by ID or title a new title (see rename)
+func (r *PasswordsRepository) Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error) {
+	result, err := rename(ctx, r.db, stmt.password.history, stmt.password.rename, cond)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
+		return nil, services.ErrPasswordAlreadyExists
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrPasswordNotFound
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// Delete moves password information to the trash by title and user ID
 func (r *PasswordsRepository) Delete(ctx context.Context, title string, UserID int64) error {
-	_, err := r.db.Conn.ExecContext(ctx, stmt.password.delete, title, UserID)
+	ok, err := moveToTrash(ctx, r.db, stmt.password.history, stmt.password.trash, title, UserID)
 	if err != nil {
 		return err
 	}
+	if !ok {
+		return services.ErrPasswordNotFound
+	}
+	return nil
+}
+
+// List returns a page of password entries belonging to the user, ordered and filtered as requested
+func (r *PasswordsRepository) List(ctx context.Context, filter models.ListFilter) ([]models.ListItem, error) {
+	return list(ctx, r.db, stmt.password.list, filter)
+}
+
+// All returns the live password entries of the user, ordered by title, with their encrypted passwords,
+// breach flags and update times only
+func (r *PasswordsRepository) All(ctx context.Context, UserID int64) ([]models.Password, error) {
+	rows, err := r.db.Conn.QueryContext(ctx, stmt.password.all, UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.Password
+	for rows.Next() {
+		var item models.Password
+		if err := rows.Scan(&item.ID, &item.Title, &item.UserID, &item.Password, &item.Compromised, &item.Stats.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Mark sets the breach flag of a password entry by ID, leaving its update time as it is
+func (r *PasswordsRepository) Mark(ctx context.Context, id int64, compromised bool) error {
+	_, err := r.db.Conn.ExecContext(ctx, stmt.password.mark, id, compromised)
+	return err
+}
+
+// History lists the archived revisions of a password entry title, newest first
+func (r *PasswordsRepository) History(ctx context.Context, title string, UserID int64) ([]models.Revision, error) {
+	return history(ctx, r.db, stmt.password.history, title, UserID)
+}
+
+// GetRevision retrieves an archived revision of a password entry with its custom fields;
+// its ID and those of the fields are the ones of the archived records
+func (r *PasswordsRepository) GetRevision(ctx context.Context, title string, UserID int64, revision int) (*models.Password, error) {
+	var result models.Password
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.password.history.get, title, UserID, revision).
+		Scan(&result.ID, &result.Title, &result.UserID, &result.Login, &result.Password)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrRevisionNotFound
+		}
+		return nil, err
+	}
+
+	result.Fields, err = r.scanFields(ctx, stmt.password.fields.revision, title, UserID, revision)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// scanFields runs a custom field query and collects the fields it returns in order
+func (r *PasswordsRepository) scanFields(ctx context.Context, query string, args ...any) ([]models.PasswordField, error) {
+	rows, err := r.db.Conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.PasswordField
+	for rows.Next() {
+		var field models.PasswordField
+		if err := rows.Scan(&field.ID, &field.Name, &field.Type, &field.Value); err != nil {
+			return nil, err
+		}
+		result = append(result, field)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// addFields stores the custom fields of a password entry within tx, numbering their positions in order
+func addFields(ctx context.Context, tx *sql.Tx, cond models.Password) error {
+	for i, field := range cond.Fields {
+		_, err := tx.ExecContext(ctx, stmt.password.fields.add, field.ID, cond.ID, cond.UserID, i, field.Name, field.Type, field.Value)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/models"
+	"main/internal/server/services"
+	"time"
+)
+
+// RecordsRepository implements the record ID data access layer for PostgreSQL.
+// Reserved IDs are drawn from the sequence of the table of their kind, so they never clash with other records.
+type RecordsRepository struct {
+	db *psql.DB // Database connection
+}
+
+// NewRecordsRepository creates a new RecordsRepository instance
+func NewRecordsRepository(db *psql.DB) *RecordsRepository {
+	return &RecordsRepository{
+		db: db,
+	}
+}
+
+// ID resolves the ID of a live record by kind, title and user ID
+func (r *RecordsRepository) ID(ctx context.Context, kind, title string, userID int64) (int64, error) {
+	q, err := recordTable(kind)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err = r.db.Conn.QueryRowContext(ctx, q.id, title, userID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, services.ErrRecordNotFound
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+// Reserve reserves the next ID of the kind for the user, first dropping the user's reservations made before expired
+func (r *RecordsRepository) Reserve(ctx context.Context, kind string, userID int64, expired time.Time) (int64, error) {
+	q, err := recordTable(kind)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := r.db.Conn.ExecContext(ctx, q.prune, userID, expired); err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err = r.db.Conn.QueryRowContext(ctx, q.reserve, userID).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// Claim consumes a reservation of the user made since the given time; ErrInvalidReservation is returned if there is none
+func (r *RecordsRepository) Claim(ctx context.Context, kind string, id, userID int64, since time.Time) error {
+	q, err := recordTable(kind)
+	if err != nil {
+		return err
+	}
+
+	err = r.db.Conn.QueryRowContext(ctx, q.claim, id, userID, since).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return services.ErrInvalidReservation
+		}
+		return err
+	}
+	return nil
+}
+
+// recordTable returns the record ID queries of the table holding records of the given kind.
+func recordTable(kind string) (recordQueries, error) {
+	switch kind {
+	case models.TablePasswords:
+		return stmt.password.record, nil
+	case models.TableCards:
+		return stmt.card.record, nil
+	case models.TableBinaries:
+		return stmt.binary.record, nil
+	case models.TableNotes:
+		return stmt.note.record, nil
+	case models.TableOTP:
+		return stmt.otp.record, nil
+	case models.TableSSHKeys:
+		return stmt.sshKey.record, nil
+	default:
+		return recordQueries{}, services.ErrUnknownKind
+	}
+}
@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"context"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/models"
+)
+
+// rename gives the live record found by ID or, if the ID is zero, by title a new title within a single transaction.
+// The archived revisions of the record move to the new title after the revisions already kept under it,
+// so the history follows the record, and the revisions beyond the owner's retention are dropped.
+// It returns sql.ErrNoRows if the user has no such record, or the unique violation if the title is taken.
+func rename(ctx context.Context, db *psql.DB, h historyQueries, q renameQueries, cond models.Rename) (*models.ListItem, error) {
+	var (
+		id     int64
+		title  string
+		result models.ListItem
+	)
+
+	tx, err := db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, q.lock, cond.UserID, cond.ID, cond.Title).Scan(&id, &title)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRowContext(ctx, q.rename, id, cond.NewTitle).Scan(listFields(&result)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if title != cond.NewTitle {
+		if _, err := tx.ExecContext(ctx, q.history, id, cond.UserID, cond.NewTitle, title); err != nil {
+			return nil, err
+		}
+		if _, err := tx.ExecContext(ctx, h.prune, cond.NewTitle, cond.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
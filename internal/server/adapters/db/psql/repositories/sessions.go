@@ -0,0 +1,112 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/models"
+	"main/internal/server/services"
+)
+
+// SessionsRepository implements the login sessions data access layer for PostgreSQL
+type SessionsRepository struct {
+	db *psql.DB // Database connection
+}
+
+// NewSessionsRepository creates a new SessionsRepository instance
+func NewSessionsRepository(db *psql.DB) *SessionsRepository {
+	return &SessionsRepository{
+		db: db,
+	}
+}
+
+// Add stores a new session with the hash of its refresh token, dropping expired sessions of the user
+func (r *SessionsRepository) Add(ctx context.Context, session models.Session, refreshHash []byte) (*models.Session, error) {
+	if _, err := r.db.Conn.ExecContext(ctx, stmt.session.prune, session.UserID); err != nil {
+		return nil, err
+	}
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.session.add, session.UserID, refreshHash, session.UserAgent, session.Address, session.ExpiresAt).
+		Scan(&session.ID, &session.CreatedAt, &session.LastUsedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// Rotate replaces the refresh token hash of an active session, returning the session
+func (r *SessionsRepository) Rotate(ctx context.Context, oldHash, newHash []byte) (*models.Session, error) {
+	var result models.Session
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.session.rotate, oldHash, newHash).Scan(&result.ID, &result.UserID,
+		&result.UserAgent, &result.Address, &result.CreatedAt, &result.LastUsedAt, &result.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrSessionNotFound
+		}
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RevokeReused revokes the session a superseded refresh token belonged to and reports whether there was one
+func (r *SessionsRepository) RevokeReused(ctx context.Context, previousHash []byte) (bool, error) {
+	res, err := r.db.Conn.ExecContext(ctx, stmt.session.reused, previousHash)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// IsActive reports whether the session of the user is neither revoked nor expired
+func (r *SessionsRepository) IsActive(ctx context.Context, sessionID, userID int64) (bool, error) {
+	var active bool
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.session.active, sessionID, userID).Scan(&active)
+	if err != nil {
+		return false, err
+	}
+	return active, nil
+}
+
+// List returns the active sessions of the user, newest first
+func (r *SessionsRepository) List(ctx context.Context, userID int64) ([]models.Session, error) {
+	rows, err := r.db.Conn.QueryContext(ctx, stmt.session.list, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.Session
+	for rows.Next() {
+		var s models.Session
+		err := rows.Scan(&s.ID, &s.UserID, &s.UserAgent, &s.Address, &s.CreatedAt, &s.LastUsedAt, &s.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, s)
+	}
+	return result, rows.Err()
+}
+
+// Revoke revokes an active session of the user
+func (r *SessionsRepository) Revoke(ctx context.Context, sessionID, userID int64) error {
+	res, err := r.db.Conn.ExecContext(ctx, stmt.session.revoke, sessionID, userID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return services.ErrSessionNotFound
+	}
+	return nil
+}
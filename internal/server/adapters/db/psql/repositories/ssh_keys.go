@@ -0,0 +1,215 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/models"
+	"main/internal/server/services"
+)
+
+// SSHKeysRepository implements the SSH keys data access layer for PostgreSQL
+type SSHKeysRepository struct {
+	db *psql.DB
+}
+
+// NewSSHKeysRepository creates a new SSHKeysRepository instance
+func NewSSHKeysRepository(db *psql.DB) *SSHKeysRepository {
+	return &SSHKeysRepository{
+		db: db,
+	}
+}
+
+// Get retrieves an SSH key by title and user ID from the database
+func (r *SSHKeysRepository) Get(ctx context.Context, title string, UserID int64) (*models.SSHKey, error) {
+	var result models.SSHKey
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.sshKey.get, title, UserID).
+		Scan(&result.ID, &result.Title, &result.UserID, &result.PublicKey, &result.Fingerprint, &result.PrivateKey,
+			&result.Stats.CreatedAt, &result.Stats.UpdatedAt, &result.Stats.AccessedAt, &result.Stats.AccessCount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrSSHKeyNotFound
+		}
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Touch counts a read of an SSH key by ID and stamps its time
+func (r *SSHKeysRepository) Touch(ctx context.Context, id int64) error {
+	_, err := r.db.Conn.ExecContext(ctx, stmt.sshKey.touch, id)
+	return err
+}
+
+// NextID reserves an ID for a new SSH key from the table sequence
+func (r *SSHKeysRepository) NextID(ctx context.Context) (int64, error) {
+	var id int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.sshKey.nextID).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// GetID resolves the ID of an SSH key by title and user ID
+func (r *SSHKeysRepository) GetID(ctx context.Context, title string, UserID int64) (int64, error) {
+	var id int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.sshKey.getID, title, UserID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, services.ErrSSHKeyNotFound
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+// Add stores a new SSH key in the database, placed in its folder with its tags
+func (r *SSHKeysRepository) Add(ctx context.Context, cond models.SSHKey) (string, error) {
+	var title string
+
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, stmt.sshKey.add, cond.ID, cond.Title, cond.UserID,
+		cond.PublicKey, cond.Fingerprint, cond.PrivateKey).Scan(&title)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
+		return "", services.ErrSSHKeyAlreadyExists
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if err := place(ctx, tx, models.TableSSHKeys, cond.ID, cond.UserID, cond.Placement); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+	return title, nil
+}
+
+// Update replaces the key pair and the placement of an existing SSH key in the database, archiving the previous version first
+func (r *SSHKeysRepository) Update(ctx context.Context, cond models.SSHKey) (string, error) {
+	var title string
+
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	id, err := archive(ctx, tx, stmt.sshKey.history, cond.Title, cond.UserID, false)
+	if errors.Is(err, sql.ErrNoRows) || err == nil && id != cond.ID {
+		return "", services.ErrSSHKeyNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.QueryRowContext(ctx, stmt.sshKey.update, cond.PublicKey, cond.Fingerprint, cond.PrivateKey,
+		cond.ID, cond.UserID).Scan(&title)
+	if err != nil {
+		return "", err
+	}
+
+	if err := place(ctx, tx, models.TableSSHKeys, cond.ID, cond.UserID, cond.Placement); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+	return title, nil
+}
+
+// Rename gives an SSH key found by ID or title a new title (see rename)
+func (r *SSHKeysRepository) Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error) {
+	result, err := rename(ctx, r.db, stmt.sshKey.history, stmt.sshKey.rename, cond)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
+		return nil, services.ErrSSHKeyAlreadyExists
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrSSHKeyNotFound
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// Delete moves an SSH key to the trash by title and user ID
+func (r *SSHKeysRepository) Delete(ctx context.Context, title string, UserID int64) error {
+	ok, err := moveToTrash(ctx, r.db, stmt.sshKey.history, stmt.sshKey.trash, title, UserID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return services.ErrSSHKeyNotFound
+	}
+	return nil
+}
+
+// List returns a page of SSH keys belonging to the user, ordered and filtered as requested
+func (r *SSHKeysRepository) List(ctx context.Context, filter models.ListFilter) ([]models.ListItem, error) {
+	return list(ctx, r.db, stmt.sshKey.list, filter)
+}
+
+// PublicKeys returns the public keys of all SSH keys belonging to the user, ordered by title
+func (r *SSHKeysRepository) PublicKeys(ctx context.Context, UserID int64) ([]models.SSHPublicKey, error) {
+	rows, err := r.db.Conn.QueryContext(ctx, stmt.sshKey.publicKeys, UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.SSHPublicKey
+	for rows.Next() {
+		var item models.SSHPublicKey
+		if err := rows.Scan(&item.Title, &item.PublicKey, &item.Fingerprint); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// History lists the archived revisions of an SSH key title, newest first
+func (r *SSHKeysRepository) History(ctx context.Context, title string, UserID int64) ([]models.Revision, error) {
+	return history(ctx, r.db, stmt.sshKey.history, title, UserID)
+}
+
+// GetRevision retrieves an archived revision of an SSH key; its ID is the one of the archived record
+func (r *SSHKeysRepository) GetRevision(ctx context.Context, title string, UserID int64, revision int) (*models.SSHKey, error) {
+	var result models.SSHKey
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.sshKey.history.get, title, UserID, revision).
+		Scan(&result.ID, &result.Title, &result.UserID, &result.PublicKey, &result.Fingerprint, &result.PrivateKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrRevisionNotFound
+		}
+		return nil, err
+	}
+	return &result, nil
+}
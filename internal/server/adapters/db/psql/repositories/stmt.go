@@ -1,148 +1,1208 @@
 package repositories
 
+import (
+	"fmt"
+	"main/internal/server/models"
+	"strings"
+)
+
 // Statements is a collection of structured SQL queries for various database operations.
 //
 // Each nested structure represents a group of related SQL queries for specific entity types (e.g., users, passwords).
 // This structure allows convenient organization of queries and easy access by names.
 var stmt = statements{
 	user: user{
-		register: registerUser,
-		login:    loginUser,
+		register:  registerUser,
+		login:     loginUser,
+		vault:     getUserVault,
+		retention: setHistoryRetention,
+	},
+	session: sessions{
+		add:    addSession,
+		prune:  pruneSessions,
+		rotate: rotateSession,
+		reused: revokeReusedSession,
+		active: isSessionActive,
+		list:   listSessions,
+		revoke: revokeSession,
+	},
+	totp: totp{
+		save:           saveTOTP,
+		get:            getTOTP,
+		confirm:        confirmTOTP,
+		clearCodes:     clearRecoveryCodes,
+		addCode:        addRecoveryCode,
+		useStep:        useTOTPStep,
+		useCode:        useRecoveryCode,
+		delete:         deleteTOTP,
+		addChallenge:   addLoginChallenge,
+		pruneChallenge: pruneLoginChallenges,
+		takeChallenge:  takeLoginChallenge,
+		dropChallenge:  deleteLoginChallenge,
+	},
+	certificate: certificates{
+		add:    addCertificate,
+		delete: deleteCertificate,
+		user:   getCertificateUser,
+		list:   listCertificates,
+	},
+	userKey: userKeys{
+		get: getUserKey,
+		add: addUserKey,
+	},
+	rotation: rotations{
+		start: startRotation,
+		list:  listRotations,
+		lock:  lockRotation,
+		save:  saveRotation,
+		phases: []rotationPhase{
+			newRotationPhase(models.RotationPhaseUserKeys, "user_keys", "user_id", "wrapped_key"),
+			newRotationPhase(models.RotationPhasePasswords, models.TablePasswords, "id", "login", "password"),
+			newRotationPhase(models.RotationPhaseCards, models.TableCards, "id", "bank", "number", "data_end", "secret_code"),
+			newRotationPhase(models.RotationPhaseBinaries, models.TableBinaries, "id", "data", "data_key"),
+			newRotationPhase(models.RotationPhaseNotes, models.TableNotes, "id", "body"),
+			newRotationPhase(models.RotationPhaseOTP, models.TableOTP, "id", "issuer", "account", "secret"),
+			newRotationPhase(models.RotationPhaseSSHKeys, models.TableSSHKeys, "id", "private_key"),
+			newRotationPhase(models.RotationPhasePasswordFields, models.TablePasswordFields, "id", "name", "value"),
+			newHistoryRotationPhase(models.RotationPhasePasswordHistory, models.TablePasswordHistory, models.TablePasswords, "login", "password"),
+			newHistoryRotationPhase(models.RotationPhaseCardHistory, models.TableCardHistory, models.TableCards, "bank", "number", "data_end", "secret_code"),
+			newHistoryRotationPhase(models.RotationPhaseBinaryHistory, models.TableBinaryHistory, models.TableBinaries, "data_key"),
+			newHistoryRotationPhase(models.RotationPhaseNoteHistory, models.TableNoteHistory, models.TableNotes, "body"),
+			newHistoryRotationPhase(models.RotationPhaseOTPHistory, models.TableOTPHistory, models.TableOTP, "issuer", "account", "secret"),
+			newHistoryRotationPhase(models.RotationPhaseSSHKeyHistory, models.TableSSHKeyHistory, models.TableSSHKeys, "private_key"),
+			newHistoryRotationPhase(models.RotationPhasePasswordFieldHistory, models.TablePasswordFieldHistory, models.TablePasswordFields, "name", "value"),
+		},
 	},
 	binary: binaries{
-		add:    addBinary,
-		get:    getBinary,
-		delete: deleteBinary,
-		update: updateBinary,
+		nextID:  nextBinaryID,
+		getID:   getBinaryID,
+		add:     addBinary,
+		get:     getBinary,
+		touch:   fmt.Sprintf(touchRecord, models.TableBinaries),
+		update:  updateBinary,
+		list:    newListQueries("binaries"),
+		chunks:  getBinaryChunks,
+		legacy:  getLegacyBinaries,
+		offload: offloadBinary,
+		hasBlob: hasBinaryBlob,
+		history: newHistoryQueries(models.TableBinaries, models.TableBinaryHistory,
+			"data_key", "size", "sha256", "blob_key", "streamed"),
+		trash:     newTrashQueries(models.TableBinaries),
+		placement: newPlacementQueries(models.TableBinaries),
+		record:    newRecordQueries(models.TableBinaries),
+		rename:    newRenameQueries(models.TableBinaries, models.TableBinaryHistory),
 	},
 	card: cards{
-		add:    addCard,
-		get:    getCard,
-		delete: deleteCard,
-		update: updateCard,
+		nextID:    nextCardID,
+		getID:     getCardID,
+		add:       addCard,
+		get:       getCard,
+		touch:     fmt.Sprintf(touchRecord, models.TableCards),
+		update:    updateCard,
+		reveal:    revealCard,
+		list:      newListQueries("cards"),
+		history:   newHistoryQueries(models.TableCards, models.TableCardHistory, "bank", "number", "data_end", "secret_code", "brand"),
+		trash:     newTrashQueries(models.TableCards),
+		placement: newPlacementQueries(models.TableCards),
+		record:    newRecordQueries(models.TableCards),
+		rename:    newRenameQueries(models.TableCards, models.TableCardHistory),
 	},
 	password: passwords{
+		nextID: nextPasswordID,
+		getID:  getPasswordID,
 		add:    addPassword,
 		get:    getPassword,
-		delete: deletePassword,
+		touch:  fmt.Sprintf(touchRecord, models.TablePasswords),
 		update: updatePassword,
+		all:    listAllPasswords,
+		mark:   markPassword,
+		list:   newListQueries("passwords"),
+		history: newHistoryQueries(models.TablePasswords, models.TablePasswordHistory, "login", "password").
+			withRelated(archivePasswordFields),
+		trash:     newTrashQueries(models.TablePasswords),
+		placement: newPlacementQueries(models.TablePasswords),
+		record:    newRecordQueries(models.TablePasswords),
+		rename:    newRenameQueries(models.TablePasswords, models.TablePasswordHistory),
+		fields: passwordFields{
+			nextIDs:  nextPasswordFieldIDs,
+			get:      getPasswordFields,
+			add:      addPasswordField,
+			clear:    clearPasswordFields,
+			revision: getPasswordFieldRevision,
+		},
+	},
+	note: notes{
+		kindQueries: newKindQueries(models.TableNotes, models.TableNoteHistory, "body"),
+		add:         addNote,
+		get:         getNote,
+		update:      updateNote,
+	},
+	otp: otps{
+		kindQueries: newKindQueries(models.TableOTP, models.TableOTPHistory,
+			"password_id", "issuer", "account", "secret", "algorithm", "digits", "period"),
+		add:    addOTP,
+		get:    getOTP,
+		update: updateOTP,
+	},
+	folder: folders{
+		list:      listFolders,
+		add:       addFolder,
+		lock:      lockFolder,
+		move:      moveFolder,
+		remove:    removeFolder,
+		children:  hasSubfolders,
+		tags:      getItemTags,
+		clearTags: clearItemTags,
+		addTags:   addItemTags,
+	},
+	sshKey: sshKeys{
+		kindQueries: newKindQueries(models.TableSSHKeys, models.TableSSHKeyHistory, "public_key", "fingerprint", "private_key"),
+		add:         addSSHKey,
+		get:         getSSHKey,
+		update:      updateSSHKey,
+		publicKeys:  listSSHPublicKeys,
 	},
 }
 
 // statements describes the storage structure of SQL queries.
 type statements struct {
-	user     user      // Queries for managing users
-	binary   binaries  // Queries for working with binary files
-	card     cards     // Queries for working with credit cards
-	password passwords // Queries for working with stored passwords
+	user        user         // Queries for managing users
+	session     sessions     // Queries for managing login sessions
+	totp        totp         // Queries for the TOTP second factor
+	certificate certificates // Queries for client certificates mapped to users
+	userKey     userKeys     // Queries for managing per-user encryption keys
+	rotation    rotations    // Queries for master key rotation jobs
+	binary      binaries     // Queries for working with binary files
+	card        cards        // Queries for working with credit cards
+	password    passwords    // Queries for working with stored passwords
+	note        notes        // Queries for working with secure notes
+	otp         otps         // Queries for working with authenticator secrets
+	sshKey      sshKeys      // Queries for working with SSH keys
+	folder      folders      // Queries for the folder tree and item tags
 }
 
 // user holds SQL queries for CRUD operations on users.
 type user struct {
-	register string // Register new user
-	login    string // Authenticate user
+	register  string // Register new user
+	login     string // Authenticate user
+	vault     string // Fetch vault parameters of a user
+	retention string // Set the number of revisions kept per record
+}
+
+// sessions holds SQL queries for login sessions and their refresh tokens.
+type sessions struct {
+	add    string // Create a new session
+	prune  string // Delete expired sessions of a user
+	rotate string // Replace the refresh token of an active session
+	reused string // Revoke the session a superseded refresh token belonged to
+	active string // Check that a session is neither revoked nor expired
+	list   string // List active sessions of a user
+	revoke string // Revoke an active session of a user
+}
+
+// totp holds SQL queries for TOTP secrets, recovery codes and pending login challenges.
+type totp struct {
+	save           string // Store a new unconfirmed secret
+	get            string // Fetch the secret of a user
+	confirm        string // Mark a secret as confirmed
+	clearCodes     string // Delete the recovery codes of a user
+	addCode        string // Store a recovery code hash
+	useStep        string // Record the time step of an accepted code
+	useCode        string // Spend a recovery code
+	delete         string // Delete the secret and recovery codes of a user
+	addChallenge   string // Create a login challenge
+	pruneChallenge string // Delete expired login challenges of a user
+	takeChallenge  string // Count an attempt against a pending login challenge
+	dropChallenge  string // Delete a completed login challenge
+}
+
+// certificates holds SQL queries for client certificate subjects mapped to users.
+type certificates struct {
+	add    string // Map a subject to a user and the session backing the mapping
+	delete string // Remove the mapping of a subject
+	user   string // Resolve the user and session of a subject
+	list   string // List the subjects mapped to a user
+}
+
+// userKeys holds SQL queries for per-user wrapped data-encryption keys.
+type userKeys struct {
+	get string // Fetch the wrapped key of a user
+	add string // Store a wrapped key unless the user already has one
+}
+
+// rotations holds SQL queries for master key rotation jobs and the tables they walk.
+type rotations struct {
+	start  string          // Create a new rotation job
+	list   string          // List rotation jobs
+	lock   string          // Lock the unfinished job for the next batch
+	save   string          // Persist job progress
+	phases []rotationPhase // Tables processed by a job, in order
+}
+
+// rotationPhase describes a table walked by a rotation job, with its batch queries.
+type rotationPhase struct {
+	name    string   // Phase name stored in the job
+	table   string   // Table the ciphertexts of the rows are bound to
+	columns []string // Encrypted columns of the table
+	batch   string   // Lock and fetch the next batch of rows
+	update  string   // Store the rewritten encrypted columns of a row
+}
+
+// newRotationPhase builds the batch queries for walking the given table in key order.
+func newRotationPhase(name, table, key string, columns ...string) rotationPhase {
+	return buildRotationPhase(name, table, table, key, key, columns)
+}
+
+// newHistoryRotationPhase builds the batch queries for walking the history table of the given table.
+// Archived ciphertexts stay bound to the table and ID of the record they were taken from.
+func newHistoryRotationPhase(name, history, table string, columns ...string) rotationPhase {
+	return buildRotationPhase(name, history, table, "id", "record_id", columns)
+}
+
+// buildRotationPhase builds the batch queries for walking source in key order,
+// reading the ID the ciphertexts are bound to from the record column.
+func buildRotationPhase(name, source, table, key, record string, columns []string) rotationPhase {
+	set := make([]string, len(columns))
+	for i, c := range columns {
+		set[i] = fmt.Sprintf("%s = $%d", c, i+2)
+	}
+
+	return rotationPhase{
+		name:    name,
+		table:   table,
+		columns: columns,
+		batch:   fmt.Sprintf(rotationBatch, source, key, strings.Join(columns, ", "), record),
+		update:  fmt.Sprintf(rotationUpdate, source, key, strings.Join(set, ", ")),
+	}
 }
 
 // binaries stores SQL queries for working with binary objects.
 type binaries struct {
-	add    string // Add new binary file
-	get    string // Retrieve binary file
-	delete string // Delete binary file
-	update string // Update binary file content
+	nextID    string           // Reserve the ID of a new binary file
+	getID     string           // Resolve binary file ID by title
+	add       string           // Add new binary file
+	get       string           // Retrieve binary file
+	touch     string           // Count a read of a binary file
+	update    string           // Update binary file content
+	list      listQueries      // Page through binary files
+	chunks    string           // Read the chunks of a binary file streamed before the blob store
+	legacy    string           // Find binary files stored in the database
+	offload   string           // Move binary file content to a blob
+	hasBlob   string           // Check whether a blob is referenced
+	history   historyQueries   // Archive and read back binary file revisions
+	trash     trashQueries     // Move binary files to the trash and out of it
+	placement placementQueries // Place binary files in folders
+	record    recordQueries    // Reserve IDs for new binary files
+	rename    renameQueries    // Rename binary files along with their history
 }
 
 // cards contains SQL queries for working with user's credit cards.
 type cards struct {
-	add    string // Add new credit card
-	get    string // Get credit card details
-	delete string // Remove credit card record
-	update string // Update credit card information
+	nextID    string           // Reserve the ID of a new credit card
+	getID     string           // Resolve credit card ID by title
+	add       string           // Add new credit card
+	get       string           // Get credit card details
+	touch     string           // Count a read of a credit card
+	update    string           // Update credit card information
+	reveal    string           // Record a reveal of a credit card
+	list      listQueries      // Page through credit cards
+	history   historyQueries   // Archive and read back credit card revisions
+	trash     trashQueries     // Move credit cards to the trash and out of it
+	placement placementQueries // Place credit cards in folders
+	record    recordQueries    // Reserve IDs for new cards
+	rename    renameQueries    // Rename cards along with their history
 }
 
 // passwords stores SQL queries for working with saved passwords.
 type passwords struct {
-	add    string // Save new password entry
-	get    string // Fetch existing password entry
-	delete string // Delete password entry
-	update string // Modify password entry
+	nextID    string           // Reserve the ID of a new password entry
+	getID     string           // Resolve password entry ID by title
+	add       string           // Save new password entry
+	get       string           // Fetch existing password entry
+	touch     string           // Count a read of a password entry
+	update    string           // Modify password entry
+	all       string           // Fetch the passwords of all live entries of a user for an audit
+	mark      string           // Flag a password entry found in a breach corpus or clear the flag
+	list      listQueries      // Page through password entries
+	history   historyQueries   // Archive and read back password entry revisions
+	trash     trashQueries     // Move password entries to the trash and out of it
+	placement placementQueries // Place password entries in folders
+	record    recordQueries    // Reserve IDs for new password entries
+	rename    renameQueries    // Rename password entries along with their history
+	fields    passwordFields   // Custom fields of password entries
+}
+
+// passwordFields stores SQL queries for working with the custom fields of password entries.
+type passwordFields struct {
+	nextIDs  string // Reserve the IDs of new custom fields
+	get      string // Fetch the custom fields of a password entry in order
+	add      string // Save a custom field
+	clear    string // Remove the custom fields of a password entry
+	revision string // Fetch the custom fields archived with a revision in order
+}
+
+// notes stores SQL queries for working with secure notes.
+type notes struct {
+	kindQueries        // Queries shared with the other kinds of records
+	add         string // Save new note
+	get         string // Fetch existing note
+	update      string // Replace note body
+}
+
+// otps stores SQL queries for working with authenticator secrets.
+type otps struct {
+	kindQueries        // Queries shared with the other kinds of records
+	add         string // Save new authenticator secret
+	get         string // Fetch existing authenticator secret with the title of its linked password
+	update      string // Replace authenticator secret
+}
+
+// sshKeys stores SQL queries for working with SSH keys.
+type sshKeys struct {
+	kindQueries        // Queries shared with the other kinds of records
+	add         string // Save new SSH key
+	get         string // Fetch existing SSH key
+	update      string // Replace SSH key pair
+	publicKeys  string // List the public keys of a user
+}
+
+// kindQueries holds the queries every kind of record runs the same way against its own table:
+// resolving IDs, counting reads, listing, archiving, trashing, placing and renaming its records.
+// Only the queries reading and writing the fields of a kind are written out for it.
+type kindQueries struct {
+	nextID    string           // Reserve the ID of a new record
+	getID     string           // Resolve the ID of a live record by title
+	touch     string           // Count a read of a record
+	list      listQueries      // Page through records
+	history   historyQueries   // Archive and read back record revisions
+	trash     trashQueries     // Move records to the trash and out of it
+	placement placementQueries // Place records in folders
+	record    recordQueries    // Reserve IDs for new records
+	rename    renameQueries    // Rename records along with their history
+}
+
+// newKindQueries builds the shared queries of the given table and its history table, which archives the given
+// columns of the table, from the templates.
+func newKindQueries(table, history string, columns ...string) kindQueries {
+	return kindQueries{
+		nextID:    fmt.Sprintf(nextRecordID, table),
+		getID:     fmt.Sprintf(getRecordID, table),
+		touch:     fmt.Sprintf(touchRecord, table),
+		list:      newListQueries(table),
+		history:   newHistoryQueries(table, history, columns...),
+		trash:     newTrashQueries(table),
+		placement: newPlacementQueries(table),
+		record:    newRecordQueries(table),
+		rename:    newRenameQueries(table, history),
+	}
+}
+
+// folders stores SQL queries for working with the folder tree and the tags of items.
+type folders struct {
+	list      string // List the folders of a user
+	add       string // Create a folder
+	lock      string // Lock a folder of a user before removing it
+	move      string // Rename a folder and change its parent
+	remove    string // Remove a folder
+	children  string // Check whether a folder has subfolders
+	tags      string // List the tags of an item
+	clearTags string // Remove the tags of an item
+	addTags   string // Tag an item
+}
+
+// placementQueries holds the queries placing the items of a table in folders.
+type placementQueries struct {
+	lock   string // Lock a live item of a user by title before placing it
+	folder string // Fetch the folder of an item
+	move   string // Move an item to a folder
+	used   string // Check whether live items are placed in a folder
+}
+
+// newPlacementQueries builds the placement queries of the given table from the placement templates.
+func newPlacementQueries(table string) placementQueries {
+	return placementQueries{
+		lock:   fmt.Sprintf(lockItem, table),
+		folder: fmt.Sprintf(getItemFolder, table),
+		move:   fmt.Sprintf(moveItem, table),
+		used:   fmt.Sprintf(isFolderUsed, table),
+	}
+}
+
+// renameQueries holds the queries giving the live records of a table a new title.
+type renameQueries struct {
+	lock    string // Lock a live record of a user by ID or title
+	rename  string // Change the title of a locked record
+	history string // Move the archived revisions of a record to its new title
+}
+
+// newRenameQueries builds the rename queries of the given table and its history table from the rename templates.
+func newRenameQueries(table, history string) renameQueries {
+	return renameQueries{
+		lock:    fmt.Sprintf(lockRenamedRecord, table),
+		rename:  fmt.Sprintf(renameRecord, table, listLabel(table)),
+		history: fmt.Sprintf(renameHistory, history),
+	}
+}
+
+// recordQueries holds the queries resolving and reserving the IDs of the records of a table.
+type recordQueries struct {
+	id      string // Find the ID of a live record of a user by title
+	prune   string // Drop the stale reservations of a user
+	reserve string // Reserve the next ID of the table for a user
+	claim   string // Consume a reservation of a user
+}
+
+// newRecordQueries builds the record ID queries of the given table from the record templates.
+func newRecordQueries(table string) recordQueries {
+	return recordQueries{
+		id:      fmt.Sprintf(getRecordID, table),
+		prune:   pruneRecordReservations,
+		reserve: fmt.Sprintf(reserveRecordID, table),
+		claim:   fmt.Sprintf(claimRecordID, table),
+	}
+}
+
+// listQueries holds keyset pagination queries for every supported ordering of a table.
+type listQueries struct {
+	titleAsc     string // Ordered by title, ascending
+	titleDesc    string // Ordered by title, descending
+	dateAsc      string // Ordered by creation time, ascending
+	dateDesc     string // Ordered by creation time, descending
+	updatedAsc   string // Ordered by update time, ascending
+	updatedDesc  string // Ordered by update time, descending
+	accessedAsc  string // Ordered by access time, never read records first, ascending
+	accessedDesc string // Ordered by access time, never read records last, descending
+}
+
+// neverAccessed is the access time key of a listing; records never read sort as read at the Unix epoch.
+const neverAccessed = "COALESCE(last_accessed_at, 'epoch')"
+
+// newListQueries builds the pagination queries for the given table from the listing templates.
+func newListQueries(table string) listQueries {
+	label := listLabel(table)
+
+	return listQueries{
+		titleAsc:     fmt.Sprintf(listByTitle, table, ">", "ASC", label),
+		titleDesc:    fmt.Sprintf(listByTitle, table, "<", "DESC", label),
+		dateAsc:      fmt.Sprintf(listByTime, table, ">", "ASC", "created_at", label),
+		dateDesc:     fmt.Sprintf(listByTime, table, "<", "DESC", "created_at", label),
+		updatedAsc:   fmt.Sprintf(listByTime, table, ">", "ASC", "updated_at", label),
+		updatedDesc:  fmt.Sprintf(listByTime, table, "<", "DESC", "updated_at", label),
+		accessedAsc:  fmt.Sprintf(listByTime, table, ">", "ASC", neverAccessed, label),
+		accessedDesc: fmt.Sprintf(listByTime, table, "<", "DESC", neverAccessed, label),
+	}
+}
+
+// listLabels are the cleartext columns listed along with the records of a table, such as the brand of a card.
+var listLabels = map[string]string{
+	models.TableCards: "brand",
+}
+
+// listLabel returns the label column listed with the records of a table, or an empty string for tables without one.
+func listLabel(table string) string {
+	if column, ok := listLabels[table]; ok {
+		return column
+	}
+	return "''"
+}
+
+// historyQueries holds the queries archiving the records of a table and reading their revisions back.
+type historyQueries struct {
+	lock    string // Lock a live or trashed record by title before archiving it
+	archive string // Copy the current version of a record into the history
+	prune   string // Drop revisions beyond the owner's retention
+	list    string // List the revisions of a title, newest first
+	get     string // Fetch a single revision of a title
+	related string // Archive the rows belonging to a record along with it; empty if there are none
+}
+
+// newHistoryQueries builds the history queries of the given table from the history templates.
+func newHistoryQueries(table, history string, columns ...string) historyQueries {
+	cols := strings.Join(columns, ", ")
+
+	return historyQueries{
+		lock:    fmt.Sprintf(lockRecord, table),
+		archive: fmt.Sprintf(archiveRecord, table, history, cols),
+		prune:   fmt.Sprintf(pruneHistory, history),
+		list:    fmt.Sprintf(listHistory, history),
+		get:     fmt.Sprintf(getRevision, history, cols),
+	}
+}
+
+// withRelated returns the history queries archiving the rows of another table that belong to a record
+// along with the record. The query receives the record and user IDs once the record is archived.
+func (q historyQueries) withRelated(query string) historyQueries {
+	q.related = query
+	return q
+}
+
+// trashQueries holds the queries moving the records of a table to the trash, back out of it, and purging them.
+type trashQueries struct {
+	trash   string // Move a locked live record to the trash
+	restore string // Move a record out of the trash
+	purge   string // Permanently remove a locked trashed record
+	untag   string // Remove the tags of a purged record
+	list    string // List the trashed records of a user
+	expired string // Find records trashed before a point in time
+}
+
+// newTrashQueries builds the trash queries of the given table from the trash templates.
+func newTrashQueries(table string) trashQueries {
+	return trashQueries{
+		trash:   fmt.Sprintf(trashRecord, table),
+		restore: fmt.Sprintf(restoreRecord, table),
+		purge:   fmt.Sprintf(purgeRecord, table),
+		untag:   fmt.Sprintf(untagRecord, table),
+		list:    fmt.Sprintf(listTrash, table),
+		expired: fmt.Sprintf(listExpiredTrash, table),
+	}
 }
 
 // Constants containing predefined SQL queries.
 const (
 	// Users
 	registerUser = `
-        INSERT INTO users (login, password)
-        VALUES ($1, $2) 
-        RETURNING id;` // Insert new user and return its ID
+        INSERT INTO users (login, password, vault, vault_salt, vault_time, vault_memory, vault_threads, vault_check)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8) 
+        RETURNING id;` // Insert new user, optionally with vault parameters, and return its ID
 
 	loginUser = `
         SELECT id, login, password
         FROM users 
         WHERE login = $1;` // Verify user credentials by username
 
+	getUserVault = `
+        SELECT vault, vault_salt, vault_time, vault_memory, vault_threads, vault_check
+        FROM users
+        WHERE id = $1;` // Fetch the vault flag and key derivation parameters of a user
+
+	setHistoryRetention = `
+        UPDATE users
+        SET history_retention = $2
+        WHERE id = $1;` // Change the number of revisions kept per record of a user
+
+	// Sessions
+	addSession = `
+        INSERT INTO sessions (user_id, refresh_hash, user_agent, address, expires_at)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING id, created_at, last_used_at;` // Create a session holding the hash of its first refresh token
+
+	pruneSessions = `
+        DELETE
+        FROM sessions
+        WHERE user_id = $1 AND expires_at < now();` // Forget expired sessions of a user
+
+	rotateSession = `
+        UPDATE sessions
+        SET refresh_hash = $2, previous_hash = refresh_hash, last_used_at = now()
+        WHERE refresh_hash = $1 AND revoked_at IS NULL AND expires_at > now()
+        RETURNING id, user_id, user_agent, address, created_at, last_used_at, expires_at;` // Exchange the current refresh token for a new one
+
+	revokeReusedSession = `
+        UPDATE sessions
+        SET revoked_at = now()
+        WHERE previous_hash = $1 AND revoked_at IS NULL;` // Revoke the session whose superseded refresh token was presented again
+
+	isSessionActive = `
+        SELECT EXISTS (
+            SELECT 1
+            FROM sessions
+            WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL AND expires_at > now()
+        );` // Check that the session of an access token is still active
+
+	listSessions = `
+        SELECT id, user_id, user_agent, address, created_at, last_used_at, expires_at
+        FROM sessions
+        WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > now()
+        ORDER BY created_at DESC, id DESC;` // List active sessions of a user, newest first
+
+	revokeSession = `
+        UPDATE sessions
+        SET revoked_at = now()
+        WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL AND expires_at > now();` // Revoke an active session of a user
+
+	// TOTP
+	saveTOTP = `
+        INSERT INTO user_totp (user_id, secret)
+        VALUES ($1, $2)
+        ON CONFLICT (user_id) DO UPDATE
+        SET secret = EXCLUDED.secret, last_step = 0, created_at = now()
+        WHERE user_totp.confirmed_at IS NULL
+        RETURNING (SELECT login FROM users WHERE id = $1);` // Store a secret unless a confirmed one exists, returning the login of the user
+
+	getTOTP = `
+        SELECT user_id, secret, confirmed_at IS NOT NULL, last_step
+        FROM user_totp
+        WHERE user_id = $1;` // Fetch the TOTP secret of a user
+
+	confirmTOTP = `
+        UPDATE user_totp
+        SET confirmed_at = now(), last_step = $2
+        WHERE user_id = $1 AND confirmed_at IS NULL AND last_step < $2;` // Enable the second factor with its first accepted code
+
+	clearRecoveryCodes = `
+        DELETE
+        FROM recovery_codes
+        WHERE user_id = $1;` // Drop the recovery codes of a user
+
+	addRecoveryCode = `
+        INSERT INTO recovery_codes (user_id, code_hash)
+        VALUES ($1, $2);` // Store the hash of a recovery code
+
+	useTOTPStep = `
+        UPDATE user_totp
+        SET last_step = $2
+        WHERE user_id = $1 AND confirmed_at IS NOT NULL AND last_step < $2;` // Accept a code once, rejecting replays of its step
+
+	useRecoveryCode = `
+        UPDATE recovery_codes
+        SET used_at = now()
+        WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL;` // Spend an unused recovery code
+
+	deleteTOTP = `
+        WITH codes AS (
+            DELETE FROM recovery_codes WHERE user_id = $1
+        )
+        DELETE
+        FROM user_totp
+        WHERE user_id = $1;` // Disable the second factor together with its recovery codes
+
+	addLoginChallenge = `
+        INSERT INTO login_challenges (user_id, token_hash, expires_at)
+        VALUES ($1, $2, $3);` // Create a challenge for a login awaiting its second factor
+
+	pruneLoginChallenges = `
+        DELETE
+        FROM login_challenges
+        WHERE user_id = $1 AND expires_at < now();` // Forget expired challenges of a user
+
+	takeLoginChallenge = `
+        UPDATE login_challenges
+        SET attempts = attempts + 1
+        WHERE token_hash = $1 AND expires_at > now() AND attempts < $2
+        RETURNING user_id;` // Count an attempt against a pending challenge, returning its user
+
+	deleteLoginChallenge = `
+        DELETE
+        FROM login_challenges
+        WHERE token_hash = $1;` // Drop a completed challenge
+
+	// Client certificates
+	addCertificate = `
+        INSERT INTO client_certificates (user_id, subject, session_id)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (subject) DO UPDATE
+        SET user_id = EXCLUDED.user_id, session_id = EXCLUDED.session_id, created_at = now()
+        WHERE NOT EXISTS (
+            SELECT 1
+            FROM sessions
+            WHERE id = client_certificates.session_id AND revoked_at IS NULL AND expires_at > now()
+        );` // Map a client certificate subject to a user and session, taking over a mapping whose session ended
+
+	deleteCertificate = `
+        DELETE
+        FROM client_certificates
+        WHERE user_id = $1 AND subject = $2
+        RETURNING session_id;` // Remove a subject mapped to the user, returning the session backing it
+
+	getCertificateUser = `
+        SELECT c.user_id, c.session_id
+        FROM client_certificates c
+        JOIN sessions s ON s.id = c.session_id
+        WHERE c.subject = $1 AND s.revoked_at IS NULL AND s.expires_at > now();` // Resolve the user and active session a client certificate subject is mapped to
+
+	listCertificates = `
+        SELECT c.subject, c.session_id, c.created_at, s.expires_at
+        FROM client_certificates c
+        JOIN sessions s ON s.id = c.session_id
+        WHERE c.user_id = $1 AND s.revoked_at IS NULL AND s.expires_at > now()
+        ORDER BY c.created_at DESC, c.id DESC;` // List the subjects mapped to a user whose sessions are active, newest first
+
+	// User keys
+	getUserKey = `
+        SELECT wrapped_key
+        FROM user_keys
+        WHERE user_id = $1;` // Fetch the wrapped data-encryption key of a user
+
+	addUserKey = `
+        INSERT INTO user_keys (user_id, wrapped_key)
+        VALUES ($1, $2)
+        ON CONFLICT (user_id) DO NOTHING;` // Store a wrapped key, keeping the existing one on a concurrent insert
+
+	// Key rotations
+	startRotation = `
+        INSERT INTO key_rotations (master_key_id, phase)
+        VALUES ($1, $2)
+        RETURNING id, master_key_id, phase, last_id, processed, skipped, created_at, updated_at, finished_at;` // Create a rotation job
+
+	listRotations = `
+        SELECT id, master_key_id, phase, last_id, processed, skipped, created_at, updated_at, finished_at
+        FROM key_rotations
+        ORDER BY id DESC
+        LIMIT $1;` // List the most recent rotation jobs
+
+	lockRotation = `
+        SELECT id, master_key_id, phase, last_id, processed, skipped, created_at, updated_at, finished_at
+        FROM key_rotations
+        WHERE finished_at IS NULL AND master_key_id = $1
+        FOR UPDATE SKIP LOCKED;` // Claim the unfinished job targeting the given key, unless another replica holds it
+
+	saveRotation = `
+        UPDATE key_rotations
+        SET phase = $2, last_id = $3, processed = $4, skipped = $5, updated_at = now(),
+            finished_at = CASE WHEN $6::boolean THEN now() END
+        WHERE id = $1
+        RETURNING updated_at, finished_at;` // Persist job progress, finishing it when requested
+
+	rotationBatch = `
+        SELECT %[2]s, user_id, %[4]s, %[3]s
+        FROM %[1]s
+        WHERE %[2]s > $1
+          AND user_id NOT IN (SELECT id FROM users WHERE vault)
+        ORDER BY %[2]s
+        LIMIT $2
+        FOR UPDATE OF %[1]s` // Lock the next batch of rows after the cursor, leaving out client-encrypted vault accounts
+
+	rotationUpdate = `
+        UPDATE %[1]s
+        SET %[3]s
+        WHERE %[2]s = $1` // Store rewritten encrypted columns of a row
+
+	// Listings
+	listColumns = `id, title, created_at, updated_at, last_accessed_at, access_count` // Columns of a listed record, followed by its label
+
+	listPlacementFilter = `
+              AND ($6::integer = 0 OR folder_id IN (
+                  WITH RECURSIVE tree AS (
+                      SELECT id FROM folders WHERE id = $6 AND user_id = $1
+                      UNION ALL
+                      SELECT f.id FROM folders f JOIN tree ON f.parent_id = tree.id
+                  )
+                  SELECT id FROM tree))
+              AND (COALESCE(cardinality($7::text[]), 0) = 0 OR id IN (
+                  SELECT item_id
+                  FROM item_tags
+                  WHERE kind = '%[1]s' AND user_id = $1 AND tag = ANY($7::text[])
+                  GROUP BY item_id
+                  HAVING count(*) = cardinality($7::text[])))` // Restrict a listing to a folder subtree and to items carrying all given tags
+
+	listStatsFilter = `
+              AND ($8::timestamptz IS NULL OR updated_at < $8)
+              AND ($9::timestamptz IS NULL OR last_accessed_at IS NULL OR last_accessed_at < $9)` // Restrict a listing to records not changed, or not read, since the given times
+
+	listByTitle = `
+            SELECT ` + listColumns + `, %[4]s
+            FROM %[1]s
+            WHERE user_id = $1 AND deleted_at IS NULL AND starts_with(title, $2)
+              AND ($3::integer = 0 OR (title, id) %[2]s ($4::text, $3::integer))` + listPlacementFilter + listStatsFilter + `
+            ORDER BY title %[3]s, id %[3]s
+            LIMIT $5` // Page through user records by title, continuing after the cursor
+
+	listByTime = `
+            SELECT ` + listColumns + `, %[5]s
+            FROM %[1]s
+            WHERE user_id = $1 AND deleted_at IS NULL AND starts_with(title, $2)
+              AND ($3::integer = 0 OR (%[4]s, id) %[2]s ($4::timestamptz, $3::integer))` + listPlacementFilter + listStatsFilter + `
+            ORDER BY %[4]s %[3]s, id %[3]s
+            LIMIT $5` // Page through user records by one of their timestamps, continuing after the cursor
+
+	// Folders
+	listFolders = `
+            SELECT id, user_id, COALESCE(parent_id, 0), name
+            FROM folders
+            WHERE user_id = $1
+            ORDER BY id` // List the folders of a user
+
+	addFolder = `
+            INSERT INTO folders (user_id, parent_id, name)
+            VALUES ($1, NULLIF($2, 0), $3)
+            RETURNING id` // Create a folder, at the root if it has no parent
+
+	lockFolder = `
+            SELECT id
+            FROM folders
+            WHERE id = $1 AND user_id = $2
+            FOR UPDATE` // Lock a folder of a user, keeping items from being placed in it meanwhile
+
+	moveFolder = `
+            UPDATE folders
+            SET parent_id = NULLIF($3, 0), name = $4
+            WHERE id = $1 AND user_id = $2` // Rename a folder of a user and change its parent
+
+	removeFolder = `
+            DELETE
+            FROM folders
+            WHERE id = $1` // Remove a locked folder
+
+	hasSubfolders = `
+            SELECT EXISTS (SELECT 1 FROM folders WHERE parent_id = $1)` // Check whether a folder has subfolders
+
+	getItemTags = `
+            SELECT tag
+            FROM item_tags
+            WHERE kind = $1 AND item_id = $2
+            ORDER BY tag` // List the tags of an item in alphabetical order
+
+	clearItemTags = `
+            DELETE
+            FROM item_tags
+            WHERE kind = $1 AND item_id = $2` // Remove the tags of an item
+
+	addItemTags = `
+            INSERT INTO item_tags (user_id, kind, item_id, tag)
+            SELECT $1, $2, $3, unnest($4::text[])` // Tag an item of a user
+
+	lockItem = `
+            SELECT id
+            FROM %[1]s
+            WHERE title = $1 AND user_id = $2 AND deleted_at IS NULL
+            FOR UPDATE` // Lock a live item of a user by title
+
+	getItemFolder = `
+            SELECT COALESCE(folder_id, 0)
+            FROM %[1]s
+            WHERE id = $1` // Fetch the folder of an item; zero for the root
+
+	moveItem = `
+            UPDATE %[1]s
+            SET folder_id = NULLIF($2, 0)
+            WHERE id = $1` // Move a locked item to a folder, or to the root
+
+	isFolderUsed = `
+            SELECT EXISTS (SELECT 1 FROM %[1]s WHERE folder_id = $1 AND deleted_at IS NULL)` // Check whether live items are placed in a folder
+
+	// History
+	lockRecord = `
+            SELECT id
+            FROM %[1]s
+            WHERE title = $1 AND user_id = $2 AND (deleted_at IS NOT NULL) = $3
+            FOR UPDATE` // Lock a live or trashed user record by title, serializing the revisions archived for it
+
+	archiveRecord = `
+            INSERT INTO %[2]s (user_id, record_id, title, revision, deleted, %[3]s)
+            SELECT user_id, id, title,
+                   COALESCE((SELECT MAX(h.revision) FROM %[2]s h WHERE h.user_id = r.user_id AND h.title = r.title), 0) + 1,
+                   $3, %[3]s
+            FROM %[1]s r
+            WHERE id = $1 AND user_id = $2` // Archive the current version of a record under the next revision of its title
+
+	pruneHistory = `
+            DELETE
+            FROM %[1]s h
+            WHERE h.user_id = $2 AND ($1::text IS NULL OR h.title = $1)
+              AND h.revision <= (SELECT MAX(m.revision) FROM %[1]s m WHERE m.user_id = h.user_id AND m.title = h.title)
+                                - (SELECT history_retention FROM users WHERE id = $2)` // Drop revisions beyond the owner's retention, for one title or all of them
+
+	listHistory = `
+            SELECT revision, deleted, archived_at
+            FROM %[1]s
+            WHERE title = $1 AND user_id = $2
+            ORDER BY revision DESC` // List the archived revisions of a title, newest first
+
+	getRevision = `
+            SELECT record_id, title, user_id, %[2]s
+            FROM %[1]s
+            WHERE title = $1 AND user_id = $2 AND revision = $3` // Fetch an archived revision of a title
+
+	// Rename
+	lockRenamedRecord = `
+            SELECT id, title
+            FROM %[1]s
+            WHERE user_id = $1 AND deleted_at IS NULL AND (id = $2 OR $2 = 0 AND title = $3)
+            FOR UPDATE` // Lock a live user record by ID or, if the ID is zero, by title
+
+	renameRecord = `
+            UPDATE %[1]s
+            SET title = $2
+            WHERE id = $1
+            RETURNING ` + listColumns + `, %[2]s`  // Give a locked record a new title
+
+	renameHistory = `
+            UPDATE %[1]s
+            SET title = $3,
+                revision = revision + COALESCE((SELECT MAX(m.revision) FROM %[1]s m WHERE m.user_id = $2 AND m.title = $3), 0)
+            WHERE record_id = $1 AND user_id = $2 AND title = $4` // Move the revisions of a record after those of its new title
+
+	// Record IDs
+	nextRecordID = `
+            SELECT nextval(pg_get_serial_sequence('%[1]s', 'id'))` // Reserve an ID for a new record
+
+	getRecordID = `
+            SELECT id
+            FROM %[1]s
+            WHERE title = $1 AND user_id = $2 AND deleted_at IS NULL` // Find the ID of a live record by title and user ID
+
+	pruneRecordReservations = `
+            DELETE
+            FROM record_reservations
+            WHERE user_id = $1 AND reserved_at < $2` // Forget reservations of a user made before the given time
+
+	reserveRecordID = `
+            INSERT INTO record_reservations (table_name, id, user_id)
+            VALUES ('%[1]s', nextval(pg_get_serial_sequence('%[1]s', 'id')), $1)
+            RETURNING id` // Reserve the next ID of a table for a user
+
+	claimRecordID = `
+            DELETE
+            FROM record_reservations
+            WHERE table_name = '%[1]s' AND id = $1 AND user_id = $2 AND reserved_at >= $3
+            RETURNING id` // Consume a reservation of a user made since the given time
+
+	// Access statistics
+	touchRecord = `
+            UPDATE %[1]s
+            SET last_accessed_at = now(), access_count = access_count + 1
+            WHERE id = $1` // Count a read of a record and stamp its time
+
+	// Trash
+	trashRecord = `
+            UPDATE %[1]s
+            SET deleted_at = now()
+            WHERE id = $1` // Move a record to the trash
+
+	restoreRecord = `
+            UPDATE %[1]s
+            SET deleted_at = NULL
+            WHERE title = $1 AND user_id = $2 AND deleted_at IS NOT NULL
+            RETURNING title` // Move a record of a user out of the trash
+
+	purgeRecord = `
+            DELETE
+            FROM %[1]s
+            WHERE id = $1` // Permanently remove a record
+
+	untagRecord = `
+            DELETE
+            FROM item_tags
+            WHERE kind = '%[1]s' AND item_id = $1` // Remove the tags of a permanently removed record
+
+	listTrash = `
+            SELECT user_id, title, deleted_at
+            FROM %[1]s
+            WHERE user_id = $1 AND deleted_at IS NOT NULL
+            ORDER BY deleted_at DESC, id DESC` // List the trashed records of a user, most recently deleted first
+
+	listExpiredTrash = `
+            SELECT user_id, title, deleted_at
+            FROM %[1]s
+            WHERE deleted_at < $1
+            ORDER BY deleted_at, id
+            LIMIT $2` // Find records trashed before the given time, oldest first
+
 	// Passwords
+	nextPasswordID = `
+            SELECT nextval(pg_get_serial_sequence('passwords', 'id'))` // Reserve an ID for a new password entry
+
+	getPasswordID = `
+            SELECT id
+            FROM passwords
+            WHERE title = $1 AND user_id = $2 AND deleted_at IS NULL` // Find password entry ID by title and user ID
+
 	addPassword = `
-            INSERT INTO passwords (title, user_id, login, password)
-            VALUES ($1, $2, $3, $4) 
-            RETURNING title` // Store new password entry and return its title
+            INSERT INTO passwords (id, title, user_id, login, password, compromised)
+            VALUES ($1, $2, $3, $4, $5, $6) 
+            RETURNING title` // Store new password entry under a reserved ID and return its title
 
 	getPassword = `
-            SELECT id, title, user_id, login, password
-            FROM passwords 
-            WHERE title = $1 AND user_id = $2` // Find password entry by title and user ID
-
-	deletePassword = `
-            DELETE 
+            SELECT id, title, user_id, login, password, compromised, created_at, updated_at, last_accessed_at, access_count
             FROM passwords 
-            WHERE title = $1 AND user_id = $2` // Remove password entry by title and user ID
+            WHERE title = $1 AND user_id = $2 AND deleted_at IS NULL` // Find password entry by title and user ID
 
 	updatePassword = `
             UPDATE passwords 
-            SET login = $1, password = $2 
-            WHERE title = $3 AND user_id = $4
+            SET login = $1, password = $2, compromised = $5, updated_at = now()
+            WHERE id = $3 AND user_id = $4 AND deleted_at IS NULL
             RETURNING title` // Update login/password fields in an existing entry
 
+	listAllPasswords = `
+            SELECT id, title, user_id, password, compromised, updated_at
+            FROM passwords
+            WHERE user_id = $1 AND deleted_at IS NULL
+            ORDER BY title` // Fetch the encrypted passwords of all live entries of a user with their breach flags and update times
+
+	markPassword = `
+            UPDATE passwords
+            SET compromised = $2
+            WHERE id = $1` // Set the breach flag of a password entry without counting it as a change
+
+	nextPasswordFieldIDs = `
+            SELECT nextval(pg_get_serial_sequence('password_fields', 'id'))
+            FROM generate_series(1, $1)` // Reserve IDs for new custom fields
+
+	getPasswordFields = `
+            SELECT id, name, type, value
+            FROM password_fields
+            WHERE password_id = $1 AND user_id = $2
+            ORDER BY position` // Fetch the custom fields of a password entry in order
+
+	addPasswordField = `
+            INSERT INTO password_fields (id, password_id, user_id, position, name, type, value)
+            VALUES ($1, $2, $3, $4, $5, $6, $7)` // Store a custom field under a reserved ID
+
+	clearPasswordFields = `
+            DELETE
+            FROM password_fields
+            WHERE password_id = $1 AND user_id = $2` // Remove the custom fields of a password entry before replacing them
+
+	archivePasswordFields = `
+            INSERT INTO password_field_history (history_id, user_id, record_id, position, name, type, value)
+            SELECT (SELECT MAX(h.id) FROM password_history h WHERE h.record_id = f.password_id AND h.user_id = f.user_id),
+                   f.user_id, f.id, f.position, f.name, f.type, f.value
+            FROM password_fields f
+            WHERE f.password_id = $1 AND f.user_id = $2` // Archive the custom fields of a password entry with its latest revision
+
+	getPasswordFieldRevision = `
+            SELECT f.record_id, f.name, f.type, f.value
+            FROM password_field_history f
+            JOIN password_history h ON h.id = f.history_id
+            WHERE h.title = $1 AND h.user_id = $2 AND h.revision = $3
+            ORDER BY f.position` // Fetch the custom fields archived with a revision of a password entry
+
 	// Binary Files
+	nextBinaryID = `
+            SELECT nextval(pg_get_serial_sequence('binaries', 'id'))` // Reserve an ID for a new binary object
+
+	getBinaryID = `
+            SELECT id
+            FROM binaries
+            WHERE title = $1 AND user_id = $2 AND deleted_at IS NULL` // Find binary object ID by title and owner
+
 	addBinary = `
-            INSERT INTO binaries (title, user_id, data) 
-            VALUES ($1, $2, $3) 
-            RETURNING title` // Create new binary object with associated owner
+            INSERT INTO binaries (id, title, user_id, data_key, size, sha256, blob_key, streamed) 
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8) 
+            RETURNING title` // Create new binary object under a reserved ID with associated owner and blob
 
 	getBinary = `
-            SELECT id, title, user_id, data
-            FROM binaries 
-            WHERE title = $1 AND user_id = $2` // Fetch binary object by title and owner
-
-	deleteBinary = `
-            DELETE 
+            SELECT id, title, user_id, data, data_key, size, sha256, blob_key, streamed,
+                   created_at, updated_at, last_accessed_at, access_count
             FROM binaries 
-            WHERE title = $1 AND user_id = $2` // Remove binary object by title and owner
+            WHERE title = $1 AND user_id = $2 AND deleted_at IS NULL` // Fetch binary object by title and owner
 
 	updateBinary = `
+            WITH chunks AS (
+                DELETE FROM binary_chunks WHERE binary_id = $1
+            )
             UPDATE binaries 
-            SET data = $1 
-            WHERE title = $2 AND user_id = $3
-            RETURNING title` // Update binary object's content
+            SET data = NULL, data_key = $3, size = $4, sha256 = $5, blob_key = $6, streamed = $7, updated_at = now()
+            WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+            RETURNING title` // Point binary object to a new blob, dropping content stored in the database
+
+	getBinaryChunks = `
+            SELECT data
+            FROM binary_chunks
+            WHERE binary_id = $1
+            ORDER BY seq` // Read the ciphertext chunks of a binary object streamed before the blob store
+
+	getLegacyBinaries = `
+            SELECT id, title, user_id, data, data_key, size, sha256
+            FROM binaries
+            WHERE blob_key IS NULL
+            ORDER BY id
+            LIMIT $1` // Fetch binary objects whose content is still stored in the database
+
+	offloadBinary = `
+            WITH chunks AS (
+                DELETE FROM binary_chunks WHERE binary_id = $1
+            )
+            UPDATE binaries
+            SET data = NULL, data_key = COALESCE($2, data_key), size = $3, sha256 = $4, blob_key = $5
+            WHERE id = $1 AND blob_key IS NULL` // Move binary object content from the database to a blob, keeping the content key unless replaced
+
+	hasBinaryBlob = `
+            SELECT EXISTS (SELECT 1 FROM binaries WHERE blob_key = $1)
+                OR EXISTS (SELECT 1 FROM binary_history WHERE blob_key = $1)` // Check whether a blob is referenced by a binary object or one of its revisions
 
 	// Credit Cards
+	nextCardID = `
+            SELECT nextval(pg_get_serial_sequence('cards', 'id'))` // Reserve an ID for a new credit card
+
+	getCardID = `
+            SELECT id
+            FROM cards
+            WHERE title = $1 AND user_id = $2 AND deleted_at IS NULL` // Find credit card ID by title and user ID
+
 	addCard = `
-            INSERT INTO cards (title, user_id, bank, number, data_end, secret_code) 
-            VALUES ($1, $2, $3, $4, $5, $6) 
-            RETURNING title` // Store new credit card details
+            INSERT INTO cards (id, title, user_id, bank, number, data_end, secret_code, brand) 
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8) 
+            RETURNING title` // Store new credit card details under a reserved ID
 
 	getCard = `
-            SELECT id, title, user_id, bank, number, data_end, secret_code 
-            FROM cards 
-            WHERE title = $1 AND user_id = $2` // Retrieve credit card info by title and user ID
-
-	deleteCard = `
-            DELETE 
-            FROM cards 
-            WHERE title = $1 AND user_id = $2` // Delete credit card record by title and user ID
+            SELECT c.id, c.title, c.user_id, c.bank, c.number, c.data_end, c.secret_code, c.brand,
+                   c.created_at, c.updated_at, c.last_accessed_at, c.access_count, count(r.id), max(r.revealed_at)
+            FROM cards c
+            LEFT JOIN card_reveals r ON r.card_id = c.id
+            WHERE c.title = $1 AND c.user_id = $2 AND c.deleted_at IS NULL
+            GROUP BY c.id` // Retrieve credit card info by title and user ID with the count and time of its reveals
 
 	updateCard = `
             UPDATE cards 
-            SET bank = $1, number = $2, data_end = $3, secret_code = $4  
-            WHERE title = $5 AND user_id = $6
-            RETURNING title` // Update credit card details by title and user ID
+            SET bank = $1, number = $2, data_end = $3, secret_code = $4, brand = $7, updated_at = now()
+            WHERE id = $5 AND user_id = $6 AND deleted_at IS NULL
+            RETURNING title` // Update credit card details by ID and user ID
+
+	revealCard = `
+            INSERT INTO card_reveals (card_id, user_id, title)
+            SELECT id, user_id, title
+            FROM cards
+            WHERE id = $1 AND user_id = $2` // Record a reveal of the full number and security code of a credit card under its current title
+
+	// Secure Notes
+	addNote = `
+            INSERT INTO notes (id, title, user_id, body)
+            VALUES ($1, $2, $3, $4)
+            RETURNING title` // Store new note under a reserved ID and return its title
+
+	getNote = `
+            SELECT id, title, user_id, body, created_at, updated_at, last_accessed_at, access_count
+            FROM notes
+            WHERE title = $1 AND user_id = $2 AND deleted_at IS NULL` // Find note by title and user ID
+
+	updateNote = `
+            UPDATE notes
+            SET body = $1, updated_at = now()
+            WHERE id = $2 AND user_id = $3 AND deleted_at IS NULL
+            RETURNING title` // Replace the body of an existing note
+
+	// Authenticator Secrets
+	addOTP = `
+            INSERT INTO otp_entries (id, title, user_id, password_id, issuer, account, secret, algorithm, digits, period)
+            VALUES ($1, $2, $3, (SELECT id FROM passwords WHERE id = $4 AND user_id = $3), $5, $6, $7, $8, $9, $10)
+            RETURNING title` // Store new authenticator secret under a reserved ID, linking it to a password of the same user if it still exists
+
+	getOTP = `
+            SELECT o.id, o.title, o.user_id, o.password_id, p.title, o.issuer, o.account, o.secret, o.algorithm, o.digits, o.period,
+                   o.created_at, o.updated_at, o.last_accessed_at, o.access_count
+            FROM otp_entries o
+            LEFT JOIN passwords p ON p.id = o.password_id AND p.deleted_at IS NULL
+            WHERE o.title = $1 AND o.user_id = $2 AND o.deleted_at IS NULL` // Find authenticator secret by title and user ID, with the title of its linked password unless trashed
+
+	updateOTP = `
+            UPDATE otp_entries
+            SET password_id = (SELECT id FROM passwords WHERE id = $1 AND user_id = $9),
+                issuer = $2, account = $3, secret = $4, algorithm = $5, digits = $6, period = $7, updated_at = now()
+            WHERE id = $8 AND user_id = $9 AND deleted_at IS NULL
+            RETURNING title` // Replace an existing authenticator secret and its password link
+
+	// SSH Keys
+	addSSHKey = `
+            INSERT INTO ssh_keys (id, title, user_id, public_key, fingerprint, private_key)
+            VALUES ($1, $2, $3, $4, $5, $6)
+            RETURNING title` // Store new SSH key under a reserved ID and return its title
+
+	getSSHKey = `
+            SELECT id, title, user_id, public_key, fingerprint, private_key, created_at, updated_at, last_accessed_at, access_count
+            FROM ssh_keys
+            WHERE title = $1 AND user_id = $2 AND deleted_at IS NULL` // Find SSH key by title and user ID
+
+	updateSSHKey = `
+            UPDATE ssh_keys
+            SET public_key = $1, fingerprint = $2, private_key = $3, updated_at = now()
+            WHERE id = $4 AND user_id = $5 AND deleted_at IS NULL
+            RETURNING title` // Replace the key pair of an existing SSH key
+
+	listSSHPublicKeys = `
+            SELECT title, public_key, fingerprint
+            FROM ssh_keys
+            WHERE user_id = $1 AND deleted_at IS NULL
+            ORDER BY title` // List the public keys of a user without touching the private keys
 )
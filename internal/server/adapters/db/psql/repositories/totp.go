@@ -0,0 +1,150 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/models"
+	"main/internal/server/services"
+	"time"
+)
+
+// TOTPRepository implements the second factor data access layer for PostgreSQL
+type TOTPRepository struct {
+	db *psql.DB // Database connection
+}
+
+// NewTOTPRepository creates a new TOTPRepository instance
+func NewTOTPRepository(db *psql.DB) *TOTPRepository {
+	return &TOTPRepository{
+		db: db,
+	}
+}
+
+// Save stores a new unconfirmed secret of the user, returning the user's login
+func (r *TOTPRepository) Save(ctx context.Context, userID int64, secret []byte) (string, error) {
+	var login string
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.totp.save, userID, secret).Scan(&login)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", services.ErrTOTPAlreadyEnabled
+		}
+		return "", err
+	}
+	return login, nil
+}
+
+// Get retrieves the secret of the user
+func (r *TOTPRepository) Get(ctx context.Context, userID int64) (*models.TOTP, error) {
+	var result models.TOTP
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.totp.get, userID).
+		Scan(&result.UserID, &result.Secret, &result.Confirmed, &result.LastStep)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrTOTPNotEnabled
+		}
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Confirm enables the secret of the user and replaces the recovery codes in a single transaction
+func (r *TOTPRepository) Confirm(ctx context.Context, userID, step int64, codeHashes [][]byte) error {
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, stmt.totp.confirm, userID, step)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return services.ErrInvalidSecondFactor
+	}
+
+	if _, err := tx.ExecContext(ctx, stmt.totp.clearCodes, userID); err != nil {
+		return err
+	}
+	for _, hash := range codeHashes {
+		if _, err := tx.ExecContext(ctx, stmt.totp.addCode, userID, hash); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// UseStep records the time step of an accepted code and reports whether it was not used before
+func (r *TOTPRepository) UseStep(ctx context.Context, userID, step int64) (bool, error) {
+	return r.exec(ctx, stmt.totp.useStep, userID, step)
+}
+
+// UseRecoveryCode spends a recovery code and reports whether it existed and was unused
+func (r *TOTPRepository) UseRecoveryCode(ctx context.Context, userID int64, codeHash []byte) (bool, error) {
+	return r.exec(ctx, stmt.totp.useCode, userID, codeHash)
+}
+
+// Delete removes the secret and recovery codes of the user
+func (r *TOTPRepository) Delete(ctx context.Context, userID int64) error {
+	ok, err := r.exec(ctx, stmt.totp.delete, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return services.ErrTOTPNotEnabled
+	}
+	return nil
+}
+
+// AddChallenge stores a login challenge by its hash, dropping expired challenges of the user
+func (r *TOTPRepository) AddChallenge(ctx context.Context, userID int64, challengeHash []byte, expiresAt time.Time) error {
+	if _, err := r.db.Conn.ExecContext(ctx, stmt.totp.pruneChallenge, userID); err != nil {
+		return err
+	}
+
+	_, err := r.db.Conn.ExecContext(ctx, stmt.totp.addChallenge, userID, challengeHash, expiresAt)
+	return err
+}
+
+// TakeChallenge counts an attempt against a pending login challenge and returns its user
+func (r *TOTPRepository) TakeChallenge(ctx context.Context, challengeHash []byte, maxAttempts int) (int64, error) {
+	var userID int64
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.totp.takeChallenge, challengeHash, maxAttempts).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, services.ErrInvalidChallenge
+		}
+		return -1, err
+	}
+	return userID, nil
+}
+
+// DeleteChallenge removes a completed login challenge
+func (r *TOTPRepository) DeleteChallenge(ctx context.Context, challengeHash []byte) error {
+	_, err := r.db.Conn.ExecContext(ctx, stmt.totp.dropChallenge, challengeHash)
+	return err
+}
+
+// exec runs a statement and reports whether it affected any row
+func (r *TOTPRepository) exec(ctx context.Context, query string, args ...any) (bool, error) {
+	res, err := r.db.Conn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
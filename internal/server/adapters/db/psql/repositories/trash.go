@@ -0,0 +1,199 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/models"
+	"main/internal/server/services"
+	"time"
+)
+
+// TrashRepository implements the trash data access layer for PostgreSQL.
+// Trashed records stay in the tables of their kind, marked with the time they were deleted.
+type TrashRepository struct {
+	db *psql.DB // Database connection
+}
+
+// NewTrashRepository creates a new TrashRepository instance
+func NewTrashRepository(db *psql.DB) *TrashRepository {
+	return &TrashRepository{
+		db: db,
+	}
+}
+
+// List returns the trashed records of the user, of the given kind or of every kind if it is empty
+func (r *TrashRepository) List(ctx context.Context, userID int64, kind string) ([]models.TrashItem, error) {
+	kinds := models.TrashKinds
+	if kind != "" {
+		kinds = []string{kind}
+	}
+
+	var result []models.TrashItem
+	for _, k := range kinds {
+		_, q, err := trashTable(k)
+		if err != nil {
+			return nil, err
+		}
+		items, err := scanTrash(ctx, r.db, k, q.list, userID)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, items...)
+	}
+	return result, nil
+}
+
+// Restore moves a record out of the trash by kind, title and user ID
+func (r *TrashRepository) Restore(ctx context.Context, userID int64, kind, title string) (string, error) {
+	_, q, err := trashTable(kind)
+	if err != nil {
+		return "", err
+	}
+
+	err = r.db.Conn.QueryRowContext(ctx, q.restore, title, userID).Scan(&title)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
+		return "", services.ErrTrashTitleTaken
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", services.ErrTrashItemNotFound
+		}
+		return "", err
+	}
+	return title, nil
+}
+
+// Purge permanently removes a trashed record by kind, title and user ID, archiving it as deleted
+func (r *TrashRepository) Purge(ctx context.Context, userID int64, kind, title string) error {
+	h, q, err := trashTable(kind)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = purge(ctx, tx, h, q, title, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return services.ErrTrashItemNotFound
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// Expired returns up to limit records of every kind trashed before the given time, oldest first
+func (r *TrashRepository) Expired(ctx context.Context, before time.Time, limit int) ([]models.TrashItem, error) {
+	var result []models.TrashItem
+	for _, k := range models.TrashKinds {
+		_, q, err := trashTable(k)
+		if err != nil {
+			return nil, err
+		}
+		items, err := scanTrash(ctx, r.db, k, q.expired, before, limit)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, items...)
+	}
+	return result, nil
+}
+
+// moveToTrash moves the live record with the given title to the trash. A record of the same title already
+// in the trash is purged first, so the trash keeps at most one record per title.
+// It reports false if the user has no such live record.
+func moveToTrash(ctx context.Context, db *psql.DB, h historyQueries, q trashQueries, title string, userID int64) (bool, error) {
+	var id int64
+
+	tx, err := db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, h.lock, title, userID, false).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	err = purge(ctx, tx, h, q, title, userID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+
+	if _, err := tx.ExecContext(ctx, q.trash, id); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// purge archives the trashed record with the given title within tx and removes it permanently, along with its tags.
+// It returns sql.ErrNoRows if the user has no such record in the trash.
+func purge(ctx context.Context, tx *sql.Tx, h historyQueries, q trashQueries, title string, userID int64) error {
+	id, err := archive(ctx, tx, h, title, userID, true)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, q.untag, id); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, q.purge, id)
+	return err
+}
+
+// trashTable resolves the history and trash queries of the table holding records of the given kind.
+func trashTable(kind string) (historyQueries, trashQueries, error) {
+	switch kind {
+	case models.TablePasswords:
+		return stmt.password.history, stmt.password.trash, nil
+	case models.TableCards:
+		return stmt.card.history, stmt.card.trash, nil
+	case models.TableBinaries:
+		return stmt.binary.history, stmt.binary.trash, nil
+	case models.TableNotes:
+		return stmt.note.history, stmt.note.trash, nil
+	case models.TableOTP:
+		return stmt.otp.history, stmt.otp.trash, nil
+	case models.TableSSHKeys:
+		return stmt.sshKey.history, stmt.sshKey.trash, nil
+	default:
+		return historyQueries{}, trashQueries{}, services.ErrUnknownKind
+	}
+}
+
+// scanTrash runs a trash listing query and collects the records of the given kind it returns.
+func scanTrash(ctx context.Context, db *psql.DB, kind, query string, args ...any) ([]models.TrashItem, error) {
+	rows, err := db.Conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.TrashItem
+	for rows.Next() {
+		item := models.TrashItem{Kind: kind}
+		if err := rows.Scan(&item.UserID, &item.Title, &item.DeletedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"main/internal/server/adapters/db/psql"
+	"main/internal/server/services"
+)
+
+// UserKeysRepository stores the wrapped per-user data-encryption keys in PostgreSQL.
+type UserKeysRepository struct {
+	db *psql.DB // Database connection
+}
+
+// NewUserKeysRepository creates a new UserKeysRepository instance
+func NewUserKeysRepository(db *psql.DB) *UserKeysRepository {
+	return &UserKeysRepository{
+		db: db,
+	}
+}
+
+// Get retrieves the wrapped data-encryption key of the user
+func (r *UserKeysRepository) Get(ctx context.Context, userID int64) ([]byte, error) {
+	var wrapped []byte
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.userKey.get, userID).Scan(&wrapped)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrUserKeyNotFound
+		}
+		return nil, err
+	}
+	return wrapped, nil
+}
+
+// Add stores a wrapped data-encryption key for the user.
+// If another key was stored concurrently, that key wins and is returned instead.
+func (r *UserKeysRepository) Add(ctx context.Context, userID int64, wrapped []byte) ([]byte, error) {
+	_, err := r.db.Conn.ExecContext(ctx, stmt.userKey.add, userID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	return r.Get(ctx, userID)
+}
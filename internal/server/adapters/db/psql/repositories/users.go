@@ -29,7 +29,19 @@ func NewUsersRepository(db *psql.DB) *UsersRepository {
 func (r *UsersRepository) Register(ctx context.Context, cond models.User) (int64, error) {
 	var userID int64
 
-	err := r.db.Conn.QueryRowContext(ctx, stmt.user.register, cond.Login, cond.Password).Scan(&userID)
+	var (
+		salt, check             []byte
+		passes, memory, threads sql.NullInt64
+	)
+	if v := cond.Vault; v != nil {
+		salt, check = v.Salt, v.Check
+		passes = sql.NullInt64{Int64: int64(v.Time), Valid: true}
+		memory = sql.NullInt64{Int64: int64(v.Memory), Valid: true}
+		threads = sql.NullInt64{Int64: int64(v.Threads), Valid: true}
+	}
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.user.register, cond.Login, cond.Password,
+		cond.Vault != nil, salt, passes, memory, threads, check).Scan(&userID)
 
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
@@ -56,3 +68,62 @@ func (r *UsersRepository) Login(ctx context.Context, Login string) (*models.User
 	}
 	return &user, nil
 }
+
+// Vault retrieves the vault parameters of a user.
+// It returns nil parameters if the user does not use a zero-knowledge vault.
+func (r *UsersRepository) Vault(ctx context.Context, userID int64) (*models.VaultParams, error) {
+	var (
+		vault                   bool
+		salt, check             []byte
+		passes, memory, threads sql.NullInt64
+	)
+
+	err := r.db.Conn.QueryRowContext(ctx, stmt.user.vault, userID).Scan(&vault, &salt, &passes, &memory, &threads, &check)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, services.ErrUserNotFound
+		}
+		return nil, err
+	}
+	if !vault {
+		return nil, nil
+	}
+
+	return &models.VaultParams{
+		Salt:    salt,
+		Time:    uint32(passes.Int64),
+		Memory:  uint32(memory.Int64),
+		Threads: uint32(threads.Int64),
+		Check:   check,
+	}, nil
+}
+
+// SetHistoryRetention changes the number of revisions kept per record of a user and drops the
+// revisions beyond the new limit right away.
+func (r *UsersRepository) SetHistoryRetention(ctx context.Context, userID int64, keep int) error {
+	tx, err := r.db.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, stmt.user.retention, userID, keep)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return services.ErrUserNotFound
+	}
+
+	for _, q := range []historyQueries{stmt.password.history, stmt.card.history, stmt.binary.history, stmt.note.history, stmt.otp.history, stmt.sshKey.history} {
+		if _, err := tx.ExecContext(ctx, q.prune, nil, userID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -7,26 +7,37 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"log"
+	"main/internal/server/adapters/blob/local"
+	"main/internal/server/adapters/blob/s3"
+	"main/internal/server/adapters/breach"
 	"main/internal/server/adapters/db/psql"
 	"main/internal/server/adapters/db/psql/repositories"
+	"main/internal/server/auth"
 	"main/internal/server/config"
 	"main/internal/server/crypto"
 	"main/internal/server/interfaces"
 	"main/internal/server/services"
 	"net"
+	"os"
+	"os/signal"
 	"sync"
+	"syscall"
 	"time"
 )
 
 const (
 	// ShutdownTime specifies the grace period for shutting down the server.
 	ShutdownTime = 5 * time.Second
+
+	// blobConnectTimeout bounds the time spent connecting to the blob store on start.
+	blobConnectTimeout = 30 * time.Second
 )
 
 // App encapsulates the core application state and dependencies.
 type App struct {
 	services *Services          // Business logic and service instances.
 	srv      *grpc.Server       // gRPC servers
+	tls      *TLSReloader       // TLS configuration reloaded on SIGHUP; nil when serving plaintext.
 	log      *zap.SugaredLogger // Configuration settings.
 	conf     *config.Config     // Logger for application-wide logging.
 	cancel   context.CancelFunc // Function to cancel the application context.
@@ -41,7 +52,15 @@ func NewApp(c *config.Config, l *zap.SugaredLogger) (*App, error) {
 		return nil, err
 	}
 
-	srv, err := NewServer(s, c, l)
+	var t *TLSReloader
+	if c.TLSCertFile != "" {
+		t, err = NewTLSReloader(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	srv, err := NewServer(s, t, c, l)
 	if err != nil {
 		return nil, err
 	}
@@ -53,16 +72,32 @@ func NewApp(c *config.Config, l *zap.SugaredLogger) (*App, error) {
 		log:      l,
 		conf:     c,
 		srv:      srv,
+		tls:      t,
 		ctx:      ctx,
 		cancel:   cancel,
 	}
 	return app, nil
 }
 
-// StartServer launches a secondary server dedicated to performance profiling tools.
+// StartServer launches the gRPC server together with the background workers and blocks until it stops.
 func (a *App) StartServer() error {
+	a.wg.Add(1)
 	defer a.wg.Done()
 
+	a.wg.Add(1)
+	go a.runKeyRotation()
+
+	a.wg.Add(1)
+	go a.runBlobGC()
+
+	a.wg.Add(1)
+	go a.runTrashPurge()
+
+	if a.tls != nil {
+		a.wg.Add(1)
+		go a.runTLSReload()
+	}
+
 	a.log.Infow("Starting gRPC server", "addr", a.conf.GRPCAddr)
 
 	listen, err := net.Listen("tcp", a.conf.GRPCAddr)
@@ -89,6 +124,115 @@ func (a *App) StartServer() error {
 	return nil
 }
 
+// runKeyRotation periodically advances pending master key rotation jobs until the application stops.
+func (a *App) runKeyRotation() {
+	defer a.wg.Done()
+
+	ticker := time.NewTicker(a.conf.RotationPoll)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+			a.advanceKeyRotation()
+		}
+	}
+}
+
+// advanceKeyRotation processes batches of the pending key rotation job until it finishes,
+// fails, or the application stops. Progress is persisted after every batch.
+func (a *App) advanceKeyRotation() {
+	for a.ctx.Err() == nil {
+		job, err := a.services.rotation.Step(a.ctx)
+		if errors.Is(err, services.ErrNoPendingRotation) {
+			return
+		}
+		if err != nil {
+			a.log.Errorw(err.Error(), "event", "advance key rotation")
+			return
+		}
+
+		a.log.Debugw("Key rotation advanced", "id", job.ID, "phase", job.Phase,
+			"processed", job.Processed, "skipped", job.Skipped)
+
+		if job.FinishedAt != nil {
+			a.log.Infow("Key rotation finished", "id", job.ID, "key", job.MasterKeyID,
+				"processed", job.Processed, "skipped", job.Skipped)
+			return
+		}
+	}
+}
+
+// runBlobGC periodically removes blobs no binary refers to until the application stops.
+func (a *App) runBlobGC() {
+	defer a.wg.Done()
+
+	ticker := time.NewTicker(a.conf.BlobGCPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+			removed, err := a.services.binaries.CollectGarbage(a.ctx, a.conf.BlobGCGrace)
+			if err != nil && a.ctx.Err() == nil {
+				a.log.Errorw(err.Error(), "event", "collect orphaned blobs")
+			}
+			if removed > 0 {
+				a.log.Infow("Orphaned blobs removed", "count", removed)
+			}
+		}
+	}
+}
+
+// runTrashPurge periodically purges records kept in the trash longer than the retention until the application stops.
+func (a *App) runTrashPurge() {
+	defer a.wg.Done()
+
+	ticker := time.NewTicker(a.conf.TrashPurgePeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := a.services.trash.PurgeExpired(a.ctx)
+			if err != nil && a.ctx.Err() == nil {
+				a.log.Errorw(err.Error(), "event", "purge expired trash")
+			}
+			if purged > 0 {
+				a.log.Infow("Expired trash purged", "count", purged)
+			}
+		}
+	}
+}
+
+// runTLSReload reloads the TLS certificates on SIGHUP until the application stops.
+func (a *App) runTLSReload() {
+	defer a.wg.Done()
+
+	hupCh := make(chan os.Signal, 1)
+	signal.Notify(hupCh, syscall.SIGHUP)
+	defer signal.Stop(hupCh)
+
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-hupCh:
+			if err := a.tls.Reload(); err != nil {
+				a.log.Errorw(err.Error(), "event", "reload TLS certificates")
+				continue
+			}
+			a.log.Infow("TLS certificates reloaded")
+		}
+	}
+}
+
 // Close gracefully cleans up running services and dependencies.
 func (a *App) Close() error {
 	a.cancel()
@@ -102,11 +246,22 @@ func (a *App) Close() error {
 }
 
 type Services struct {
-	binaries  interfaces.BinariesService
-	passwords interfaces.PasswordsService
-	cards     interfaces.CardsService
-	users     interfaces.UsersService
-	r         *Repositories
+	binaries     interfaces.BinariesService
+	passwords    interfaces.PasswordsService
+	cards        interfaces.CardsService
+	notes        interfaces.NotesService
+	otp          interfaces.OTPService
+	sshKeys      interfaces.SSHKeysService
+	folders      interfaces.FoldersService
+	trash        interfaces.TrashService
+	records      interfaces.RecordsService
+	users        interfaces.UsersService
+	sessions     interfaces.SessionsService
+	totp         interfaces.SecondFactorService
+	certificates interfaces.CertificatesService
+	rotation     interfaces.KeyRotationService
+	jwt          interfaces.JWTService
+	r            *Repositories
 }
 
 func NewServices(c *config.Config, l *zap.SugaredLogger) (*Services, error) {
@@ -115,21 +270,59 @@ func NewServices(c *config.Config, l *zap.SugaredLogger) (*Services, error) {
 		return nil, err
 	}
 
-	aesCrypto, err := crypto.NewAes([]byte(c.CryptoSecret))
+	masterKeys := make(map[uint32][]byte, len(c.CryptoKeys))
+	for id, secret := range c.CryptoKeys {
+		masterKeys[id] = []byte(secret)
+	}
+	keyring, err := crypto.NewKeyring(masterKeys, c.CryptoKeyID, []byte(c.CryptoSecret))
+	if err != nil {
+		return nil, err
+	}
+	blobs, err := NewBlobStore(c)
+	if err != nil {
+		return nil, err
+	}
+	corpus, err := NewBreachCorpus(c)
 	if err != nil {
 		return nil, err
 	}
+
+	keys := services.NewKeysService(r.userKeys, keyring, c.CryptoStrict)
+	records := services.NewVaultCryptoService(keys, r.users)
 	passCrypto := crypto.NewPassCrypto()
 
+	j, err := auth.NewJWTService(c.JWTSecret, c.JWTExpiration)
+	if err != nil {
+		return nil, err
+	}
+	sessions := services.NewSessionsService(r.sessions, j, keys, c.SessionTTL)
+	totp := services.NewSecondFactorService(r.totp, keys)
+
 	return &Services{
-		binaries:  services.NewBinariesService(r.binaries, aesCrypto),
-		passwords: services.NewPasswordsService(r.passwords, aesCrypto),
-		cards:     services.NewCardsService(r.cards, aesCrypto),
-		users:     services.NewUsersService(r.users, passCrypto),
-		r:         r,
+		binaries:     services.NewBinariesService(r.binaries, r.users, r.folders, r.records, records, blobs),
+		passwords:    services.NewPasswordsService(r.passwords, r.users, r.folders, r.records, records, corpus),
+		cards:        services.NewCardsService(r.cards, r.users, r.folders, r.records, records),
+		notes:        services.NewNotesService(r.notes, r.users, r.folders, r.records, records),
+		otp:          services.NewOTPService(r.otp, r.passwords, r.users, r.folders, r.records, records),
+		sshKeys:      services.NewSSHKeysService(r.sshKeys, r.users, r.folders, r.records, records),
+		folders:      services.NewFoldersService(r.folders),
+		trash:        services.NewTrashService(r.trash, c.TrashRetention),
+		records:      services.NewRecordsService(r.records),
+		users:        services.NewUsersService(r.users, passCrypto),
+		sessions:     sessions,
+		totp:         totp,
+		certificates: services.NewCertificatesService(r.certificates, sessions, totp),
+		rotation:     services.NewRotationService(r.rotations, keyring, keys, c.RotationBatch),
+		jwt:          j,
+		r:            r,
 	}, nil
 }
 
+// Binaries returns the service managing binary data, for maintenance commands run outside the server.
+func (s *Services) Binaries() interfaces.BinariesService {
+	return s.binaries
+}
+
 func (s *Services) Close() error {
 	err := s.r.Close()
 	if err != nil {
@@ -139,11 +332,22 @@ func (s *Services) Close() error {
 }
 
 type Repositories struct {
-	binaries  interfaces.BinariesRepository
-	passwords interfaces.PasswordsRepository
-	cards     interfaces.CardsRepository
-	users     interfaces.UsersRepository
-	db        interfaces.DB
+	binaries     interfaces.BinariesRepository
+	passwords    interfaces.PasswordsRepository
+	cards        interfaces.CardsRepository
+	notes        interfaces.NotesRepository
+	otp          interfaces.OTPRepository
+	sshKeys      interfaces.SSHKeysRepository
+	folders      interfaces.FoldersRepository
+	trash        interfaces.TrashRepository
+	records      interfaces.RecordsRepository
+	users        interfaces.UsersRepository
+	sessions     interfaces.SessionsRepository
+	totp         interfaces.TOTPRepository
+	certificates interfaces.CertificatesRepository
+	userKeys     interfaces.UserKeysRepository
+	rotations    interfaces.KeyRotationsRepository
+	db           interfaces.DB
 }
 
 func NewRepositories(c *config.Config, l *zap.SugaredLogger) (*Repositories, error) {
@@ -168,6 +372,48 @@ func (r *Repositories) Close() error {
 	return nil
 }
 
+// NewBlobStore opens the blob store holding the content of binaries, as selected by the configuration.
+func NewBlobStore(c *config.Config) (interfaces.BlobStore, error) {
+	switch c.BlobBackend {
+	case config.BlobBackendS3:
+		ctx, cancel := context.WithTimeout(context.Background(), blobConnectTimeout)
+		defer cancel()
+
+		store, err := s3.NewStore(ctx, s3.Options{
+			Endpoint:  c.S3Endpoint,
+			Bucket:    c.S3Bucket,
+			Region:    c.S3Region,
+			AccessKey: c.S3AccessKey,
+			SecretKey: c.S3SecretKey,
+			UseSSL:    c.S3UseSSL,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	default:
+		store, err := local.NewStore(c.BlobDir)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	}
+}
+
+// NewBreachCorpus opens the corpus of breached passwords; without a configured directory
+// there is none and passwords are not checked.
+func NewBreachCorpus(c *config.Config) (interfaces.BreachCorpus, error) {
+	if c.BreachDir == "" {
+		return nil, nil
+	}
+
+	corpus, err := breach.NewCorpus(c.BreachDir)
+	if err != nil {
+		return nil, err
+	}
+	return corpus, nil
+}
+
 func postgresRepositories(c *config.Config, l *zap.SugaredLogger) (*Repositories, error) {
 	db, err := psql.NewDB(c.DatabaseDSN)
 	if err != nil {
@@ -179,10 +425,21 @@ func postgresRepositories(c *config.Config, l *zap.SugaredLogger) (*Repositories
 	}
 
 	return &Repositories{
-		binaries:  repositories.NewBinariesRepository(db),
-		passwords: repositories.NewPasswordsRepository(db),
-		cards:     repositories.NewCardsRepository(db),
-		users:     repositories.NewUsersRepository(db),
-		db:        db,
+		binaries:     repositories.NewBinariesRepository(db),
+		passwords:    repositories.NewPasswordsRepository(db),
+		cards:        repositories.NewCardsRepository(db),
+		notes:        repositories.NewNotesRepository(db),
+		otp:          repositories.NewOTPRepository(db),
+		sshKeys:      repositories.NewSSHKeysRepository(db),
+		folders:      repositories.NewFoldersRepository(db),
+		trash:        repositories.NewTrashRepository(db),
+		records:      repositories.NewRecordsRepository(db),
+		users:        repositories.NewUsersRepository(db),
+		sessions:     repositories.NewSessionsRepository(db),
+		totp:         repositories.NewTOTPRepository(db),
+		certificates: repositories.NewCertificatesRepository(db),
+		userKeys:     repositories.NewUserKeysRepository(db),
+		rotations:    repositories.NewKeyRotationsRepository(db),
+		db:           db,
 	}, nil
 }
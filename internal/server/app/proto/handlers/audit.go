@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"main/internal/audit"
+	pb "main/proto"
+)
+
+// newAuditPolicy translates a protobuf audit request into a policy, applying the defaults to zero values.
+// A negative maximum age skips the check for stale passwords.
+func newAuditPolicy(in *pb.AuditRequest) audit.Policy {
+	policy := audit.DefaultPolicy()
+	if in.MinScore != 0 {
+		policy.MinScore = int(in.MinScore)
+	}
+	if in.MaxAgeDays > 0 {
+		policy.MaxAgeDays = int(in.MaxAgeDays)
+	}
+	if in.MaxAgeDays < 0 {
+		policy.MaxAgeDays = 0
+	}
+	return policy
+}
+
+// newAuditResponse converts an audit report into its protobuf representation.
+func newAuditResponse(report *audit.Report) *pb.AuditResponse {
+	out := &pb.AuditResponse{
+		MinScore:   int32(report.Policy.MinScore),
+		MaxAgeDays: int32(report.Policy.MaxAgeDays),
+		Total:      int32(report.Total),
+		Weak:       make([]*pb.WeakPassword, 0, len(report.Weak)),
+		Reused:     make([]*pb.ReusedPassword, 0, len(report.Reused)),
+		Stale:      make([]*pb.StalePassword, 0, len(report.Stale)),
+		Breached:   make([]*pb.BreachedPassword, 0, len(report.Breached)),
+	}
+	for _, w := range report.Weak {
+		out.Weak = append(out.Weak, &pb.WeakPassword{
+			Title: w.Title,
+			Strength: &pb.PasswordStrength{
+				Score:        int32(w.Strength.Score),
+				GuessesLog10: w.Strength.GuessesLog10,
+				Warning:      w.Strength.Warning,
+			},
+		})
+	}
+	for _, r := range report.Reused {
+		out.Reused = append(out.Reused, &pb.ReusedPassword{Titles: r.Titles})
+	}
+	for _, s := range report.Stale {
+		out.Stale = append(out.Stale, &pb.StalePassword{
+			Title:     s.Title,
+			UpdatedAt: timestamppb.New(s.UpdatedAt),
+			AgeDays:   int32(s.AgeDays),
+		})
+	}
+	for _, b := range report.Breached {
+		out.Breached = append(out.Breached, &pb.BreachedPassword{Title: b.Title, Count: b.Count})
+	}
+	return out
+}
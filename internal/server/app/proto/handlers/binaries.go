@@ -6,6 +6,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
 	"main/internal/server/interfaces"
 	"main/internal/server/models"
 	"main/internal/server/services"
@@ -32,6 +34,7 @@ func NewBinariesHandler(s interfaces.BinariesService, j interfaces.JWTService) *
 // It extracts the user ID from the context and passes control to the BinariesService.
 // Possible errors:
 // - ErrBinaryNotFound: If no password matches the given title and user ID.
+// - ErrBinaryStreamed: If the binary was uploaded as a stream and has to be downloaded as one.
 // - Internal server error if any other issue occurs during processing.
 func (h *BinariesHandler) Get(ctx context.Context, in *pb.BinariesRequest) (*pb.BinariesResponse, error) {
 	userID := ctx.Value("userID").(int64)
@@ -41,13 +44,21 @@ func (h *BinariesHandler) Get(ctx context.Context, in *pb.BinariesRequest) (*pb.
 		if errors.Is(err, services.ErrBinaryNotFound) {
 			return nil, status.Errorf(codes.NotFound, "binary with title '%s' was not found.", in.Title)
 		}
+		if errors.Is(err, services.ErrBinaryStreamed) {
+			return nil, status.Errorf(codes.FailedPrecondition, "binary with title '%s' is streamed, download it instead.", in.Title)
+		}
 		return nil, status.Error(codes.Internal, "Internal server error.")
 	}
 
 	return &pb.BinariesResponse{
-		Id:    result.ID,
-		Title: result.Title,
-		Data:  result.Data,
+		Id:             result.ID,
+		Title:          result.Title,
+		Data:           result.Data,
+		Placement:      newPlacementResponse(result.Placement),
+		CreatedAt:      timestamppb.New(result.Stats.CreatedAt),
+		UpdatedAt:      timestamppb.New(result.Stats.UpdatedAt),
+		LastAccessedAt: accessedAt(result.Stats.AccessedAt),
+		AccessCount:    result.Stats.AccessCount,
 	}, nil
 }
 
@@ -55,14 +66,18 @@ func (h *BinariesHandler) Get(ctx context.Context, in *pb.BinariesRequest) (*pb.
 // It populates a BinaryData model and invokes the BinariesService to perform the insertion.
 // Possible errors:
 // - ErrBinaryAlreadyExists: If a password with the same title already exists for this user.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - ErrInvalidReservation: If the given record ID was not reserved for a new record of the user.
 // - Internal server error if any other issue occurs during processing.
 func (h *BinariesHandler) Add(ctx context.Context, in *pb.BinariesCreateRequest) (*pb.BinariesShortResponse, error) {
 	userID := ctx.Value("userID").(int64)
 
 	cond := models.BinaryData{
-		UserID: userID,
-		Title:  in.Title,
-		Data:   in.Data,
+		ID:        in.Id,
+		UserID:    userID,
+		Title:     in.Title,
+		Data:      in.Data,
+		Placement: newPlacement(in.Placement),
 	}
 
 	result, err := h.s.Add(ctx, cond)
@@ -70,6 +85,12 @@ func (h *BinariesHandler) Add(ctx context.Context, in *pb.BinariesCreateRequest)
 		if errors.Is(err, services.ErrBinaryAlreadyExists) {
 			return nil, status.Errorf(codes.AlreadyExists, "A binary with title '%s' already exists.", in.Title)
 		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
 		return nil, status.Error(codes.Internal, "Internal server error.")
 	}
 
@@ -81,18 +102,32 @@ func (h *BinariesHandler) Add(ctx context.Context, in *pb.BinariesCreateRequest)
 // Update modifies an existing binary data entry.
 // It prepares a BinaryData model and triggers the BinariesService to execute the update.
 // Possible errors:
+// - ErrBinaryNotFound: If no binary matches the given title and user ID.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - ErrRecordReplaced: If the given record ID is no longer that of the record with the title.
 // - Internal server error if any issue occurs during processing.
 func (h *BinariesHandler) Update(ctx context.Context, in *pb.BinariesUpdateRequest) (*pb.BinariesShortResponse, error) {
 	userID := ctx.Value("userID").(int64)
 
 	cond := models.BinaryData{
-		UserID: userID,
-		Title:  in.Title,
-		Data:   in.Data,
+		ID:        in.Id,
+		UserID:    userID,
+		Title:     in.Title,
+		Data:      in.Data,
+		Placement: newPlacementUpdate(in.Placement, in.ReplaceFolder, in.ReplaceTags),
 	}
 
 	result, err := h.s.Update(ctx, cond)
 	if err != nil {
+		if errors.Is(err, services.ErrBinaryNotFound) {
+			return nil, status.Errorf(codes.NotFound, "binary with title '%s' was not found.", in.Title)
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
 		return nil, err
 	}
 
@@ -101,16 +136,275 @@ func (h *BinariesHandler) Update(ctx context.Context, in *pb.BinariesUpdateReque
 	}, nil
 }
 
-// Delete removes a binary data entry by title and user ID.
+// Delete moves a binary data entry to the trash by title and user ID.
 // It extracts the user ID from the context and forwards the removal request to the BinariesService.
 // Possible errors:
-// - Internal server error if any issue occurs during processing.
+// - ErrBinaryNotFound: If no binary matches the given title and user ID.
+// - Internal server error if any other issue occurs during processing.
 func (h *BinariesHandler) Delete(ctx context.Context, in *pb.BinariesRequest) (*emptypb.Empty, error) {
 	userID := ctx.Value("userID").(int64)
 
 	err := h.s.Delete(ctx, in.Title, userID)
 	if err != nil {
-		return nil, err
+		if errors.Is(err, services.ErrBinaryNotFound) {
+			return nil, status.Errorf(codes.NotFound, "binary with title '%s' was not found.", in.Title)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Rename gives a binary found by its ID or, if the ID is zero, by its title a new title.
+// Possible errors:
+// - ErrBinaryNotFound: If the user has no such binary.
+// - ErrBinaryAlreadyExists: If another binary of the user already has the new title.
+// - ErrInvalidTitle: If the new title is blank or too long.
+// - Internal server error if any other issue occurs during processing.
+func (h *BinariesHandler) Rename(ctx context.Context, in *pb.RenameRequest) (*pb.BinariesShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Rename(ctx, newRename(in, userID))
+	if err != nil {
+		if errors.Is(err, services.ErrBinaryNotFound) {
+			return nil, status.Errorf(codes.NotFound, "binary %s was not found.", renamed(in))
+		}
+		if errors.Is(err, services.ErrBinaryAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "A binary with title '%s' already exists.", in.NewTitle)
+		}
+		if errors.Is(err, services.ErrInvalidTitle) {
+			return nil, status.Errorf(codes.InvalidArgument, "Title must be 1 to %d characters long.", services.MaxTitleLen)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.BinariesShortResponse{
+		Id:             result.ID,
+		Title:          result.Title,
+		CreatedAt:      timestamppb.New(result.Stats.CreatedAt),
+		UpdatedAt:      timestamppb.New(result.Stats.UpdatedAt),
+		LastAccessedAt: accessedAt(result.Stats.AccessedAt),
+		AccessCount:    result.Stats.AccessCount,
+	}, nil
+}
+
+// List returns a page of binary data entries owned by the user.
+// Entries can be filtered by a title prefix or by the time they were last changed or read,
+// and ordered by title or by the time they were created, last changed or last read.
+// Possible errors:
+// - ErrInvalidCursor: If the supplied cursor is malformed or was issued for another ordering.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - Internal server error if any other issue occurs during processing.
+func (h *BinariesHandler) List(ctx context.Context, in *pb.ListRequest) (*pb.BinariesListResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.List(ctx, newListQuery(userID, in))
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, "Invalid list cursor.")
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	items := make([]*pb.BinariesShortResponse, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, &pb.BinariesShortResponse{
+			Id:             item.ID,
+			Title:          item.Title,
+			CreatedAt:      timestamppb.New(item.Stats.CreatedAt),
+			UpdatedAt:      timestamppb.New(item.Stats.UpdatedAt),
+			LastAccessedAt: accessedAt(item.Stats.AccessedAt),
+			AccessCount:    item.Stats.AccessCount,
+		})
+	}
+
+	return &pb.BinariesListResponse{
+		Items:      items,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// downloadChunkSize is the amount of content sent in one Download message.
+const downloadChunkSize = 256 * 1024
+
+// Upload stores a new binary data entry streamed by the client.
+// The stream starts with the entry info, continues with the content in chunks and ends with the
+// SHA-256 checksum of the content; the entry is only stored if the checksum matches.
+// Possible errors:
+// - InvalidArgument: If the messages arrive out of order or the stream ends without a checksum.
+// - ErrBinaryAlreadyExists: If a binary with the same title already exists for this user.
+// - ErrChecksumMismatch: If the content does not match the checksum.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - ErrInvalidReservation: If the given record ID was not reserved for a new record of the user.
+// - Internal server error if any other issue occurs during processing.
+func (h *BinariesHandler) Upload(stream pb.Binaries_UploadServer) error {
+	ctx := stream.Context()
+	userID := ctx.Value("userID").(int64)
+
+	in, err := stream.Recv()
+	if err != nil {
+		return err
 	}
-	return nil, nil
+	info := in.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "Upload must start with the binary info.")
+	}
+
+	upload, err := h.s.Upload(ctx, models.BinaryData{
+		ID:        info.Id,
+		UserID:    userID,
+		Title:     info.Title,
+		Placement: newPlacement(info.Placement),
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrBinaryAlreadyExists) {
+			return status.Errorf(codes.AlreadyExists, "A binary with title '%s' already exists.", info.Title)
+		}
+		if st := placementError(err); st != nil {
+			return st
+		}
+		if st := recordError(err); st != nil {
+			return st
+		}
+		return status.Error(codes.Internal, "Internal server error.")
+	}
+
+	for {
+		in, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			upload.Abort()
+			return status.Error(codes.InvalidArgument, "Upload ended without a checksum.")
+		}
+		if err != nil {
+			upload.Abort()
+			return err
+		}
+
+		switch payload := in.Payload.(type) {
+		case *pb.BinaryUploadRequest_Chunk:
+			if _, err := upload.Write(payload.Chunk); err != nil {
+				upload.Abort()
+				return status.Error(codes.Internal, "Internal server error.")
+			}
+		case *pb.BinaryUploadRequest_Sha256:
+			result, err := upload.Commit(payload.Sha256)
+			if err != nil {
+				if errors.Is(err, services.ErrChecksumMismatch) {
+					return status.Error(codes.DataLoss, "Uploaded content does not match its checksum.")
+				}
+				if errors.Is(err, services.ErrBinaryAlreadyExists) {
+					return status.Errorf(codes.AlreadyExists, "A binary with title '%s' already exists.", info.Title)
+				}
+				return status.Error(codes.Internal, "Internal server error.")
+			}
+			return stream.SendAndClose(&pb.BinariesShortResponse{
+				Title: result,
+			})
+		default:
+			upload.Abort()
+			return status.Error(codes.InvalidArgument, "Unexpected upload message.")
+		}
+	}
+}
+
+// Download streams a binary data entry to the client.
+// The stream starts with the entry info, carrying the size and SHA-256 checksum of the content,
+// and continues with the content in chunks.
+// Possible errors:
+// - ErrBinaryNotFound: If no binary matches the given title and user ID.
+// - Internal server error if any other issue occurs during processing.
+func (h *BinariesHandler) Download(in *pb.BinariesRequest, stream pb.Binaries_DownloadServer) error {
+	ctx := stream.Context()
+	userID := ctx.Value("userID").(int64)
+
+	result, content, err := h.s.Download(ctx, in.Title, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrBinaryNotFound) {
+			return status.Errorf(codes.NotFound, "binary with title '%s' was not found.", in.Title)
+		}
+		return status.Error(codes.Internal, "Internal server error.")
+	}
+	defer content.Close()
+
+	err = stream.Send(&pb.BinaryDownloadResponse{
+		Payload: &pb.BinaryDownloadResponse_Info{
+			Info: &pb.BinaryDownloadInfo{
+				Title:          result.Title,
+				Size:           result.Size,
+				Sha256:         result.SHA256,
+				Streamed:       result.Streamed,
+				Placement:      newPlacementResponse(result.Placement),
+				CreatedAt:      timestamppb.New(result.Stats.CreatedAt),
+				UpdatedAt:      timestamppb.New(result.Stats.UpdatedAt),
+				LastAccessedAt: accessedAt(result.Stats.AccessedAt),
+				AccessCount:    result.Stats.AccessCount,
+				Id:             result.ID,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := io.ReadFull(content, buf)
+		if n > 0 {
+			err := stream.Send(&pb.BinaryDownloadResponse{
+				Payload: &pb.BinaryDownloadResponse_Chunk{
+					Chunk: buf[:n],
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return status.Error(codes.Internal, "Internal server error.")
+		}
+	}
+}
+
+// History lists the archived revisions of a binary by title, newest first.
+// Revisions of a deleted binary are listed as well.
+// Possible errors:
+// - Internal server error if any issue occurs during processing.
+func (h *BinariesHandler) History(ctx context.Context, in *pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.History(ctx, in.Title, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return newHistoryResponse(result), nil
+}
+
+// Restore brings back an archived revision of a binary, archiving the current version in turn.
+// A deleted binary is added again.
+// Possible errors:
+// - ErrRevisionNotFound: If the title has no such revision, for example because it was pruned.
+// - ErrRecordReplaced: If a revision of a vault account belongs to a record that was replaced.
+// - Internal server error if any other issue occurs during processing.
+func (h *BinariesHandler) Restore(ctx context.Context, in *pb.RestoreRequest) (*pb.BinariesShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Restore(ctx, in.Title, userID, int(in.Revision))
+	if err != nil {
+		if errors.Is(err, services.ErrRevisionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "revision %d of '%s' was not found.", in.Revision, in.Title)
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.BinariesShortResponse{
+		Title: result,
+	}, nil
 }
@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"main/internal/server/interfaces"
 	"main/internal/server/models"
 	"main/internal/server/services"
@@ -28,10 +29,10 @@ func NewCardsHandler(s interfaces.CardsService, j interfaces.JWTService) *CardsH
 	}
 }
 
-// Get retrieves a credit card by title and user ID.
+// Get retrieves a credit card by title and user ID, with its number masked and without its security code.
 // It extracts the user ID from the context and passes control to the CardsService.
 // Possible errors:
-// - ErrCardNotFound: If no password matches the given title and user ID.
+// - ErrCardNotFound: If no card matches the given title and user ID.
 // - Internal server error if any other issue occurs during processing.
 func (h *CardsHandler) Get(ctx context.Context, in *pb.CardRequest) (*pb.CardResponse, error) {
 	userID := ctx.Value("userID").(int64)
@@ -43,32 +44,48 @@ func (h *CardsHandler) Get(ctx context.Context, in *pb.CardRequest) (*pb.CardRes
 		}
 		return nil, status.Error(codes.Internal, "Internal server error.")
 	}
+	return newCardResponse(result), nil
+}
 
-	return &pb.CardResponse{
-		Id:         result.ID,
-		Title:      result.Title,
-		Bank:       string(result.Bank),
-		Number:     string(result.Number),
-		DataEnd:    string(result.DataEnd),
-		SecretCode: string(result.SecretCode),
-	}, nil
+// Reveal retrieves a credit card by title and user ID with its full number and security code.
+// Every reveal is recorded and shows in the reveal count of the card.
+// Possible errors:
+// - ErrCardNotFound: If no card matches the given title and user ID.
+// - Internal server error if any other issue occurs during processing.
+func (h *CardsHandler) Reveal(ctx context.Context, in *pb.CardRequest) (*pb.CardResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Reveal(ctx, in.Title, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrCardNotFound) {
+			return nil, status.Errorf(codes.NotFound, "card with title '%s' was not found.", in.Title)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return newCardResponse(result), nil
 }
 
 // Add creates a new credit card entry.
 // It populates a Card model and invokes the CardsService to perform the insertion.
 // Possible errors:
 // - ErrCardAlreadyExists: If a password with the same title already exists for this user.
+// - ErrInvalidCard: If the number, expiry date or security code is malformed.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - ErrInvalidReservation: If the given record ID was not reserved for a new record of the user.
 // - Internal server error if any other issue occurs during processing.
 func (h *CardsHandler) Add(ctx context.Context, in *pb.CardCreateRequest) (*pb.CardShortResponse, error) {
 	userID := ctx.Value("userID").(int64)
 
 	cond := models.Card{
+		ID:         in.Id,
 		UserID:     userID,
 		Title:      in.Title,
 		Bank:       []byte(in.Bank),
 		Number:     []byte(in.Number),
 		DataEnd:    []byte(in.DataEnd),
 		SecretCode: []byte(in.SecretCode),
+		Brand:      in.Brand,
+		Placement:  newPlacement(in.Placement),
 	}
 
 	result, err := h.s.Add(ctx, cond)
@@ -76,6 +93,15 @@ func (h *CardsHandler) Add(ctx context.Context, in *pb.CardCreateRequest) (*pb.C
 		if errors.Is(err, services.ErrCardAlreadyExists) {
 			return nil, status.Errorf(codes.AlreadyExists, "A card with title '%s' already exists.", in.Title)
 		}
+		if errors.Is(err, services.ErrInvalidCard) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s.", err)
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
 		return nil, status.Error(codes.Internal, "Internal server error.")
 	}
 
@@ -87,21 +113,40 @@ func (h *CardsHandler) Add(ctx context.Context, in *pb.CardCreateRequest) (*pb.C
 // Update modifies an existing credit card entry.
 // It prepares a Card model and triggers the CardsService to execute the update.
 // Possible errors:
+// - ErrCardNotFound: If no card matches the given title and user ID.
+// - ErrInvalidCard: If the number, expiry date or security code is malformed.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - ErrRecordReplaced: If the given record ID is no longer that of the record with the title.
 // - Internal server error if any issue occurs during processing.
 func (h *CardsHandler) Update(ctx context.Context, in *pb.CardUpdateRequest) (*pb.CardShortResponse, error) {
 	userID := ctx.Value("userID").(int64)
 
 	cond := models.Card{
+		ID:         in.Id,
 		UserID:     userID,
 		Title:      in.Title,
 		Bank:       []byte(in.Bank),
 		Number:     []byte(in.Number),
 		DataEnd:    []byte(in.DataEnd),
 		SecretCode: []byte(in.SecretCode),
+		Brand:      in.Brand,
+		Placement:  newPlacementUpdate(in.Placement, in.ReplaceFolder, in.ReplaceTags),
 	}
 
 	result, err := h.s.Update(ctx, cond)
 	if err != nil {
+		if errors.Is(err, services.ErrCardNotFound) {
+			return nil, status.Errorf(codes.NotFound, "card with title '%s' was not found.", in.Title)
+		}
+		if errors.Is(err, services.ErrInvalidCard) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s.", err)
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
 		return nil, err
 	}
 
@@ -110,16 +155,157 @@ func (h *CardsHandler) Update(ctx context.Context, in *pb.CardUpdateRequest) (*p
 	}, nil
 }
 
-// Delete removes a credit card entry by title and user ID.
+// Delete moves a credit card entry to the trash by title and user ID.
 // It extracts the user ID from the context and forwards the removal request to the CardsService.
 // Possible errors:
-// - Internal server error if any issue occurs during processing.
+// - ErrCardNotFound: If no card matches the given title and user ID.
+// - Internal server error if any other issue occurs during processing.
 func (h *CardsHandler) Delete(ctx context.Context, in *pb.CardRequest) (*emptypb.Empty, error) {
 	userID := ctx.Value("userID").(int64)
 
 	err := h.s.Delete(ctx, in.Title, userID)
 	if err != nil {
-		return nil, err
+		if errors.Is(err, services.ErrCardNotFound) {
+			return nil, status.Errorf(codes.NotFound, "card with title '%s' was not found.", in.Title)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Rename gives a card found by its ID or, if the ID is zero, by its title a new title.
+// Possible errors:
+// - ErrCardNotFound: If the user has no such card.
+// - ErrCardAlreadyExists: If another card of the user already has the new title.
+// - ErrInvalidTitle: If the new title is blank or too long.
+// - Internal server error if any other issue occurs during processing.
+func (h *CardsHandler) Rename(ctx context.Context, in *pb.RenameRequest) (*pb.CardShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Rename(ctx, newRename(in, userID))
+	if err != nil {
+		if errors.Is(err, services.ErrCardNotFound) {
+			return nil, status.Errorf(codes.NotFound, "card %s was not found.", renamed(in))
+		}
+		if errors.Is(err, services.ErrCardAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "A card with title '%s' already exists.", in.NewTitle)
+		}
+		if errors.Is(err, services.ErrInvalidTitle) {
+			return nil, status.Errorf(codes.InvalidArgument, "Title must be 1 to %d characters long.", services.MaxTitleLen)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.CardShortResponse{
+		Id:             result.ID,
+		Title:          result.Title,
+		CreatedAt:      timestamppb.New(result.Stats.CreatedAt),
+		UpdatedAt:      timestamppb.New(result.Stats.UpdatedAt),
+		LastAccessedAt: accessedAt(result.Stats.AccessedAt),
+		AccessCount:    result.Stats.AccessCount,
+		Brand:          result.Label,
+	}, nil
+}
+
+// List returns a page of credit card entries owned by the user.
+// Entries can be filtered by a title prefix or by the time they were last changed or read,
+// and ordered by title or by the time they were created, last changed or last read.
+// Possible errors:
+// - ErrInvalidCursor: If the supplied cursor is malformed or was issued for another ordering.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - Internal server error if any other issue occurs during processing.
+func (h *CardsHandler) List(ctx context.Context, in *pb.ListRequest) (*pb.CardListResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.List(ctx, newListQuery(userID, in))
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, "Invalid list cursor.")
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	items := make([]*pb.CardShortResponse, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, &pb.CardShortResponse{
+			Id:             item.ID,
+			Title:          item.Title,
+			CreatedAt:      timestamppb.New(item.Stats.CreatedAt),
+			UpdatedAt:      timestamppb.New(item.Stats.UpdatedAt),
+			LastAccessedAt: accessedAt(item.Stats.AccessedAt),
+			AccessCount:    item.Stats.AccessCount,
+			Brand:          item.Label,
+		})
+	}
+
+	return &pb.CardListResponse{
+		Items:      items,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// History lists the archived revisions of a card by title, newest first.
+// Revisions of a deleted card are listed as well.
+// Possible errors:
+// - Internal server error if any issue occurs during processing.
+func (h *CardsHandler) History(ctx context.Context, in *pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.History(ctx, in.Title, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return newHistoryResponse(result), nil
+}
+
+// Restore brings back an archived revision of a card, archiving the current version in turn.
+// A deleted card is added again.
+// Possible errors:
+// - ErrRevisionNotFound: If the title has no such revision, for example because it was pruned.
+// - ErrInvalidCard: If the revision holds a malformed number, expiry date or security code.
+// - ErrRecordReplaced: If a revision of a vault account belongs to a record that was replaced.
+// - Internal server error if any other issue occurs during processing.
+func (h *CardsHandler) Restore(ctx context.Context, in *pb.RestoreRequest) (*pb.CardShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Restore(ctx, in.Title, userID, int(in.Revision))
+	if err != nil {
+		if errors.Is(err, services.ErrRevisionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "revision %d of '%s' was not found.", in.Revision, in.Title)
+		}
+		if errors.Is(err, services.ErrInvalidCard) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s.", err)
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.CardShortResponse{
+		Title: result,
+	}, nil
+}
+
+// newCardResponse translates a credit card along with its placement and its access and reveal statistics.
+func newCardResponse(result *models.Card) *pb.CardResponse {
+	return &pb.CardResponse{
+		Id:             result.ID,
+		Title:          result.Title,
+		Bank:           string(result.Bank),
+		Number:         string(result.Number),
+		DataEnd:        string(result.DataEnd),
+		SecretCode:     string(result.SecretCode),
+		Placement:      newPlacementResponse(result.Placement),
+		CreatedAt:      timestamppb.New(result.Stats.CreatedAt),
+		UpdatedAt:      timestamppb.New(result.Stats.UpdatedAt),
+		LastAccessedAt: accessedAt(result.Stats.AccessedAt),
+		AccessCount:    result.Stats.AccessCount,
+		Brand:          result.Brand,
+		RevealCount:    result.Reveals,
+		LastRevealedAt: accessedAt(result.RevealedAt),
 	}
-	return nil, nil
 }
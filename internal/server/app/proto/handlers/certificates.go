@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"main/internal/server/auth"
+	"main/internal/server/services"
+	pb "main/proto"
+)
+
+// BindCertificate binds the client certificate of the current mutual TLS connection to the user.
+// Afterwards calls presenting the certificate are authenticated without an access token, under a session
+// of their own, until the session expires or is revoked. Users with a second factor have to pass a code.
+// Possible errors:
+// - The connection was not verified with a client certificate.
+// - ErrInvalidSecondFactor: The user has a second factor and the code is missing, wrong or already used.
+// - ErrCertificateAlreadyBound: The certificate subject is already bound to a user.
+// - Internal server error if the binding cannot be stored.
+func (h *UsersHandler) BindCertificate(ctx context.Context, in *pb.CertificateBindRequest) (*pb.CertificateResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	subject, ok := auth.CertificateSubject(ctx)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "Connection has no verified client certificate.")
+	}
+
+	sessionID, err := h.cs.Bind(ctx, newSession(ctx, userID), subject, in.Code)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidSecondFactor) {
+			return nil, status.Error(codes.InvalidArgument, "A valid two-factor code is required.")
+		}
+		if errors.Is(err, services.ErrCertificateAlreadyBound) {
+			return nil, status.Errorf(codes.AlreadyExists, "Certificate '%s' is already bound.", subject)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return &pb.CertificateResponse{Subject: subject, SessionId: sessionID}, nil
+}
+
+// UnbindCertificate removes the binding of a client certificate of the user and revokes its session.
+// Without a subject the certificate of the current connection is unbound.
+// Possible errors:
+// - No subject was given and the connection was not verified with a client certificate.
+// - ErrCertificateNotBound: The certificate subject is not bound to the user.
+// - Internal server error if the binding cannot be removed.
+func (h *UsersHandler) UnbindCertificate(ctx context.Context, in *pb.CertificateRequest) (*pb.CertificateResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	subject := in.Subject
+	if subject == "" {
+		var ok bool
+		if subject, ok = auth.CertificateSubject(ctx); !ok {
+			return nil, status.Error(codes.FailedPrecondition, "Connection has no verified client certificate.")
+		}
+	}
+
+	err := h.cs.Unbind(ctx, userID, subject)
+	if err != nil {
+		if errors.Is(err, services.ErrCertificateNotBound) {
+			return nil, status.Errorf(codes.NotFound, "Certificate '%s' is not bound.", subject)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return &pb.CertificateResponse{Subject: subject}, nil
+}
+
+// ListCertificates returns the client certificates bound to the user whose sessions are still active.
+// Possible errors:
+// - Internal server error if the bindings cannot be read.
+func (h *UsersHandler) ListCertificates(ctx context.Context, _ *emptypb.Empty) (*pb.CertificateListResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.cs.List(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	items := make([]*pb.Certificate, 0, len(result))
+	for _, c := range result {
+		items = append(items, &pb.Certificate{
+			Subject:   c.Subject,
+			SessionId: c.SessionID,
+			CreatedAt: timestamppb.New(c.CreatedAt),
+			ExpiresAt: timestamppb.New(c.ExpiresAt),
+		})
+	}
+	return &pb.CertificateListResponse{Items: items}, nil
+}
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+	"main/internal/server/services"
+	pb "main/proto"
+)
+
+// FoldersHandler implements the gRPC service definition for the folder tree items are placed in.
+// It delegates requests to the underlying FoldersService.
+type FoldersHandler struct {
+	pb.UnimplementedFoldersServer                           // Base implementation for protobuf-defined gRPC server.
+	s                             interfaces.FoldersService // Service managing folders.
+}
+
+// NewFoldersHandler creates a new instance of FoldersHandler with injected dependencies.
+func NewFoldersHandler(s interfaces.FoldersService) *FoldersHandler {
+	return &FoldersHandler{
+		s: s,
+	}
+}
+
+// Create creates a folder along with its missing ancestors.
+// Possible errors:
+// - ErrInvalidFolder: If the path is empty or has empty or overlong names.
+// - ErrFolderAlreadyExists: If the folder exists already.
+// - Internal server error if any other issue occurs during processing.
+func (h *FoldersHandler) Create(ctx context.Context, in *pb.FolderRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value("userID").(int64)
+
+	err := h.s.Create(ctx, userID, in.Path)
+	if err != nil {
+		if errors.Is(err, services.ErrFolderAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "Folder '%s' already exists.", in.Path)
+		}
+		return nil, folderError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Move places a folder under another one, or at the root, optionally renaming it.
+// Possible errors:
+// - ErrFolderNotFound: If the folder or the new parent does not exist.
+// - ErrInvalidFolder, ErrFolderCycle: If a path is malformed or the folder would be moved into its own subtree.
+// - ErrFolderAlreadyExists: If the new parent already holds a folder of that name.
+// - Internal server error if any other issue occurs during processing.
+func (h *FoldersHandler) Move(ctx context.Context, in *pb.FolderMoveRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value("userID").(int64)
+
+	err := h.s.Move(ctx, userID, in.Path, in.Parent, in.Name)
+	if err != nil {
+		if errors.Is(err, services.ErrFolderAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, "A folder of that name already exists there.")
+		}
+		return nil, folderError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Remove removes an empty folder.
+// Possible errors:
+// - ErrFolderNotFound: If the folder does not exist.
+// - ErrFolderNotEmpty: If the folder still holds subfolders or items.
+// - Internal server error if any other issue occurs during processing.
+func (h *FoldersHandler) Remove(ctx context.Context, in *pb.FolderRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value("userID").(int64)
+
+	err := h.s.Remove(ctx, userID, in.Path)
+	if err != nil {
+		if errors.Is(err, services.ErrFolderNotEmpty) {
+			return nil, status.Errorf(codes.FailedPrecondition, "Folder '%s' is not empty.", in.Path)
+		}
+		return nil, folderError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// List returns the paths of all folders of the user in alphabetical order.
+// Possible errors:
+// - Internal server error if any issue occurs during processing.
+func (h *FoldersHandler) List(ctx context.Context, _ *emptypb.Empty) (*pb.FolderListResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.List(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.FolderListResponse{
+		Paths: result,
+	}, nil
+}
+
+// Place moves a record of the requested kind and title to a folder and retags it without touching its content.
+// Possible errors:
+// - ErrItemNotFound: If the user has no such live record.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - Internal server error if any other issue occurs during processing.
+func (h *FoldersHandler) Place(ctx context.Context, in *pb.PlaceRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value("userID").(int64)
+
+	kind, ok := kindFromPB(in.Kind)
+	if !ok || kind == "" || in.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "Record kind and title are required.")
+	}
+
+	err := h.s.Place(ctx, userID, kind, in.Title, newPlacementUpdate(in.Placement, in.ReplaceFolder, in.ReplaceTags))
+	if err != nil {
+		if errors.Is(err, services.ErrItemNotFound) {
+			return nil, status.Errorf(codes.NotFound, "'%s' was not found.", in.Title)
+		}
+		return nil, folderError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// folderError translates errors resolving folders and tags into gRPC status errors,
+// falling back to an internal error.
+func folderError(err error) error {
+	if st := placementError(err); st != nil {
+		return st
+	}
+	return status.Error(codes.Internal, "Internal server error.")
+}
+
+// placementError translates errors resolving the folder and tags of an item or a listing into gRPC status errors.
+// It returns nil for any other error.
+func placementError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrFolderNotFound):
+		return status.Error(codes.NotFound, "Folder was not found.")
+	case errors.Is(err, services.ErrInvalidFolder), errors.Is(err, services.ErrFolderCycle), errors.Is(err, services.ErrInvalidTag):
+		return status.Errorf(codes.InvalidArgument, "%s.", err)
+	default:
+		return nil
+	}
+}
+
+// newPlacement translates the placement of a new item; the item is placed at the root without tags if it is nil.
+func newPlacement(in *pb.Placement) models.Placement {
+	return newPlacementUpdate(in, true, true)
+}
+
+// newPlacementUpdate translates the placement of an updated item, which keeps the parts it is not asked to replace.
+func newPlacementUpdate(in *pb.Placement, replaceFolder, replaceTags bool) models.Placement {
+	return models.Placement{
+		Folder:        in.GetFolder(),
+		Tags:          in.GetTags(),
+		ReplaceFolder: replaceFolder,
+		ReplaceTags:   replaceTags,
+	}
+}
+
+// newPlacementResponse translates the placement of a stored item.
+func newPlacementResponse(p models.Placement) *pb.Placement {
+	return &pb.Placement{
+		Folder: p.Folder,
+		Tags:   p.Tags,
+	}
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"main/internal/passgen"
+	"main/internal/server/services"
+	pb "main/proto"
+)
+
+// newGeneratePolicy translates a protobuf generator request into a policy, taking the zero values from its preset.
+func newGeneratePolicy(in *pb.GenerateRequest) (passgen.Policy, error) {
+	return passgen.Resolve(in.Preset, passgen.Policy{
+		Length:           int(in.Length),
+		Classes:          in.Classes,
+		ExcludeAmbiguous: in.ExcludeAmbiguous,
+		Words:            int(in.Words),
+		Separator:        in.Separator,
+	})
+}
+
+// newGenerate translates the optional generator request of a written password entry; nil keeps its password.
+func newGenerate(in *pb.GenerateRequest) (*passgen.Policy, error) {
+	if in == nil {
+		return nil, nil
+	}
+	policy, err := newGeneratePolicy(in)
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// generateError translates errors generating a password into gRPC status errors.
+// It returns nil for any other error.
+func generateError(err error) error {
+	switch {
+	case errors.Is(err, passgen.ErrUnknownPreset), errors.Is(err, passgen.ErrInvalidPolicy):
+		return status.Errorf(codes.InvalidArgument, "%s.", err)
+	case errors.Is(err, services.ErrGenerateClientSide):
+		return status.Error(codes.FailedPrecondition, "Passwords of zero-knowledge vault accounts are generated by the client.")
+	default:
+		return nil
+	}
+}
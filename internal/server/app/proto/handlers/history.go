@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"main/internal/server/models"
+	pb "main/proto"
+)
+
+// newHistoryResponse converts archived revisions into their protobuf form, keeping their order.
+func newHistoryResponse(revisions []models.Revision) *pb.HistoryResponse {
+	items := make([]*pb.Revision, 0, len(revisions))
+	for _, r := range revisions {
+		items = append(items, &pb.Revision{
+			Revision:   int32(r.Revision),
+			Deleted:    r.Deleted,
+			ArchivedAt: timestamppb.New(r.ArchivedAt),
+		})
+	}
+
+	return &pb.HistoryResponse{
+		Items: items,
+	}
+}
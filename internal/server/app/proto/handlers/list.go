@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"main/internal/server/models"
+	pb "main/proto"
+	"time"
+)
+
+// newListQuery translates a protobuf listing request into the service-level query for the given user.
+func newListQuery(userID int64, in *pb.ListRequest) models.ListQuery {
+	sortBy := models.SortByTitle
+	switch in.SortBy {
+	case pb.SortField_SORT_FIELD_DATE:
+		sortBy = models.SortByDate
+	case pb.SortField_SORT_FIELD_UPDATED:
+		sortBy = models.SortByUpdated
+	case pb.SortField_SORT_FIELD_ACCESSED:
+		sortBy = models.SortByAccessed
+	}
+
+	return models.ListQuery{
+		UserID:         userID,
+		TitlePrefix:    in.TitlePrefix,
+		Folder:         in.Folder,
+		Tags:           in.Tags,
+		UpdatedBefore:  timeOf(in.UpdatedBefore),
+		AccessedBefore: timeOf(in.AccessedBefore),
+		SortBy:         sortBy,
+		Descending:     in.Descending,
+		PageSize:       int(in.PageSize),
+		Cursor:         in.Cursor,
+	}
+}
+
+// timeOf converts an optional protobuf timestamp, returning the zero time if it is unset.
+func timeOf(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// accessedAt converts the last access or reveal time of a record, leaving it unset if the record never had one.
+func accessedAt(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+	"main/internal/server/services"
+	pb "main/proto"
+)
+
+// NotesHandler implements the gRPC service definition for managing secure notes.
+// It delegates requests to the underlying NotesService for actual business logic execution.
+type NotesHandler struct {
+	pb.UnimplementedNotesServer                         // Base implementation for protobuf-defined gRPC server.
+	s                           interfaces.NotesService // Service for handling note operations.
+	j                           interfaces.JWTService   // JWT service for authentication purposes.
+}
+
+// NewNotesHandler creates a new instance of NotesHandler with injected dependencies.
+func NewNotesHandler(s interfaces.NotesService, j interfaces.JWTService) *NotesHandler {
+	return &NotesHandler{
+		s: s,
+		j: j,
+	}
+}
+
+// Get retrieves a note by title and user ID.
+// It extracts the user ID from the context and passes control to the NotesService.
+// Possible errors:
+// - ErrNoteNotFound: If no note matches the given title and user ID.
+// - Internal server error if any other issue occurs during processing.
+func (h *NotesHandler) Get(ctx context.Context, in *pb.NoteRequest) (*pb.NoteResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Get(ctx, in.Title, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrNoteNotFound) {
+			return nil, status.Errorf(codes.NotFound, "note with title '%s' was not found.", in.Title)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.NoteResponse{
+		Id:             result.ID,
+		Title:          result.Title,
+		Body:           string(result.Body),
+		Placement:      newPlacementResponse(result.Placement),
+		CreatedAt:      timestamppb.New(result.Stats.CreatedAt),
+		UpdatedAt:      timestamppb.New(result.Stats.UpdatedAt),
+		LastAccessedAt: accessedAt(result.Stats.AccessedAt),
+		AccessCount:    result.Stats.AccessCount,
+	}, nil
+}
+
+// Add creates a new note.
+// It populates a Note model and invokes the NotesService to perform the insertion.
+// Possible errors:
+// - ErrNoteAlreadyExists: If a note with the same title already exists for this user.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - ErrInvalidReservation: If the given record ID was not reserved for a new record of the user.
+// - Internal server error if any other issue occurs during processing.
+func (h *NotesHandler) Add(ctx context.Context, in *pb.NoteCreateRequest) (*pb.NoteShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	cond := models.Note{
+		ID:        in.Id,
+		UserID:    userID,
+		Title:     in.Title,
+		Body:      []byte(in.Body),
+		Placement: newPlacement(in.Placement),
+	}
+
+	result, err := h.s.Add(ctx, cond)
+	if err != nil {
+		if errors.Is(err, services.ErrNoteAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "A note with title '%s' already exists.", in.Title)
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.NoteShortResponse{
+		Title: result,
+	}, nil
+}
+
+// Update modifies an existing note.
+// It prepares a Note model and triggers the NotesService to execute the update.
+// Possible errors:
+// - ErrNoteNotFound: If no note matches the given title and user ID.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - ErrRecordReplaced: If the given record ID is no longer that of the record with the title.
+// - Internal server error if any issue occurs during processing.
+func (h *NotesHandler) Update(ctx context.Context, in *pb.NoteUpdateRequest) (*pb.NoteShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	cond := models.Note{
+		ID:        in.Id,
+		UserID:    userID,
+		Title:     in.Title,
+		Body:      []byte(in.Body),
+		Placement: newPlacementUpdate(in.Placement, in.ReplaceFolder, in.ReplaceTags),
+	}
+
+	result, err := h.s.Update(ctx, cond)
+	if err != nil {
+		if errors.Is(err, services.ErrNoteNotFound) {
+			return nil, status.Errorf(codes.NotFound, "note with title '%s' was not found.", in.Title)
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.NoteShortResponse{
+		Title: result,
+	}, nil
+}
+
+// Delete moves a note to the trash by title and user ID.
+// It extracts the user ID from the context and forwards the removal request to the NotesService.
+// Possible errors:
+// - ErrNoteNotFound: If no note matches the given title and user ID.
+// - Internal server error if any other issue occurs during processing.
+func (h *NotesHandler) Delete(ctx context.Context, in *pb.NoteRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value("userID").(int64)
+
+	err := h.s.Delete(ctx, in.Title, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrNoteNotFound) {
+			return nil, status.Errorf(codes.NotFound, "note with title '%s' was not found.", in.Title)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Rename gives a note found by its ID or, if the ID is zero, by its title a new title.
+// Possible errors:
+// - ErrNoteNotFound: If the user has no such note.
+// - ErrNoteAlreadyExists: If another note of the user already has the new title.
+// - ErrInvalidTitle: If the new title is blank or too long.
+// - Internal server error if any other issue occurs during processing.
+func (h *NotesHandler) Rename(ctx context.Context, in *pb.RenameRequest) (*pb.NoteShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Rename(ctx, newRename(in, userID))
+	if err != nil {
+		if errors.Is(err, services.ErrNoteNotFound) {
+			return nil, status.Errorf(codes.NotFound, "note %s was not found.", renamed(in))
+		}
+		if errors.Is(err, services.ErrNoteAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "A note with title '%s' already exists.", in.NewTitle)
+		}
+		if errors.Is(err, services.ErrInvalidTitle) {
+			return nil, status.Errorf(codes.InvalidArgument, "Title must be 1 to %d characters long.", services.MaxTitleLen)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.NoteShortResponse{
+		Id:             result.ID,
+		Title:          result.Title,
+		CreatedAt:      timestamppb.New(result.Stats.CreatedAt),
+		UpdatedAt:      timestamppb.New(result.Stats.UpdatedAt),
+		LastAccessedAt: accessedAt(result.Stats.AccessedAt),
+		AccessCount:    result.Stats.AccessCount,
+	}, nil
+}
+
+// List returns a page of notes owned by the user.
+// Notes can be filtered by a title prefix or by the time they were last changed or read,
+// and ordered by title or by the time they were created, last changed or last read.
+// Possible errors:
+// - ErrInvalidCursor: If the supplied cursor is malformed or was issued for another ordering.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - Internal server error if any other issue occurs during processing.
+func (h *NotesHandler) List(ctx context.Context, in *pb.ListRequest) (*pb.NoteListResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.List(ctx, newListQuery(userID, in))
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, "Invalid list cursor.")
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	items := make([]*pb.NoteShortResponse, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, &pb.NoteShortResponse{
+			Id:             item.ID,
+			Title:          item.Title,
+			CreatedAt:      timestamppb.New(item.Stats.CreatedAt),
+			UpdatedAt:      timestamppb.New(item.Stats.UpdatedAt),
+			LastAccessedAt: accessedAt(item.Stats.AccessedAt),
+			AccessCount:    item.Stats.AccessCount,
+		})
+	}
+
+	return &pb.NoteListResponse{
+		Items:      items,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// History lists the archived revisions of a note by title, newest first.
+// Revisions of a deleted note are listed as well.
+// Possible errors:
+// - Internal server error if any issue occurs during processing.
+func (h *NotesHandler) History(ctx context.Context, in *pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.History(ctx, in.Title, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return newHistoryResponse(result), nil
+}
+
+// Restore brings back an archived revision of a note, archiving the current version in turn.
+// A deleted note is added again.
+// Possible errors:
+// - ErrRevisionNotFound: If the title has no such revision, for example because it was pruned.
+// - ErrRecordReplaced: If a revision of a vault account belongs to a record that was replaced.
+// - Internal server error if any other issue occurs during processing.
+func (h *NotesHandler) Restore(ctx context.Context, in *pb.RestoreRequest) (*pb.NoteShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Restore(ctx, in.Title, userID, int(in.Revision))
+	if err != nil {
+		if errors.Is(err, services.ErrRevisionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "revision %d of '%s' was not found.", in.Revision, in.Title)
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.NoteShortResponse{
+		Title: result,
+	}, nil
+}
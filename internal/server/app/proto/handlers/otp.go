@@ -0,0 +1,332 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+	"main/internal/server/services"
+	pb "main/proto"
+	"time"
+)
+
+// OTPHandler implements the gRPC service definition for managing authenticator secrets.
+// It delegates requests to the underlying OTPService for actual business logic execution.
+type OTPHandler struct {
+	pb.UnimplementedOTPServer                       // Base implementation for protobuf-defined gRPC server.
+	s                         interfaces.OTPService // Service for handling OTP operations.
+	j                         interfaces.JWTService // JWT service for authentication purposes.
+}
+
+// NewOTPHandler creates a new instance of OTPHandler with injected dependencies.
+func NewOTPHandler(s interfaces.OTPService, j interfaces.JWTService) *OTPHandler {
+	return &OTPHandler{
+		s: s,
+		j: j,
+	}
+}
+
+// Get retrieves an authenticator secret by title and user ID.
+// It extracts the user ID from the context and passes control to the OTPService.
+// Possible errors:
+// - ErrOTPNotFound: If no authenticator secret matches the given title and user ID.
+// - Internal server error if any other issue occurs during processing.
+func (h *OTPHandler) Get(ctx context.Context, in *pb.OTPRequest) (*pb.OTPResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Get(ctx, in.Title, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrOTPNotFound) {
+			return nil, status.Errorf(codes.NotFound, "authenticator secret with title '%s' was not found.", in.Title)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.OTPResponse{
+		Id:             result.ID,
+		Title:          result.Title,
+		Issuer:         string(result.Issuer),
+		Account:        string(result.Account),
+		Secret:         string(result.Secret),
+		Algorithm:      result.Algorithm,
+		Digits:         int32(result.Digits),
+		Period:         int32(result.Period),
+		Password:       result.PasswordTitle,
+		Placement:      newPlacementResponse(result.Placement),
+		CreatedAt:      timestamppb.New(result.Stats.CreatedAt),
+		UpdatedAt:      timestamppb.New(result.Stats.UpdatedAt),
+		LastAccessedAt: accessedAt(result.Stats.AccessedAt),
+		AccessCount:    result.Stats.AccessCount,
+	}, nil
+}
+
+// Add creates a new authenticator secret.
+// It populates an OTP model and invokes the OTPService to perform the insertion.
+// Possible errors:
+// - ErrOTPAlreadyExists: If an authenticator secret with the same title already exists for this user.
+// - ErrInvalidOTP: If the secret is not base32 or the code parameters are not supported.
+// - ErrLinkedPasswordNotFound: If the password to link to does not exist.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - ErrInvalidReservation: If the given record ID was not reserved for a new record of the user.
+// - Internal server error if any other issue occurs during processing.
+func (h *OTPHandler) Add(ctx context.Context, in *pb.OTPCreateRequest) (*pb.OTPShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	cond := models.OTP{
+		ID:            in.Id,
+		UserID:        userID,
+		Title:         in.Title,
+		Issuer:        []byte(in.Issuer),
+		Account:       []byte(in.Account),
+		Secret:        []byte(in.Secret),
+		Algorithm:     in.Algorithm,
+		Digits:        int(in.Digits),
+		Period:        int(in.Period),
+		PasswordTitle: in.Password,
+		Placement:     newPlacement(in.Placement),
+	}
+
+	result, err := h.s.Add(ctx, cond)
+	if err != nil {
+		if errors.Is(err, services.ErrOTPAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "An authenticator secret with title '%s' already exists.", in.Title)
+		}
+		if st := otpInputError(err, in.Password); st != nil {
+			return nil, st
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.OTPShortResponse{
+		Title: result,
+	}, nil
+}
+
+// Update modifies an existing authenticator secret.
+// It prepares an OTP model and triggers the OTPService to execute the update.
+// Possible errors:
+// - ErrOTPNotFound: If no authenticator secret matches the given title and user ID.
+// - ErrInvalidOTP: If the secret is not base32 or the code parameters are not supported.
+// - ErrLinkedPasswordNotFound: If the password to link to does not exist.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - ErrRecordReplaced: If the given record ID is no longer that of the record with the title.
+// - Internal server error if any issue occurs during processing.
+func (h *OTPHandler) Update(ctx context.Context, in *pb.OTPUpdateRequest) (*pb.OTPShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	cond := models.OTP{
+		ID:            in.Id,
+		UserID:        userID,
+		Title:         in.Title,
+		Issuer:        []byte(in.Issuer),
+		Account:       []byte(in.Account),
+		Secret:        []byte(in.Secret),
+		Algorithm:     in.Algorithm,
+		Digits:        int(in.Digits),
+		Period:        int(in.Period),
+		PasswordTitle: in.Password,
+		Placement:     newPlacementUpdate(in.Placement, in.ReplaceFolder, in.ReplaceTags),
+	}
+
+	result, err := h.s.Update(ctx, cond)
+	if err != nil {
+		if errors.Is(err, services.ErrOTPNotFound) {
+			return nil, status.Errorf(codes.NotFound, "authenticator secret with title '%s' was not found.", in.Title)
+		}
+		if st := otpInputError(err, in.Password); st != nil {
+			return nil, st
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.OTPShortResponse{
+		Title: result,
+	}, nil
+}
+
+// Delete moves an authenticator secret to the trash by title and user ID.
+// It extracts the user ID from the context and forwards the removal request to the OTPService.
+// Possible errors:
+// - ErrOTPNotFound: If no authenticator secret matches the given title and user ID.
+// - Internal server error if any other issue occurs during processing.
+func (h *OTPHandler) Delete(ctx context.Context, in *pb.OTPRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value("userID").(int64)
+
+	err := h.s.Delete(ctx, in.Title, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrOTPNotFound) {
+			return nil, status.Errorf(codes.NotFound, "authenticator secret with title '%s' was not found.", in.Title)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Rename gives an authenticator secret found by its ID or, if the ID is zero, by its title a new title.
+// Possible errors:
+// - ErrOTPNotFound: If the user has no such authenticator secret.
+// - ErrOTPAlreadyExists: If another authenticator secret of the user already has the new title.
+// - ErrInvalidTitle: If the new title is blank or too long.
+// - Internal server error if any other issue occurs during processing.
+func (h *OTPHandler) Rename(ctx context.Context, in *pb.RenameRequest) (*pb.OTPShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Rename(ctx, newRename(in, userID))
+	if err != nil {
+		if errors.Is(err, services.ErrOTPNotFound) {
+			return nil, status.Errorf(codes.NotFound, "authenticator secret %s was not found.", renamed(in))
+		}
+		if errors.Is(err, services.ErrOTPAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "An authenticator secret with title '%s' already exists.", in.NewTitle)
+		}
+		if errors.Is(err, services.ErrInvalidTitle) {
+			return nil, status.Errorf(codes.InvalidArgument, "Title must be 1 to %d characters long.", services.MaxTitleLen)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.OTPShortResponse{
+		Id:             result.ID,
+		Title:          result.Title,
+		CreatedAt:      timestamppb.New(result.Stats.CreatedAt),
+		UpdatedAt:      timestamppb.New(result.Stats.UpdatedAt),
+		LastAccessedAt: accessedAt(result.Stats.AccessedAt),
+		AccessCount:    result.Stats.AccessCount,
+	}, nil
+}
+
+// List returns a page of authenticator secrets owned by the user.
+// Secrets can be filtered by a title prefix or by the time they were last changed or read,
+// and ordered by title or by the time they were created, last changed or last read.
+// Possible errors:
+// - ErrInvalidCursor: If the supplied cursor is malformed or was issued for another ordering.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - Internal server error if any other issue occurs during processing.
+func (h *OTPHandler) List(ctx context.Context, in *pb.ListRequest) (*pb.OTPListResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.List(ctx, newListQuery(userID, in))
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, "Invalid list cursor.")
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	items := make([]*pb.OTPShortResponse, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, &pb.OTPShortResponse{
+			Id:             item.ID,
+			Title:          item.Title,
+			CreatedAt:      timestamppb.New(item.Stats.CreatedAt),
+			UpdatedAt:      timestamppb.New(item.Stats.UpdatedAt),
+			LastAccessedAt: accessedAt(item.Stats.AccessedAt),
+			AccessCount:    item.Stats.AccessCount,
+		})
+	}
+
+	return &pb.OTPListResponse{
+		Items:      items,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// History lists the archived revisions of an authenticator secret by title, newest first.
+// Revisions of a deleted authenticator secret are listed as well.
+// Possible errors:
+// - Internal server error if any issue occurs during processing.
+func (h *OTPHandler) History(ctx context.Context, in *pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.History(ctx, in.Title, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return newHistoryResponse(result), nil
+}
+
+// Restore brings back an archived revision of an authenticator secret, archiving the current version in turn.
+// A deleted authenticator secret is added again.
+// Possible errors:
+// - ErrRevisionNotFound: If the title has no such revision, for example because it was pruned.
+// - ErrRecordReplaced: If a revision of a vault account belongs to a record that was replaced.
+// - Internal server error if any other issue occurs during processing.
+func (h *OTPHandler) Restore(ctx context.Context, in *pb.RestoreRequest) (*pb.OTPShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Restore(ctx, in.Title, userID, int(in.Revision))
+	if err != nil {
+		if errors.Is(err, services.ErrRevisionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "revision %d of '%s' was not found.", in.Revision, in.Title)
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.OTPShortResponse{
+		Title: result,
+	}, nil
+}
+
+// GenerateCode computes the current code of an authenticator secret and the seconds left until it expires.
+// Possible errors:
+// - ErrOTPNotFound: If no authenticator secret matches the given title and user ID.
+// - ErrOTPClientSide: If the account is a zero-knowledge vault, whose codes the client generates itself.
+// - ErrInvalidOTP: If the stored secret cannot generate codes.
+// - Internal server error if any other issue occurs during processing.
+func (h *OTPHandler) GenerateCode(ctx context.Context, in *pb.OTPRequest) (*pb.OTPCodeResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.GenerateCode(ctx, in.Title, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrOTPNotFound) {
+			return nil, status.Errorf(codes.NotFound, "authenticator secret with title '%s' was not found.", in.Title)
+		}
+		if errors.Is(err, services.ErrOTPClientSide) {
+			return nil, status.Error(codes.FailedPrecondition, "Codes of zero-knowledge vault accounts are generated by the client.")
+		}
+		if errors.Is(err, services.ErrInvalidOTP) {
+			return nil, status.Error(codes.FailedPrecondition, "The stored authenticator secret cannot generate codes.")
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.OTPCodeResponse{
+		Code:      result.Code,
+		Remaining: int32(result.ExpiresIn.Round(time.Second) / time.Second),
+		Period:    int32(result.Period / time.Second),
+	}, nil
+}
+
+// otpInputError converts the errors caused by an invalid authenticator secret or password link into gRPC errors;
+// it returns nil for any other error.
+func otpInputError(err error, password string) error {
+	if errors.Is(err, services.ErrInvalidOTP) {
+		return status.Error(codes.InvalidArgument, "Invalid authenticator secret or unsupported code parameters.")
+	}
+	if errors.Is(err, services.ErrLinkedPasswordNotFound) {
+		return status.Errorf(codes.NotFound, "password with title '%s' was not found.", password)
+	}
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"main/internal/audit"
 	"main/internal/server/interfaces"
 	"main/internal/server/models"
 	"main/internal/server/services"
@@ -44,34 +46,75 @@ func (h *PasswordsHandler) Get(ctx context.Context, in *pb.PasswordRequest) (*pb
 		return nil, status.Error(codes.Internal, "Internal server error.")
 	}
 
+	fields := make([]*pb.CustomField, 0, len(result.Fields))
+	for _, field := range result.Fields {
+		fields = append(fields, &pb.CustomField{
+			Name:  string(field.Name),
+			Type:  field.Type,
+			Value: string(field.Value),
+		})
+	}
+
 	return &pb.PasswordResponse{
-		Id:       result.ID,
-		Title:    result.Title,
-		Login:    string(result.Login),
-		Password: string(result.Password),
+		Id:             result.ID,
+		Title:          result.Title,
+		Login:          string(result.Login),
+		Password:       string(result.Password),
+		Fields:         fields,
+		Placement:      newPlacementResponse(result.Placement),
+		CreatedAt:      timestamppb.New(result.Stats.CreatedAt),
+		UpdatedAt:      timestamppb.New(result.Stats.UpdatedAt),
+		LastAccessedAt: accessedAt(result.Stats.AccessedAt),
+		AccessCount:    result.Stats.AccessCount,
+		Compromised:    result.Compromised,
 	}, nil
 }
 
 // Add creates a new password entry.
 // It populates a Password model and invokes the PasswordsService to perform the insertion.
 // Possible errors:
+// - ErrInvalidField: If a custom field is malformed.
 // - ErrPasswordAlreadyExists: If a password with the same title already exists for this user.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - ErrInvalidReservation: If the given record ID was not reserved for a new record of the user.
+// - ErrInvalidPolicy, ErrUnknownPreset, ErrGenerateClientSide: If the password cannot be generated as asked.
 // - Internal server error if any other issue occurs during processing.
 func (h *PasswordsHandler) Add(ctx context.Context, in *pb.PasswordCreateRequest) (*pb.PasswordShortResponse, error) {
 	userID := ctx.Value("userID").(int64)
 
+	generate, err := newGenerate(in.Generate)
+	if err != nil {
+		return nil, generateError(err)
+	}
+
 	cond := models.Password{
-		UserID:   userID,
-		Title:    in.Title,
-		Login:    []byte(in.Login),
-		Password: []byte(in.Password),
+		ID:        in.Id,
+		UserID:    userID,
+		Title:     in.Title,
+		Login:     []byte(in.Login),
+		Password:  []byte(in.Password),
+		Fields:    newPasswordFields(in.Fields),
+		Placement: newPlacement(in.Placement),
+		Generate:  generate,
 	}
 
 	result, err := h.s.Add(ctx, cond)
 	if err != nil {
+		if errors.Is(err, services.ErrInvalidField) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s.", err)
+		}
 		if errors.Is(err, services.ErrPasswordAlreadyExists) {
 			return nil, status.Errorf(codes.AlreadyExists, "A password with title '%s' already exists.", in.Title)
 		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
+		if st := generateError(err); st != nil {
+			return nil, st
+		}
 		return nil, status.Error(codes.Internal, "Internal server error.")
 	}
 
@@ -80,22 +123,52 @@ func (h *PasswordsHandler) Add(ctx context.Context, in *pb.PasswordCreateRequest
 	}, nil
 }
 
-// Update modifies an existing password entry.
+// Update modifies an existing password entry; its custom fields are replaced only if requested.
 // It prepares a Password model and triggers the PasswordsService to execute the update.
 // Possible errors:
+// - ErrInvalidField: If a custom field is malformed.
+// - ErrPasswordNotFound: If no password matches the given title and user ID.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - ErrRecordReplaced: If the given record ID is no longer that of the record with the title.
+// - ErrInvalidPolicy, ErrUnknownPreset, ErrGenerateClientSide: If the password cannot be generated as asked.
 // - Internal server error if any issue occurs during processing.
 func (h *PasswordsHandler) Update(ctx context.Context, in *pb.PasswordUpdateRequest) (*pb.PasswordShortResponse, error) {
 	userID := ctx.Value("userID").(int64)
 
+	generate, err := newGenerate(in.Generate)
+	if err != nil {
+		return nil, generateError(err)
+	}
+
 	cond := models.Password{
-		UserID:   userID,
-		Title:    in.Title,
-		Login:    []byte(in.Login),
-		Password: []byte(in.Password),
+		ID:            in.Id,
+		UserID:        userID,
+		Title:         in.Title,
+		Login:         []byte(in.Login),
+		Password:      []byte(in.Password),
+		Fields:        newPasswordFields(in.Fields),
+		ReplaceFields: in.ReplaceFields,
+		Placement:     newPlacementUpdate(in.Placement, in.ReplaceFolder, in.ReplaceTags),
+		Generate:      generate,
 	}
 
 	result, err := h.s.Update(ctx, cond)
 	if err != nil {
+		if errors.Is(err, services.ErrInvalidField) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s.", err)
+		}
+		if errors.Is(err, services.ErrPasswordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "password with title '%s' was not found.", in.Title)
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
+		if st := generateError(err); st != nil {
+			return nil, st
+		}
 		return nil, status.Error(codes.Internal, "Internal server error.")
 	}
 
@@ -104,16 +177,216 @@ func (h *PasswordsHandler) Update(ctx context.Context, in *pb.PasswordUpdateRequ
 	}, nil
 }
 
-// Delete removes a password entry by title and user ID.
+// Delete moves a password entry to the trash by title and user ID.
 // It extracts the user ID from the context and forwards the removal request to the PasswordsService.
 // Possible errors:
-// - Internal server error if any issue occurs during processing.
+// - ErrPasswordNotFound: If no password matches the given title and user ID.
+// - Internal server error if any other issue occurs during processing.
 func (h *PasswordsHandler) Delete(ctx context.Context, in *pb.PasswordRequest) (*emptypb.Empty, error) {
 	userID := ctx.Value("userID").(int64)
 
 	err := h.s.Delete(ctx, in.Title, userID)
 	if err != nil {
+		if errors.Is(err, services.ErrPasswordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "password with title '%s' was not found.", in.Title)
+		}
 		return nil, status.Error(codes.Internal, "Internal server error.")
 	}
 	return &emptypb.Empty{}, nil
 }
+
+// Rename gives a password found by its ID or, if the ID is zero, by its title a new title.
+// Possible errors:
+// - ErrPasswordNotFound: If the user has no such password.
+// - ErrPasswordAlreadyExists: If another password of the user already has the new title.
+// - ErrInvalidTitle: If the new title is blank or too long.
+// - Internal server error if any other issue occurs during processing.
+func (h *PasswordsHandler) Rename(ctx context.Context, in *pb.RenameRequest) (*pb.PasswordShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Rename(ctx, newRename(in, userID))
+	if err != nil {
+		if errors.Is(err, services.ErrPasswordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "password %s was not found.", renamed(in))
+		}
+		if errors.Is(err, services.ErrPasswordAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "A password with title '%s' already exists.", in.NewTitle)
+		}
+		if errors.Is(err, services.ErrInvalidTitle) {
+			return nil, status.Errorf(codes.InvalidArgument, "Title must be 1 to %d characters long.", services.MaxTitleLen)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.PasswordShortResponse{
+		Id:             result.ID,
+		Title:          result.Title,
+		CreatedAt:      timestamppb.New(result.Stats.CreatedAt),
+		UpdatedAt:      timestamppb.New(result.Stats.UpdatedAt),
+		LastAccessedAt: accessedAt(result.Stats.AccessedAt),
+		AccessCount:    result.Stats.AccessCount,
+	}, nil
+}
+
+// List returns a page of password entries owned by the user.
+// Entries can be filtered by a title prefix or by the time they were last changed or read,
+// and ordered by title or by the time they were created, last changed or last read.
+// Possible errors:
+// - ErrInvalidCursor: If the supplied cursor is malformed or was issued for another ordering.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - Internal server error if any other issue occurs during processing.
+func (h *PasswordsHandler) List(ctx context.Context, in *pb.ListRequest) (*pb.PasswordListResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.List(ctx, newListQuery(userID, in))
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, "Invalid list cursor.")
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	items := make([]*pb.PasswordShortResponse, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, &pb.PasswordShortResponse{
+			Id:             item.ID,
+			Title:          item.Title,
+			CreatedAt:      timestamppb.New(item.Stats.CreatedAt),
+			UpdatedAt:      timestamppb.New(item.Stats.UpdatedAt),
+			LastAccessedAt: accessedAt(item.Stats.AccessedAt),
+			AccessCount:    item.Stats.AccessCount,
+		})
+	}
+
+	return &pb.PasswordListResponse{
+		Items:      items,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// History lists the archived revisions of a password by title, newest first.
+// Revisions of a deleted password are listed as well.
+// Possible errors:
+// - Internal server error if any issue occurs during processing.
+func (h *PasswordsHandler) History(ctx context.Context, in *pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.History(ctx, in.Title, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return newHistoryResponse(result), nil
+}
+
+// Restore brings back an archived revision of a password, archiving the current version in turn.
+// A deleted password is added again.
+// Possible errors:
+// - ErrRevisionNotFound: If the title has no such revision, for example because it was pruned.
+// - ErrRecordReplaced: If a revision of a vault account belongs to a record that was replaced.
+// - Internal server error if any other issue occurs during processing.
+func (h *PasswordsHandler) Restore(ctx context.Context, in *pb.RestoreRequest) (*pb.PasswordShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Restore(ctx, in.Title, userID, int(in.Revision))
+	if err != nil {
+		if errors.Is(err, services.ErrRevisionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "revision %d of '%s' was not found.", in.Revision, in.Title)
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.PasswordShortResponse{
+		Title: result,
+	}, nil
+}
+
+// Audit reports the weak, reused, stale and breached passwords of the user; passwords are never part of the report.
+// Possible errors:
+// - ErrInvalidPolicy: If the minimum score is out of range.
+// - ErrAuditClientSide: If the account is a zero-knowledge vault, whose passwords the client audits itself.
+// - Internal server error if any other issue occurs during processing.
+func (h *PasswordsHandler) Audit(ctx context.Context, in *pb.AuditRequest) (*pb.AuditResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Audit(ctx, userID, newAuditPolicy(in))
+	if err != nil {
+		if errors.Is(err, audit.ErrInvalidPolicy) {
+			return nil, status.Errorf(codes.InvalidArgument, "Minimum score must be 1 to %d.", audit.MaxScore)
+		}
+		if errors.Is(err, services.ErrAuditClientSide) {
+			return nil, status.Error(codes.FailedPrecondition, "Passwords of zero-knowledge vault accounts are audited by the client.")
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return newAuditResponse(result), nil
+}
+
+// AuditEntries returns the sealed passwords of a zero-knowledge vault account for the client to audit.
+// Possible errors:
+// - ErrAuditServerSide: If the server encrypts the account's passwords and audits them itself.
+// - Internal server error if any other issue occurs during processing.
+func (h *PasswordsHandler) AuditEntries(ctx context.Context, _ *emptypb.Empty) (*pb.AuditEntriesResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.AuditEntries(ctx, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrAuditServerSide) {
+			return nil, status.Error(codes.FailedPrecondition, "Passwords of regular accounts are audited by the server.")
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	items := make([]*pb.AuditEntry, 0, len(result))
+	for _, item := range result {
+		items = append(items, &pb.AuditEntry{
+			Id:        item.ID,
+			Title:     item.Title,
+			Password:  string(item.Password),
+			UpdatedAt: timestamppb.New(item.Stats.UpdatedAt),
+		})
+	}
+	return &pb.AuditEntriesResponse{Items: items}, nil
+}
+
+// Generate returns a password or passphrase following a preset and the settings overriding it; nothing is stored.
+// Possible errors:
+// - ErrInvalidPolicy, ErrUnknownPreset: If the preset is unknown or a setting is out of range.
+// - Internal server error if any other issue occurs during processing.
+func (h *PasswordsHandler) Generate(ctx context.Context, in *pb.GenerateRequest) (*pb.GenerateResponse, error) {
+	policy, err := newGeneratePolicy(in)
+	if err != nil {
+		return nil, generateError(err)
+	}
+
+	result, err := h.s.Generate(ctx, policy)
+	if err != nil {
+		if st := generateError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.GenerateResponse{
+		Password:    result,
+		EntropyBits: policy.EntropyBits(),
+	}, nil
+}
+
+// newPasswordFields converts the custom fields of a request into their model.
+func newPasswordFields(in []*pb.CustomField) []models.PasswordField {
+	fields := make([]models.PasswordField, 0, len(in))
+	for _, field := range in {
+		fields = append(fields, models.PasswordField{
+			Name:  []byte(field.Name),
+			Type:  field.Type,
+			Value: []byte(field.Value),
+		})
+	}
+	return fields
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"fmt"
+	"main/internal/server/models"
+	pb "main/proto"
+)
+
+// newRename translates a rename request of a user.
+func newRename(in *pb.RenameRequest, userID int64) models.Rename {
+	return models.Rename{
+		ID:       in.Id,
+		UserID:   userID,
+		Title:    in.Title,
+		NewTitle: in.NewTitle,
+	}
+}
+
+// renamed describes the record a rename request refers to in error messages: by ID if given, else by title.
+func renamed(in *pb.RenameRequest) string {
+	if in.Id != 0 {
+		return fmt.Sprintf("with ID %d", in.Id)
+	}
+	return fmt.Sprintf("with title '%s'", in.Title)
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"main/internal/server/models"
+	"main/internal/server/services"
+	pb "main/proto"
+)
+
+// Refresh exchanges a refresh token for a new access and refresh token pair.
+// The presented refresh token stops working; presenting it again revokes the whole session.
+// Possible errors:
+// - ErrInvalidRefreshToken, ErrRefreshTokenReused: The token is unknown, expired, revoked or superseded.
+// - Internal server error if the tokens cannot be issued.
+func (h *UsersHandler) Refresh(ctx context.Context, in *pb.RefreshRequest) (*pb.RefreshResponse, error) {
+	tokens, err := h.ss.Refresh(ctx, in.RefreshToken)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRefreshToken) || errors.Is(err, services.ErrRefreshTokenReused) {
+			return nil, status.Error(codes.Unauthenticated, "Refresh token is invalid.")
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.RefreshResponse{
+		Token:        tokens.Access,
+		RefreshToken: tokens.Refresh,
+		ExpiresAt:    timestamppb.New(tokens.AccessExpiresAt),
+	}, nil
+}
+
+// Logout revokes the session of the access token used for the call.
+// Possible errors:
+// - Internal server error if the session cannot be revoked.
+func (h *UsersHandler) Logout(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	userID := ctx.Value("userID").(int64)
+	sessionID := ctx.Value("sessionID").(int64)
+
+	err := h.ss.Revoke(ctx, userID, sessionID)
+	if err != nil && !errors.Is(err, services.ErrSessionNotFound) {
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListSessions returns the active sessions of the user, marking the one used for the call.
+// Possible errors:
+// - Internal server error if the sessions cannot be read.
+func (h *UsersHandler) ListSessions(ctx context.Context, _ *emptypb.Empty) (*pb.SessionListResponse, error) {
+	userID := ctx.Value("userID").(int64)
+	sessionID := ctx.Value("sessionID").(int64)
+
+	result, err := h.ss.List(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	items := make([]*pb.Session, 0, len(result))
+	for _, s := range result {
+		items = append(items, &pb.Session{
+			Id:         s.ID,
+			UserAgent:  s.UserAgent,
+			Address:    s.Address,
+			CreatedAt:  timestamppb.New(s.CreatedAt),
+			LastUsedAt: timestamppb.New(s.LastUsedAt),
+			ExpiresAt:  timestamppb.New(s.ExpiresAt),
+			Current:    s.ID == sessionID,
+		})
+	}
+	return &pb.SessionListResponse{Items: items}, nil
+}
+
+// RevokeSession revokes one of the user's sessions, e.g. that of a lost device.
+// Its access and refresh tokens are rejected from the next call on.
+// Possible errors:
+// - ErrSessionNotFound: The user has no active session with the given ID.
+// - Internal server error if the session cannot be revoked.
+func (h *UsersHandler) RevokeSession(ctx context.Context, in *pb.RevokeSessionRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value("userID").(int64)
+
+	err := h.ss.Revoke(ctx, userID, in.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrSessionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "session %d was not found.", in.Id)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// newSession describes a session being opened for the user by the current request.
+func newSession(ctx context.Context, userID int64) models.Session {
+	session := models.Session{UserID: userID}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get("user-agent"); len(vals) > 0 {
+			session.UserAgent = vals[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		session.Address = p.Addr.String()
+	}
+	return session
+}
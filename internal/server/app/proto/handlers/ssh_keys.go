@@ -0,0 +1,298 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+	"main/internal/server/services"
+	pb "main/proto"
+)
+
+// SSHKeysHandler implements the gRPC service definition for managing SSH keys.
+// It delegates requests to the underlying SSHKeysService for actual business logic execution.
+type SSHKeysHandler struct {
+	pb.UnimplementedSSHKeysServer                           // Base implementation for protobuf-defined gRPC server.
+	s                             interfaces.SSHKeysService // Service for handling SSH key operations.
+	j                             interfaces.JWTService     // JWT service for authentication purposes.
+}
+
+// NewSSHKeysHandler creates a new instance of SSHKeysHandler with injected dependencies.
+func NewSSHKeysHandler(s interfaces.SSHKeysService, j interfaces.JWTService) *SSHKeysHandler {
+	return &SSHKeysHandler{
+		s: s,
+		j: j,
+	}
+}
+
+// Get retrieves an SSH key by title and user ID.
+// It extracts the user ID from the context and passes control to the SSHKeysService.
+// Possible errors:
+// - ErrSSHKeyNotFound: If no SSH key matches the given title and user ID.
+// - Internal server error if any other issue occurs during processing.
+func (h *SSHKeysHandler) Get(ctx context.Context, in *pb.SSHKeyRequest) (*pb.SSHKeyResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Get(ctx, in.Title, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrSSHKeyNotFound) {
+			return nil, status.Errorf(codes.NotFound, "SSH key with title '%s' was not found.", in.Title)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.SSHKeyResponse{
+		Id:             result.ID,
+		Title:          result.Title,
+		PublicKey:      result.PublicKey,
+		Fingerprint:    result.Fingerprint,
+		PrivateKey:     string(result.PrivateKey),
+		Placement:      newPlacementResponse(result.Placement),
+		CreatedAt:      timestamppb.New(result.Stats.CreatedAt),
+		UpdatedAt:      timestamppb.New(result.Stats.UpdatedAt),
+		LastAccessedAt: accessedAt(result.Stats.AccessedAt),
+		AccessCount:    result.Stats.AccessCount,
+	}, nil
+}
+
+// Add creates a new SSH key.
+// It populates an SSHKey model and invokes the SSHKeysService to perform the insertion.
+// Possible errors:
+// - ErrInvalidSSHKey: If the public key does not parse or does not match the private key.
+// - ErrSSHKeyAlreadyExists: If an SSH key with the same title already exists for this user.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - ErrInvalidReservation: If the given record ID was not reserved for a new record of the user.
+// - Internal server error if any other issue occurs during processing.
+func (h *SSHKeysHandler) Add(ctx context.Context, in *pb.SSHKeyCreateRequest) (*pb.SSHKeyShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	cond := models.SSHKey{
+		ID:         in.Id,
+		UserID:     userID,
+		Title:      in.Title,
+		PublicKey:  in.PublicKey,
+		PrivateKey: []byte(in.PrivateKey),
+		Placement:  newPlacement(in.Placement),
+	}
+
+	result, err := h.s.Add(ctx, cond)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidSSHKey) {
+			return nil, status.Error(codes.InvalidArgument, "Invalid SSH key pair.")
+		}
+		if errors.Is(err, services.ErrSSHKeyAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "An SSH key with title '%s' already exists.", in.Title)
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.SSHKeyShortResponse{
+		Title: result,
+	}, nil
+}
+
+// Update replaces the key pair of an existing SSH key.
+// It prepares an SSHKey model and triggers the SSHKeysService to execute the update.
+// Possible errors:
+// - ErrInvalidSSHKey: If the public key does not parse or does not match the private key.
+// - ErrSSHKeyNotFound: If no SSH key matches the given title and user ID.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - ErrRecordReplaced: If the given record ID is no longer that of the record with the title.
+// - Internal server error if any issue occurs during processing.
+func (h *SSHKeysHandler) Update(ctx context.Context, in *pb.SSHKeyUpdateRequest) (*pb.SSHKeyShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	cond := models.SSHKey{
+		ID:         in.Id,
+		UserID:     userID,
+		Title:      in.Title,
+		PublicKey:  in.PublicKey,
+		PrivateKey: []byte(in.PrivateKey),
+		Placement:  newPlacementUpdate(in.Placement, in.ReplaceFolder, in.ReplaceTags),
+	}
+
+	result, err := h.s.Update(ctx, cond)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidSSHKey) {
+			return nil, status.Error(codes.InvalidArgument, "Invalid SSH key pair.")
+		}
+		if errors.Is(err, services.ErrSSHKeyNotFound) {
+			return nil, status.Errorf(codes.NotFound, "SSH key with title '%s' was not found.", in.Title)
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.SSHKeyShortResponse{
+		Title: result,
+	}, nil
+}
+
+// Delete moves an SSH key to the trash by title and user ID.
+// It extracts the user ID from the context and forwards the removal request to the SSHKeysService.
+// Possible errors:
+// - ErrSSHKeyNotFound: If no SSH key matches the given title and user ID.
+// - Internal server error if any other issue occurs during processing.
+func (h *SSHKeysHandler) Delete(ctx context.Context, in *pb.SSHKeyRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value("userID").(int64)
+
+	err := h.s.Delete(ctx, in.Title, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrSSHKeyNotFound) {
+			return nil, status.Errorf(codes.NotFound, "SSH key with title '%s' was not found.", in.Title)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Rename gives an SSH key found by its ID or, if the ID is zero, by its title a new title.
+// Possible errors:
+// - ErrSSHKeyNotFound: If the user has no such SSH key.
+// - ErrSSHKeyAlreadyExists: If another SSH key of the user already has the new title.
+// - ErrInvalidTitle: If the new title is blank or too long.
+// - Internal server error if any other issue occurs during processing.
+func (h *SSHKeysHandler) Rename(ctx context.Context, in *pb.RenameRequest) (*pb.SSHKeyShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Rename(ctx, newRename(in, userID))
+	if err != nil {
+		if errors.Is(err, services.ErrSSHKeyNotFound) {
+			return nil, status.Errorf(codes.NotFound, "SSH key %s was not found.", renamed(in))
+		}
+		if errors.Is(err, services.ErrSSHKeyAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "An SSH key with title '%s' already exists.", in.NewTitle)
+		}
+		if errors.Is(err, services.ErrInvalidTitle) {
+			return nil, status.Errorf(codes.InvalidArgument, "Title must be 1 to %d characters long.", services.MaxTitleLen)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.SSHKeyShortResponse{
+		Id:             result.ID,
+		Title:          result.Title,
+		CreatedAt:      timestamppb.New(result.Stats.CreatedAt),
+		UpdatedAt:      timestamppb.New(result.Stats.UpdatedAt),
+		LastAccessedAt: accessedAt(result.Stats.AccessedAt),
+		AccessCount:    result.Stats.AccessCount,
+	}, nil
+}
+
+// List returns a page of SSH keys owned by the user.
+// SSH keys can be filtered by a title prefix or by the time they were last changed or read,
+// and ordered by title or by the time they were created, last changed or last read.
+// Possible errors:
+// - ErrInvalidCursor: If the supplied cursor is malformed or was issued for another ordering.
+// - ErrFolderNotFound, ErrInvalidFolder, ErrInvalidTag: If the folder does not exist or the folder or a tag is malformed.
+// - Internal server error if any other issue occurs during processing.
+func (h *SSHKeysHandler) List(ctx context.Context, in *pb.ListRequest) (*pb.SSHKeyListResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.List(ctx, newListQuery(userID, in))
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, "Invalid list cursor.")
+		}
+		if st := placementError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	items := make([]*pb.SSHKeyShortResponse, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, &pb.SSHKeyShortResponse{
+			Id:             item.ID,
+			Title:          item.Title,
+			CreatedAt:      timestamppb.New(item.Stats.CreatedAt),
+			UpdatedAt:      timestamppb.New(item.Stats.UpdatedAt),
+			LastAccessedAt: accessedAt(item.Stats.AccessedAt),
+			AccessCount:    item.Stats.AccessCount,
+		})
+	}
+
+	return &pb.SSHKeyListResponse{
+		Items:      items,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// PublicKeys lists the public keys and fingerprints of all SSH keys owned by the user, ordered by title.
+// Private keys are not read, so nothing is decrypted.
+// Possible errors:
+// - Internal server error if any issue occurs during processing.
+func (h *SSHKeysHandler) PublicKeys(ctx context.Context, _ *emptypb.Empty) (*pb.SSHPublicKeysResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.PublicKeys(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	keys := make([]*pb.SSHPublicKey, 0, len(result))
+	for _, key := range result {
+		keys = append(keys, &pb.SSHPublicKey{
+			Title:       key.Title,
+			PublicKey:   key.PublicKey,
+			Fingerprint: key.Fingerprint,
+		})
+	}
+	return &pb.SSHPublicKeysResponse{
+		Keys: keys,
+	}, nil
+}
+
+// History lists the archived revisions of an SSH key by title, newest first.
+// Revisions of a deleted SSH key are listed as well.
+// Possible errors:
+// - Internal server error if any issue occurs during processing.
+func (h *SSHKeysHandler) History(ctx context.Context, in *pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.History(ctx, in.Title, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return newHistoryResponse(result), nil
+}
+
+// Restore brings back an archived revision of an SSH key, archiving the current version in turn.
+// A deleted SSH key is added again.
+// Possible errors:
+// - ErrRevisionNotFound: If the title has no such revision, for example because it was pruned.
+// - ErrRecordReplaced: If a revision of a vault account belongs to a record that was replaced.
+// - Internal server error if any other issue occurs during processing.
+func (h *SSHKeysHandler) Restore(ctx context.Context, in *pb.RestoreRequest) (*pb.SSHKeyShortResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.s.Restore(ctx, in.Title, userID, int(in.Revision))
+	if err != nil {
+		if errors.Is(err, services.ErrRevisionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "revision %d of '%s' was not found.", in.Revision, in.Title)
+		}
+		if st := recordError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.SSHKeyShortResponse{
+		Title: result,
+	}, nil
+}
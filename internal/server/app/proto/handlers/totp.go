@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"main/internal/server/services"
+	pb "main/proto"
+)
+
+// VerifySecondFactor completes a login challenge with a TOTP or recovery code and opens a session.
+// Possible errors:
+// - ErrInvalidChallenge: The challenge is unknown, expired or has run out of attempts.
+// - ErrInvalidSecondFactor: The code is wrong or was already used.
+// - Internal server error if the session cannot be opened.
+func (h *UsersHandler) VerifySecondFactor(ctx context.Context, in *pb.SecondFactorRequest) (*pb.LoginResponse, error) {
+	userID, err := h.tf.Verify(ctx, in.Challenge, in.Code)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidChallenge) {
+			return nil, status.Error(codes.Unauthenticated, "Login challenge is invalid or expired.")
+		}
+		if errors.Is(err, services.ErrInvalidSecondFactor) || errors.Is(err, services.ErrTOTPNotEnabled) {
+			return nil, status.Error(codes.Unauthenticated, "Provided code is invalid.")
+		}
+		return nil, status.Error(codes.Internal, "Error logging into account.")
+	}
+
+	return h.completeLogin(ctx, userID)
+}
+
+// EnrollTOTP generates a new TOTP secret for the user. It is enforced only after ConfirmTOTP.
+// Possible errors:
+// - ErrTOTPAlreadyEnabled: The user already has a confirmed second factor.
+// - Internal server error if the secret cannot be stored.
+func (h *UsersHandler) EnrollTOTP(ctx context.Context, _ *emptypb.Empty) (*pb.TOTPEnrollResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	result, err := h.tf.Enroll(ctx, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrTOTPAlreadyEnabled) {
+			return nil, status.Error(codes.AlreadyExists, "Two-factor authentication is already enabled.")
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.TOTPEnrollResponse{
+		Secret: result.Secret,
+		Uri:    result.URI,
+	}, nil
+}
+
+// ConfirmTOTP enables the enrolled secret after checking a code and returns one-time recovery codes.
+// Possible errors:
+// - ErrTOTPNotEnabled: The user has not enrolled a secret.
+// - ErrTOTPAlreadyEnabled: The secret is already confirmed.
+// - ErrInvalidSecondFactor: The code does not match the secret.
+// - Internal server error if the second factor cannot be enabled.
+func (h *UsersHandler) ConfirmTOTP(ctx context.Context, in *pb.TOTPCodeRequest) (*pb.TOTPConfirmResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	recoveryCodes, err := h.tf.Confirm(ctx, userID, in.Code)
+	if err != nil {
+		if errors.Is(err, services.ErrTOTPNotEnabled) {
+			return nil, status.Error(codes.FailedPrecondition, "No two-factor secret was enrolled.")
+		}
+		if errors.Is(err, services.ErrTOTPAlreadyEnabled) {
+			return nil, status.Error(codes.AlreadyExists, "Two-factor authentication is already enabled.")
+		}
+		if errors.Is(err, services.ErrInvalidSecondFactor) {
+			return nil, status.Error(codes.InvalidArgument, "Provided code is invalid.")
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.TOTPConfirmResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// DisableTOTP removes the second factor of the user after checking a TOTP or recovery code.
+// Possible errors:
+// - ErrTOTPNotEnabled: The user has no confirmed second factor.
+// - ErrInvalidSecondFactor: The code is wrong or was already used.
+// - Internal server error if the second factor cannot be removed.
+func (h *UsersHandler) DisableTOTP(ctx context.Context, in *pb.TOTPCodeRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value("userID").(int64)
+
+	err := h.tf.Disable(ctx, userID, in.Code)
+	if err != nil {
+		if errors.Is(err, services.ErrTOTPNotEnabled) {
+			return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication is not enabled.")
+		}
+		if errors.Is(err, services.ErrInvalidSecondFactor) {
+			return nil, status.Error(codes.InvalidArgument, "Provided code is invalid.")
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+	"main/internal/server/services"
+	pb "main/proto"
+)
+
+// TrashHandler implements the gRPC service definition for deleted records of every kind.
+// It delegates requests to the underlying TrashService.
+type TrashHandler struct {
+	pb.UnimplementedTrashServer                         // Base implementation for protobuf-defined gRPC server.
+	s                           interfaces.TrashService // Service managing the trash.
+}
+
+// NewTrashHandler creates a new instance of TrashHandler with injected dependencies.
+func NewTrashHandler(s interfaces.TrashService) *TrashHandler {
+	return &TrashHandler{
+		s: s,
+	}
+}
+
+// ListTrash returns the trashed records of the user, of the requested kind or of every kind,
+// most recently deleted first within a kind.
+// Possible errors:
+// - Internal server error if any issue occurs during processing.
+func (h *TrashHandler) ListTrash(ctx context.Context, in *pb.TrashListRequest) (*pb.TrashListResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	kind, ok := kindFromPB(in.Kind)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "Unknown record kind.")
+	}
+
+	result, err := h.s.List(ctx, userID, kind)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	items := make([]*pb.TrashItem, 0, len(result))
+	for _, item := range result {
+		items = append(items, &pb.TrashItem{
+			Kind:      kindToPB(item.Kind),
+			Title:     item.Title,
+			DeletedAt: timestamppb.New(item.DeletedAt),
+			PurgeAt:   timestamppb.New(item.PurgeAt),
+		})
+	}
+
+	return &pb.TrashListResponse{
+		Items: items,
+	}, nil
+}
+
+// Restore moves a record of the requested kind and title out of the trash.
+// Possible errors:
+// - ErrTrashItemNotFound: If the trash holds no such record.
+// - ErrTrashTitleTaken: If a live record of the same kind has been given the title meanwhile.
+// - Internal server error if any other issue occurs during processing.
+func (h *TrashHandler) Restore(ctx context.Context, in *pb.TrashRequest) (*pb.TrashRestoreResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	kind, ok := kindFromPB(in.Kind)
+	if !ok || kind == "" || in.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "Record kind and title are required.")
+	}
+
+	result, err := h.s.Restore(ctx, userID, kind, in.Title)
+	if err != nil {
+		if errors.Is(err, services.ErrTrashItemNotFound) {
+			return nil, status.Errorf(codes.NotFound, "'%s' was not found in the trash.", in.Title)
+		}
+		if errors.Is(err, services.ErrTrashTitleTaken) {
+			return nil, status.Errorf(codes.AlreadyExists, "A record with title '%s' already exists.", in.Title)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.TrashRestoreResponse{
+		Title: result,
+	}, nil
+}
+
+// Purge permanently removes a record of the requested kind and title from the trash.
+// An empty title empties the trash, of the requested kind or entirely.
+// Possible errors:
+// - ErrTrashItemNotFound: If the trash holds no such record.
+// - Internal server error if any other issue occurs during processing.
+func (h *TrashHandler) Purge(ctx context.Context, in *pb.TrashRequest) (*pb.TrashPurgeResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	kind, ok := kindFromPB(in.Kind)
+	if !ok || kind == "" && in.Title != "" {
+		return nil, status.Error(codes.InvalidArgument, "Record kind is required to purge a single record.")
+	}
+
+	result, err := h.s.Purge(ctx, userID, kind, in.Title)
+	if err != nil {
+		if errors.Is(err, services.ErrTrashItemNotFound) {
+			return nil, status.Errorf(codes.NotFound, "'%s' was not found in the trash.", in.Title)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.TrashPurgeResponse{
+		Purged: int32(result),
+	}, nil
+}
+
+// kindFromPB converts a protobuf record kind into the table name used as kind by the services;
+// the unspecified kind maps to an empty string. It reports false for unknown kinds.
+func kindFromPB(kind pb.ItemKind) (string, bool) {
+	switch kind {
+	case pb.ItemKind_ITEM_KIND_UNSPECIFIED:
+		return "", true
+	case pb.ItemKind_ITEM_KIND_PASSWORD:
+		return models.TablePasswords, true
+	case pb.ItemKind_ITEM_KIND_CARD:
+		return models.TableCards, true
+	case pb.ItemKind_ITEM_KIND_BINARY:
+		return models.TableBinaries, true
+	case pb.ItemKind_ITEM_KIND_NOTE:
+		return models.TableNotes, true
+	case pb.ItemKind_ITEM_KIND_OTP:
+		return models.TableOTP, true
+	case pb.ItemKind_ITEM_KIND_SSH_KEY:
+		return models.TableSSHKeys, true
+	default:
+		return "", false
+	}
+}
+
+// kindToPB converts a record kind into its protobuf form.
+func kindToPB(kind string) pb.ItemKind {
+	switch kind {
+	case models.TablePasswords:
+		return pb.ItemKind_ITEM_KIND_PASSWORD
+	case models.TableCards:
+		return pb.ItemKind_ITEM_KIND_CARD
+	case models.TableBinaries:
+		return pb.ItemKind_ITEM_KIND_BINARY
+	case models.TableNotes:
+		return pb.ItemKind_ITEM_KIND_NOTE
+	case models.TableOTP:
+		return pb.ItemKind_ITEM_KIND_OTP
+	case models.TableSSHKeys:
+		return pb.ItemKind_ITEM_KIND_SSH_KEY
+	default:
+		return pb.ItemKind_ITEM_KIND_UNSPECIFIED
+	}
+}
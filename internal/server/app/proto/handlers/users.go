@@ -5,6 +5,8 @@ import (
 	"errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"main/internal/server/interfaces"
 	"main/internal/server/models"
 	"main/internal/server/services"
@@ -15,30 +17,39 @@ import (
 // UsersHandler implements the gRPC service definition for user management.
 // Delegates requests to the underlying UsersService for actual business logic execution.
 type UsersHandler struct {
-	pb.UnimplementedUsersServer                         // Base implementation for protobuf-defined gRPC server.
-	s                           interfaces.UsersService // Service for handling user-related operations.
-	j                           interfaces.JWTService   // JWT service for authentication and token generation.
+	pb.UnimplementedUsersServer                                // Base implementation for protobuf-defined gRPC server.
+	s                           interfaces.UsersService        // Service for handling user-related operations.
+	ss                          interfaces.SessionsService     // Service opening sessions and issuing their tokens.
+	tf                          interfaces.SecondFactorService // Service checking the second factor of logins.
+	cs                          interfaces.CertificatesService // Service binding client certificates to users.
+	rs                          interfaces.RecordsService      // Service handing out the IDs vault records are bound to.
 }
 
 // NewUsersHandler creates a new instance of UsersHandler with injected dependencies.
-func NewUsersHandler(s interfaces.UsersService, j interfaces.JWTService) *UsersHandler {
+func NewUsersHandler(s interfaces.UsersService, ss interfaces.SessionsService, tf interfaces.SecondFactorService,
+	cs interfaces.CertificatesService, rs interfaces.RecordsService) *UsersHandler {
 	return &UsersHandler{
-		s: s,
-		j: j,
+		s:  s,
+		ss: ss,
+		tf: tf,
+		cs: cs,
+		rs: rs,
 	}
 }
 
-// Register handles user registration by delegating to the UsersService and generates a JWT token on successful completion.
+// Register handles user registration by delegating to the UsersService and opens a session on successful completion.
 // Possible errors:
 // - ErrLoginAlreadyExists: The provided login is already taken by another user.
+// - ErrInvalidVaultParams: The vault key derivation parameters are out of the accepted range.
 // - Internal server error if registration fails.
 func (h *UsersHandler) Register(ctx context.Context, in *pb.RegisterRequest) (*pb.RegisterResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
 	defer cancel()
 
 	cond := models.User{
 		Login:    in.Login,
 		Password: in.Password,
+		Vault:    vaultFromPB(in.Vault),
 	}
 
 	userID, err := h.s.Register(ctx, cond)
@@ -46,20 +57,27 @@ func (h *UsersHandler) Register(ctx context.Context, in *pb.RegisterRequest) (*p
 		if errors.Is(err, services.ErrLoginAlreadyExists) {
 			return nil, status.Errorf(codes.AlreadyExists, "A user with login '%s' already exists.", in.Login)
 		}
+		if errors.Is(err, services.ErrInvalidVaultParams) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "Error registering new user.")
 	}
 
-	token, err := h.j.Generate(userID)
+	tokens, err := h.ss.Start(ctx, newSession(ctx, userID))
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.Internal, "Error opening session.")
 	}
 
 	return &pb.RegisterResponse{
-		Token: token,
+		Token:        tokens.Access,
+		RefreshToken: tokens.Refresh,
+		ExpiresAt:    timestamppb.New(tokens.AccessExpiresAt),
 	}, nil
 }
 
-// Login authenticates a user and returns a JWT token upon successful authentication.
+// Login authenticates a user, opens a session and returns its access and refresh tokens.
+// Vault accounts also receive their key derivation parameters. Users with two-factor authentication
+// only receive a challenge, which VerifySecondFactor completes.
 // Possible errors:
 // - ErrUserNotFound: User with specified login does not exist.
 // - ErrInvalidCredentials: Provided username or password is incorrect.
@@ -81,12 +99,147 @@ func (h *UsersHandler) Login(ctx context.Context, in *pb.LoginRequest) (*pb.Logi
 		return nil, status.Error(codes.Internal, "Error logging into account.")
 	}
 
-	token, err := h.j.Generate(userID)
+	challenge, err := h.tf.Challenge(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error logging into account.")
+	}
+	if challenge != "" {
+		return &pb.LoginResponse{Challenge: challenge}, nil
+	}
+
+	return h.completeLogin(ctx, userID)
+}
+
+// completeLogin opens a session for an authenticated user and builds the login response.
+func (h *UsersHandler) completeLogin(ctx context.Context, userID int64) (*pb.LoginResponse, error) {
+	vault, err := h.s.Vault(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error logging into account.")
+	}
+
+	tokens, err := h.ss.Start(ctx, newSession(ctx, userID))
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.Internal, "Error opening session.")
 	}
 
 	return &pb.LoginResponse{
-		Token: token,
+		Token:        tokens.Access,
+		RefreshToken: tokens.Refresh,
+		ExpiresAt:    timestamppb.New(tokens.AccessExpiresAt),
+		Vault:        vaultToPB(vault, userID),
 	}, nil
 }
+
+// Vault returns the key derivation parameters of the authenticated user's vault.
+// The response carries no parameters if the server encrypts the user's records.
+// Possible errors:
+// - ErrUserNotFound: The user of the token no longer exists.
+// - Internal server error if the parameters cannot be read.
+func (h *UsersHandler) Vault(ctx context.Context, _ *emptypb.Empty) (*pb.VaultResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	vault, err := h.s.Vault(ctx, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "User was not found.")
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.VaultResponse{
+		Vault: vaultToPB(vault, userID),
+	}, nil
+}
+
+// RecordID returns the ID of the authenticated user's live record of the given kind and title, or reserves an ID
+// for a new one. Vault accounts bind the ciphertexts of a record to its ID before sending them.
+// Possible errors:
+// - ErrUnknownKind: The kind is unspecified or unknown.
+// - ErrInvalidTitle: The title is blank or too long.
+// - Internal server error if the ID cannot be resolved or reserved.
+func (h *UsersHandler) RecordID(ctx context.Context, in *pb.RecordRequest) (*pb.RecordResponse, error) {
+	userID := ctx.Value("userID").(int64)
+
+	kind, ok := kindFromPB(in.Kind)
+	if !ok || kind == "" {
+		return nil, status.Error(codes.InvalidArgument, "Unknown record kind.")
+	}
+
+	id, reserved, err := h.rs.ID(ctx, userID, kind, in.Title)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidTitle) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s.", err)
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+
+	return &pb.RecordResponse{
+		Id:       id,
+		Reserved: reserved,
+	}, nil
+}
+
+// SetHistoryRetention changes the number of archived revisions kept per record of the authenticated user.
+// Revisions beyond the new limit are dropped at once.
+// Possible errors:
+// - ErrInvalidRetention: The number is out of the accepted range.
+// - ErrUserNotFound: The user of the token no longer exists.
+// - Internal server error if the setting cannot be stored.
+func (h *UsersHandler) SetHistoryRetention(ctx context.Context, in *pb.HistoryRetentionRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value("userID").(int64)
+
+	err := h.s.SetHistoryRetention(ctx, userID, int(in.Keep))
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRetention) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, services.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "User was not found.")
+		}
+		return nil, status.Error(codes.Internal, "Internal server error.")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// vaultFromPB converts protobuf vault parameters into the model; nil stays nil.
+func vaultFromPB(in *pb.VaultParams) *models.VaultParams {
+	if in == nil {
+		return nil
+	}
+	return &models.VaultParams{
+		Salt:    in.Salt,
+		Time:    in.Time,
+		Memory:  in.Memory,
+		Threads: in.Threads,
+		Check:   in.Check,
+	}
+}
+
+// vaultToPB converts vault parameters of a user into their protobuf form, along with the user ID the client
+// binds its ciphertexts to; nil stays nil.
+func vaultToPB(v *models.VaultParams, userID int64) *pb.VaultParams {
+	if v == nil {
+		return nil
+	}
+	return &pb.VaultParams{
+		Salt:    v.Salt,
+		Time:    v.Time,
+		Memory:  v.Memory,
+		Threads: v.Threads,
+		Check:   v.Check,
+		UserId:  userID,
+	}
+}
+
+// recordError translates errors about the ID a record of a vault account is written under into gRPC status errors.
+// It returns nil for any other error.
+func recordError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidReservation):
+		return status.Error(codes.FailedPrecondition, "Record ID is not reserved; request a new one.")
+	case errors.Is(err, services.ErrRecordReplaced):
+		return status.Error(codes.FailedPrecondition, "Record was replaced meanwhile; request its ID again.")
+	default:
+		return nil
+	}
+}
@@ -2,58 +2,121 @@ package interceptors
 
 import (
 	"context"
+	"errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"main/internal/server/auth"
 	"main/internal/server/interfaces"
 )
 
 // ErrRPCInvalidToken represents an error when the provided JWT token is invalid or missing.
+// ErrRPCMissingToken is returned when the request carries no token at all.
 var (
 	ErrRPCInvalidToken = status.Errorf(codes.Unauthenticated, "invalid token")
+	ErrRPCMissingToken = status.Errorf(codes.Unauthenticated, "missing token")
 )
 
 // AuthInterceptor is a gRPC Unary Server Interceptor that enforces authentication.
-// It extracts the JWT token from the request metadata and verifies it using the JWTService.
-// If the token is valid, the user ID is propagated through the context for downstream handlers.
-func AuthInterceptor(j interfaces.JWTService) grpc.UnaryServerInterceptor {
+// It extracts the JWT token from the request metadata and verifies it using the SessionsService,
+// which also rejects tokens whose session was revoked. If the token is valid, the user and session
+// IDs are propagated through the context for downstream handlers.
+// Under mutual TLS a call without a token is authenticated by the client certificate instead,
+// if its subject is bound to a user; such calls run under the session backing the binding,
+// so they are rejected as well once it is revoked.
+func AuthInterceptor(ss interfaces.SessionsService, cs interfaces.CertificatesService) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		skipMethods := map[string]bool{
-			"/gophkeeper.Users/Login":    true, // Allows login requests without authentication.
-			"/gophkeeper.Users/Register": true, // Allows registration requests without authentication.
+			"/gophkeeper.Users/Login":              true, // Allows login requests without authentication.
+			"/gophkeeper.Users/Register":           true, // Allows registration requests without authentication.
+			"/gophkeeper.Users/Refresh":            true, // Authenticated by the refresh token in the request body.
+			"/gophkeeper.Users/VerifySecondFactor": true, // Authenticated by the login challenge in the request body.
 		}
 
 		if skipMethods[info.FullMethod] {
 			return handler(ctx, req)
 		}
 
-		userID, err := GetUserIDFromMD(ctx, j)
+		ctx, err := authenticate(ctx, ss, cs)
 		if err != nil {
 			return nil, err
 		}
-
-		ctx = context.WithValue(ctx, "userID", userID)
 		return handler(ctx, req)
 	}
 }
 
-// GetUserIDFromMD retrieves the JWT token from the request metadata and verifies it.
-// If the token is successfully validated, the associated user ID is returned.
-func GetUserIDFromMD(ctx context.Context, j interfaces.JWTService) (int64, error) {
+// AuthStreamInterceptor is the streaming counterpart of AuthInterceptor.
+// All streaming RPCs require authentication.
+func AuthStreamInterceptor(ss interfaces.SessionsService, cs interfaces.CertificatesService) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(stream.Context(), ss, cs)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// authStream exposes the authenticated context to streaming handlers.
+type authStream struct {
+	grpc.ServerStream                 // Wrapped stream.
+	ctx               context.Context // Context carrying the user and session IDs.
+}
+
+// Context returns the authenticated context of the stream.
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate authenticates a call by its token or, failing that, its client certificate
+// and returns a context carrying the user and session IDs.
+func authenticate(ctx context.Context, ss interfaces.SessionsService, cs interfaces.CertificatesService) (context.Context, error) {
+	userID, sessionID, err := GetSessionFromMD(ctx, ss)
+	if errors.Is(err, ErrRPCMissingToken) {
+		userID, sessionID, err = GetUserFromCertificate(ctx, cs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, "userID", userID)
+	ctx = context.WithValue(ctx, "sessionID", sessionID)
+	return ctx, nil
+}
+
+// GetSessionFromMD retrieves the JWT token from the request metadata and authenticates it.
+// If the token is valid and its session is active, the associated user and session IDs are returned.
+func GetSessionFromMD(ctx context.Context, ss interfaces.SessionsService) (int64, int64, error) {
 	var token string
 
 	if md, ok := metadata.FromIncomingContext(ctx); !ok {
-		return -1, ErrRPCInvalidToken
+		return -1, -1, ErrRPCMissingToken
 	} else if vals := md.Get("token"); len(vals) > 0 && vals[0] != "" {
 		token = vals[0]
 	} else {
-		return -1, ErrRPCInvalidToken
+		return -1, -1, ErrRPCMissingToken
+	}
+
+	userID, sessionID, err := ss.Authenticate(ctx, token)
+	if err != nil {
+		return -1, -1, ErrRPCInvalidToken
+	}
+	return userID, sessionID, nil
+}
+
+// GetUserFromCertificate authenticates the request by the verified client certificate of the connection,
+// returning the user and session IDs of its binding. It fails with ErrRPCInvalidToken if there is no such
+// certificate or its subject is not bound to a user under an active session.
+func GetUserFromCertificate(ctx context.Context, cs interfaces.CertificatesService) (int64, int64, error) {
+	subject, ok := auth.CertificateSubject(ctx)
+	if !ok {
+		return -1, -1, ErrRPCInvalidToken
 	}
 
-	userID, err := j.Verify(token)
+	userID, sessionID, err := cs.Authenticate(ctx, subject)
 	if err != nil {
-		return -1, ErrRPCInvalidToken
+		return -1, -1, ErrRPCInvalidToken
 	}
-	return userID, nil
+	return userID, sessionID, nil
 }
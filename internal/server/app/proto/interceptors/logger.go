@@ -34,3 +34,26 @@ func LoggerInterceptor(logger *zap.SugaredLogger) grpc.UnaryServerInterceptor {
 		return
 	}
 }
+
+// LoggerStreamInterceptor crete a gRPC interceptor that logs streaming calls; messages are not logged.
+func LoggerStreamInterceptor(logger *zap.SugaredLogger) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		start := time.Now()
+		md, _ := metadata.FromIncomingContext(stream.Context())
+
+		logger.Info("stream   | ",
+			"method: ", info.FullMethod,
+			" metadata: ", md)
+
+		err = handler(srv, stream)
+
+		logger.Info("response | ",
+			"duration: ", time.Since(start),
+			" ok: ", err == nil)
+
+		logger.Debug("outgoing | ",
+			" error: ", err)
+
+		return
+	}
+}
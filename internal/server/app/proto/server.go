@@ -3,35 +3,46 @@ package proto
 import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"main/internal/server/app/proto/handlers"
 	"main/internal/server/app/proto/interceptors"
-	"main/internal/server/auth"
 	"main/internal/server/config"
 	pb "main/proto"
 )
 
 // NewServer initializes and configures a gRPC server instance.
 // It incorporates interceptors for logging and authentication, and registers handlers for gRPC services.
-func NewServer(s *Services, c *config.Config, l *zap.SugaredLogger) (*grpc.Server, error) {
-	// Initialize JWT service for authentication purposes.
-	j, err := auth.NewJWTService(c.JWTSecret, c.JWTExpiration)
-	if err != nil {
-		return nil, err
+// The server speaks TLS when t is not nil and plaintext otherwise.
+func NewServer(s *Services, t *TLSReloader, c *config.Config, l *zap.SugaredLogger) (*grpc.Server, error) {
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			interceptors.LoggerInterceptor(l),                        // Logging interceptor.
+			interceptors.AuthInterceptor(s.sessions, s.certificates), // Authentication interceptor.
+		),
+		grpc.ChainStreamInterceptor(
+			interceptors.LoggerStreamInterceptor(l),                        // Logging interceptor.
+			interceptors.AuthStreamInterceptor(s.sessions, s.certificates), // Authentication interceptor.
+		),
+	}
+	if t != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(t.Config())))
+	} else {
+		l.Warnw("gRPC server runs without TLS; credentials and tokens are sent in plaintext", "addr", c.GRPCAddr)
 	}
 
 	// Instantiate a new gRPC server with chained interceptors for logging and authentication.
-	srv := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			interceptors.LoggerInterceptor(l), // Logging interceptor.
-			interceptors.AuthInterceptor(j),   // Authentication interceptor.
-		),
-	)
+	srv := grpc.NewServer(opts...)
 
 	// Register gRPC service handlers for respective domains.
-	pb.RegisterUsersServer(srv, handlers.NewUsersHandler(s.users, j))             // Handler for user-related RPCs.
-	pb.RegisterBinariesServer(srv, handlers.NewBinariesHandler(s.binaries, j))    // Handler for binary data-related RPCs.
-	pb.RegisterPasswordsServer(srv, handlers.NewPasswordsHandler(s.passwords, j)) // Handler for password-related RPCs.
-	pb.RegisterCardsServer(srv, handlers.NewCardsHandler(s.cards, j))             // Handler for credit card-related RPCs.
+	pb.RegisterUsersServer(srv, handlers.NewUsersHandler(s.users, s.sessions, s.totp, s.certificates, s.records)) // Handler for user-related RPCs.
+	pb.RegisterBinariesServer(srv, handlers.NewBinariesHandler(s.binaries, s.jwt))                                // Handler for binary data-related RPCs.
+	pb.RegisterPasswordsServer(srv, handlers.NewPasswordsHandler(s.passwords, s.jwt))                             // Handler for password-related RPCs.
+	pb.RegisterCardsServer(srv, handlers.NewCardsHandler(s.cards, s.jwt))                                         // Handler for credit card-related RPCs.
+	pb.RegisterNotesServer(srv, handlers.NewNotesHandler(s.notes, s.jwt))                                         // Handler for secure note-related RPCs.
+	pb.RegisterOTPServer(srv, handlers.NewOTPHandler(s.otp, s.jwt))                                               // Handler for authenticator secret-related RPCs.
+	pb.RegisterSSHKeysServer(srv, handlers.NewSSHKeysHandler(s.sshKeys, s.jwt))                                   // Handler for SSH key-related RPCs.
+	pb.RegisterFoldersServer(srv, handlers.NewFoldersHandler(s.folders))                                          // Handler for folder-related RPCs.
+	pb.RegisterTrashServer(srv, handlers.NewTrashHandler(s.trash))                                                // Handler for trash-related RPCs.
 
 	return srv, nil
 }
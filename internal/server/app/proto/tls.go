@@ -0,0 +1,91 @@
+package proto
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"main/internal/server/config"
+	"os"
+	"sync/atomic"
+)
+
+// TLSReloader serves the TLS configuration built from the configured files and rebuilds it on demand,
+// so certificates can be replaced without restarting the server. Connections already established
+// keep the configuration they were accepted with.
+type TLSReloader struct {
+	certFile   string                     // Server certificate chain in PEM format.
+	keyFile    string                     // Private key of the server certificate.
+	clientCA   string                     // CA bundle verifying client certificates; empty disables client certificates.
+	clientAuth tls.ClientAuthType         // Policy for client certificates.
+	current    atomic.Pointer[tls.Config] // Configuration handed to new connections.
+}
+
+// NewTLSReloader loads the TLS files named in the configuration.
+func NewTLSReloader(c *config.Config) (*TLSReloader, error) {
+	r := &TLSReloader{
+		certFile: c.TLSCertFile,
+		keyFile:  c.TLSKeyFile,
+		clientCA: c.TLSClientCA,
+	}
+
+	switch c.TLSClientAuth {
+	case config.ClientAuthNone:
+		r.clientAuth = tls.NoClientCert
+	case config.ClientAuthOptional:
+		r.clientAuth = tls.VerifyClientCertIfGiven
+	case config.ClientAuthRequire:
+		r.clientAuth = tls.RequireAndVerifyClientCert
+	default:
+		return nil, fmt.Errorf("unsupported client auth mode: %s", c.TLSClientAuth)
+	}
+	if r.clientAuth != tls.NoClientCert && r.clientCA == "" {
+		return nil, errors.New("client certificates require a client CA")
+	}
+
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reads the certificate, key and client CA files again.
+// On failure the previous configuration stays in use.
+func (r *TLSReloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("load server certificate: %w", err)
+	}
+
+	conf := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   r.clientAuth,
+		NextProtos:   []string{"h2"},
+	}
+
+	if r.clientAuth != tls.NoClientCert {
+		pem, err := os.ReadFile(r.clientCA)
+		if err != nil {
+			return fmt.Errorf("load client CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("load client CA: no certificates in %s", r.clientCA)
+		}
+		conf.ClientCAs = pool
+	}
+
+	r.current.Store(conf)
+	return nil
+}
+
+// Config returns the configuration for the gRPC server; each handshake picks up the latest reload.
+func (r *TLSReloader) Config() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.current.Load(), nil
+		},
+	}
+}
@@ -1,7 +1,8 @@
 // Package auth provides authentication functionality for the server.
 // It includes JWT token generation and verification, as well as user credential validation.
 // The main components are:
-// - Claims: Custom JWT claims structure with embedded RegisteredClaims and a UserID field
+// - Claims: Custom JWT claims structure with embedded RegisteredClaims, a UserID and a SessionID field
 // - JWTService: Service for generating and verifying JWT tokens
+// - CertificateSubject: Subject of the verified client certificate of a mutual TLS connection
 // - Error handling for unexpected signing methods and invalid tokens
 package auth
@@ -27,9 +27,9 @@ func NewJWTService(secret string, tokenExp time.Duration) (*JWTService, error) {
 	}, nil
 }
 
-// Verify validates a JWT token and extracts the associated user ID.
-// It returns the user ID if valid, otherwise an error.
-func (s *JWTService) Verify(tokenStr string) (int64, error) {
+// Verify validates a JWT token and extracts the associated user and session IDs.
+// It returns the IDs if valid, otherwise an error. Whether the session is still active is not checked here.
+func (s *JWTService) Verify(tokenStr string) (int64, int64, error) {
 	token, err := jwt.ParseWithClaims(tokenStr, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, ErrUnexpectedMethod
@@ -37,21 +37,22 @@ func (s *JWTService) Verify(tokenStr string) (int64, error) {
 		return []byte(s.secret), nil
 	})
 	if err != nil {
-		return -1, err
+		return -1, -1, err
 	}
 	claims, ok := token.Claims.(*Claims)
-	if !ok || !token.Valid {
-		return -1, ErrInvalidToken
+	if !ok || !token.Valid || claims.SessionID <= 0 {
+		return -1, -1, ErrInvalidToken
 	}
-	return claims.UserID, nil
+	return claims.UserID, claims.SessionID, nil
 }
 
-// Generate creates a signed JWT token for the given user ID.
-// The generated token will expire after the configured token expiration duration.
-func (s *JWTService) Generate(userID int64) (string, error) {
+// Generate creates a signed JWT token for the given user and session IDs.
+// The generated token will expire after the configured token expiration duration, which is returned as well.
+func (s *JWTService) Generate(userID, sessionID int64) (string, time.Time, error) {
 	expirationTime := time.Now().Add(s.tokenExp)
 	claims := &Claims{
-		UserID: userID,
+		UserID:    userID,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 		},
@@ -60,8 +61,8 @@ func (s *JWTService) Generate(userID int64) (string, error) {
 	tokenString, err := token.SignedString([]byte(s.secret))
 	if err != nil {
 		fmt.Println(err)
-		return "", err
+		return "", time.Time{}, err
 	}
 
-	return tokenString, nil
+	return tokenString, expirationTime, nil
 }
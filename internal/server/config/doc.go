@@ -7,8 +7,18 @@
 //	    DatabaseDSN   *url.URL      // Parsed DSN for the database connection.
 //	    DatabaseType  string        // Database type, e.g., "postgres".
 //	    JWTSecret     string        // Secret key used for signing JWT tokens.
-//	    JWTExpiration time.Duration // Token expiration time in hours.
-//	    CryptoSecret  string        // Secret used for encryption.
-//	    GRPCAddr      string        // Port for the gRPC server.
+//	    JWTExpiration time.Duration // Access token expiration time.
+//	    SessionTTL    time.Duration // Login session lifetime.
+//	    CryptoSecret  string            // Legacy master key for data written before key IDs.
+//	    CryptoKeys    map[uint32]string // Master keys indexed by key ID.
+//	    CryptoKeyID   uint32            // ID of the master key used for new encryptions.
+//	    CryptoStrict  bool              // Rejects ciphertexts not bound to their location.
+//	    RotationBatch int               // Rows re-encrypted per transaction during key rotation.
+//	    RotationPoll  time.Duration     // Interval between key rotation job polls.
+//	    GRPCAddr      string            // Port for the gRPC server.
+//	    TLSCertFile   string            // Server certificate; empty serves plaintext.
+//	    TLSKeyFile    string            // Private key of the server certificate.
+//	    TLSClientCA   string            // CA bundle verifying client certificates.
+//	    TLSClientAuth string            // Client certificate policy: none, optional or require.
 //	}
 package config
@@ -4,17 +4,37 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"encoding/binary"
+	"errors"
 	"io"
 )
 
+// DataKeySize is the length in bytes of generated data-encryption keys, selecting AES-256.
+const DataKeySize = 32
+
+// DataKeyID is the key ID written into ciphertexts produced with a user's data-encryption key.
+// Master keys are identified by the positive IDs configured for the keyring.
+const DataKeyID uint32 = 0
+
+// Ciphertext header layout: a format version byte followed by the big-endian key ID.
+const (
+	versionPlain = 0x01  // Format of ciphertexts sealed without associated data.
+	versionBound = 0x02  // Format of ciphertexts sealed with associated data.
+	headerSize   = 1 + 4 // Version byte plus 32-bit key ID.
+)
+
+// ErrCiphertextTooShort is returned when the data to decrypt cannot even hold a nonce.
+var ErrCiphertextTooShort = errors.New("ciphertext too short")
+
 // Aes implements AES-based symmetric encryption and decryption functionality.
 type Aes struct {
+	id  uint32      // Key ID written into the header of every produced ciphertext.
 	gcm cipher.AEAD // Galois Counter Mode (GCM) interface for performing authenticated encryption.
 }
 
-// NewAes initializes a new Aes instance with the provided encryption key.
+// NewAes initializes a new Aes instance with the provided key ID and encryption key.
 // It sets up AES block cipher mode and constructs a GCM cipher.
-func NewAes(key []byte) (*Aes, error) {
+func NewAes(id uint32, key []byte) (*Aes, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, err
@@ -25,33 +45,100 @@ func NewAes(key []byte) (*Aes, error) {
 		return nil, err
 	}
 
-	return &Aes{aesGCM}, nil
+	return &Aes{id: id, gcm: aesGCM}, nil
+}
+
+// ID returns the key ID this instance writes into ciphertext headers.
+func (a *Aes) ID() uint32 {
+	return a.id
 }
 
 // Encrypt encrypts input plaintext data using AES-GCM encryption scheme.
-// It generates a random nonce, seals the data, and appends the nonce at the beginning of the encrypted output.
+// The output consists of a header carrying the key ID, a random nonce, and the sealed data.
 func (a *Aes) Encrypt(data []byte) ([]byte, error) {
+	return a.Seal(data, nil)
+}
+
+// Decrypt decrypts previously encrypted data back to its original form.
+// Ciphertexts produced before key IDs were introduced carry no header and are accepted as well.
+func (a *Aes) Decrypt(ciphertext []byte) ([]byte, error) {
+	return a.Open(ciphertext, nil)
+}
+
+// Seal encrypts data like Encrypt, additionally authenticating the associated data.
+// The same associated data must be presented to Open, so a ciphertext moved to another
+// context fails authentication. A nil associated data produces an unbound ciphertext.
+func (a *Aes) Seal(data, ad []byte) ([]byte, error) {
 	nonce := make([]byte, a.gcm.NonceSize())
 	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
 		return nil, err
 	}
 
-	res := a.gcm.Seal(nil, nonce, data, nil)
-	res = append(nonce, res...)
+	res := make([]byte, headerSize, headerSize+len(nonce)+len(data)+a.gcm.Overhead())
+	res[0] = versionPlain
+	if ad != nil {
+		res[0] = versionBound
+	}
+	binary.BigEndian.PutUint32(res[1:headerSize], a.id)
+	res = append(res, nonce...)
+	res = a.gcm.Seal(res, nonce, data, ad)
 
 	return res, nil
 }
 
-// Decrypt decrypts previously encrypted data back to its original form.
-// It separates the nonce from the ciphertext and uses it for decryption via GCM.
-func (a *Aes) Decrypt(ciphertext []byte) ([]byte, error) {
+// Open decrypts a ciphertext produced by Seal with the same associated data.
+// Unbound ciphertexts, including headerless legacy ones, are opened without associated data.
+func (a *Aes) Open(ciphertext, ad []byte) ([]byte, error) {
+	if id, ok := KeyID(ciphertext); ok && id == a.id {
+		bound := ciphertext[0] == versionBound
+		if !bound {
+			ad = nil
+		}
+		if res, err := a.open(ciphertext[headerSize:], ad); err == nil || bound {
+			return res, err
+		}
+	}
+	return a.open(ciphertext, nil)
+}
+
+// open separates the nonce from the sealed data and decrypts it via GCM.
+func (a *Aes) open(ciphertext, ad []byte) ([]byte, error) {
 	nonceSize := a.gcm.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, ErrCiphertextTooShort
+	}
 	nonce, ciphertext := ciphertext[:nonceSize], ciphertext[nonceSize:]
 
-	res, err := a.gcm.Open(nil, nonce, ciphertext, nil)
+	res, err := a.gcm.Open(nil, nonce, ciphertext, ad)
 	if err != nil {
 		return nil, err
 	}
 
 	return res, nil
 }
+
+// KeyID extracts the key ID from the ciphertext header.
+// The second result is false if the data does not start with a recognizable header; legacy
+// ciphertexts without a header may still be reported as headed by chance, so callers must
+// fall back to headerless decryption when the indicated key fails.
+func KeyID(ciphertext []byte) (uint32, bool) {
+	if len(ciphertext) < headerSize || (ciphertext[0] != versionPlain && ciphertext[0] != versionBound) {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(ciphertext[1:headerSize]), true
+}
+
+// IsBound reports whether the ciphertext header declares it sealed with associated data.
+func IsBound(ciphertext []byte) bool {
+	_, ok := KeyID(ciphertext)
+	return ok && ciphertext[0] == versionBound
+}
+
+// NewDataKey generates a random data-encryption key suitable for NewAes.
+func NewDataKey() ([]byte, error) {
+	key := make([]byte, DataKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
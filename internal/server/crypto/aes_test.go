@@ -0,0 +1,64 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestAesSealOpen(t *testing.T) {
+	key := testKey(t)
+	a, err := NewAes(1, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []byte("secret")
+	ad := []byte("user/1/passwords/password/42")
+
+	seal := func(ad []byte) []byte {
+		ct, err := a.Seal(data, ad)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ct
+	}
+	tampered := seal(ad)
+	tampered[len(tampered)-1] ^= 0x01
+
+	tests := []struct {
+		name       string
+		ciphertext []byte
+		ad         []byte
+		wantBound  bool
+		wantErr    bool
+	}{
+		{"bound with matching data", seal(ad), ad, true, false},
+		{"bound moved to another record", seal(ad), []byte("user/1/passwords/password/43"), true, true},
+		{"bound moved to another field", seal(ad), []byte("user/1/passwords/login/42"), true, true},
+		{"bound opened without data", seal(ad), nil, true, true},
+		{"unbound ignores data", seal(nil), ad, false, false},
+		{"headerless legacy ciphertext", legacyCiphertext(t, key, data), ad, false, false},
+		{"tampered", tampered, ad, true, true},
+		{"too short", []byte{versionBound, 0, 0, 0, 1}, ad, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if bound := IsBound(tt.ciphertext); bound != tt.wantBound {
+				t.Errorf("IsBound() = %v, want %v", bound, tt.wantBound)
+			}
+
+			got, err := a.Open(tt.ciphertext, tt.ad)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Open() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Open() error = %v", err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Errorf("Open() = %q, want %q", got, data)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 // Package crypto provides cryptographic utilities for the server application.
-// It includes symmetric encryption using AES-GCM and password hashing using bcrypt.
+// It includes symmetric encryption using AES-GCM, password hashing using bcrypt and TOTP codes.
 //
 // The package contains the following main components:
 //
@@ -10,6 +10,13 @@
 //
 //   - Decrypt(ciphertext []byte) ([]byte, error): Extracts the nonce and decrypts data.
 //
+//   - Seal(data, ad []byte) / Open(ciphertext, ad []byte): Same as above, authenticating associated data.
+//
+//   - NewDataKey() ([]byte, error): Generates a random AES-256 key for per-user data encryption.
+//
+//   - TOTP (RFC 6238): NewTOTPSecret, TOTPURI and TOTPCode generate secrets and codes;
+//     VerifyTOTP accepts a code within one period of clock drift and rejects replayed time steps.
+//
 //   - PassCrypto: Structure for password hashing and verification.
 //     Methods:
 //
@@ -18,4 +25,5 @@
 //   - CheckPassword(password string, hashedPassword string) error: Compares a plain text password with its hashed version.
 //
 // The configuration for the crypto package is provided via a secret key for AES operations.
+// That master key only wraps the per-user data keys; see services.KeysService.
 package crypto
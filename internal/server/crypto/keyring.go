@@ -0,0 +1,86 @@
+package crypto
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownKey is returned when a ciphertext references a master key missing from the keyring.
+var ErrUnknownKey = errors.New("unknown master key")
+
+// Keyring holds every configured master key, identified by key ID.
+// New ciphertexts are always produced with the active key, while ciphertexts produced with any
+// other configured key remain readable, which allows rotating the master key without downtime.
+type Keyring struct {
+	keys   map[uint32]*Aes // Configured master keys indexed by key ID.
+	active *Aes            // Key used for all new encryptions.
+	legacy *Aes            // Key that produced headerless ciphertexts; nil if there is none.
+}
+
+// NewKeyring builds a keyring from raw master keys indexed by key ID.
+// The legacy key, if not nil, is used for ciphertexts written before key IDs were introduced.
+func NewKeyring(keys map[uint32][]byte, activeID uint32, legacy []byte) (*Keyring, error) {
+	k := &Keyring{keys: make(map[uint32]*Aes, len(keys))}
+
+	for id, raw := range keys {
+		if id == DataKeyID {
+			return nil, fmt.Errorf("master key ID %d is reserved for data keys", id)
+		}
+		key, err := NewAes(id, raw)
+		if err != nil {
+			return nil, fmt.Errorf("master key %d: %w", id, err)
+		}
+		k.keys[id] = key
+	}
+
+	active, ok := k.keys[activeID]
+	if !ok {
+		return nil, fmt.Errorf("%w: active key %d is not configured", ErrUnknownKey, activeID)
+	}
+	k.active = active
+
+	if legacy != nil {
+		key, err := NewAes(activeID, legacy)
+		if err != nil {
+			return nil, fmt.Errorf("legacy master key: %w", err)
+		}
+		k.legacy = key
+	}
+
+	return k, nil
+}
+
+// ActiveKeyID returns the ID of the key used for new encryptions.
+func (k *Keyring) ActiveKeyID() uint32 {
+	return k.active.ID()
+}
+
+// IsCurrent reports whether the ciphertext was produced with the active key.
+func (k *Keyring) IsCurrent(ciphertext []byte) bool {
+	id, ok := KeyID(ciphertext)
+	return ok && id == k.active.ID()
+}
+
+// Encrypt encrypts data with the active master key.
+func (k *Keyring) Encrypt(data []byte) ([]byte, error) {
+	return k.active.Encrypt(data)
+}
+
+// Decrypt decrypts data with the master key referenced by its header,
+// falling back to the legacy key for ciphertexts without one.
+func (k *Keyring) Decrypt(ciphertext []byte) ([]byte, error) {
+	err := ErrUnknownKey
+	if id, ok := KeyID(ciphertext); ok {
+		if key, ok := k.keys[id]; ok {
+			var res []byte
+			if res, err = key.Decrypt(ciphertext); err == nil {
+				return res, nil
+			}
+		}
+	}
+
+	if k.legacy != nil {
+		return k.legacy.open(ciphertext, nil)
+	}
+	return nil, err
+}
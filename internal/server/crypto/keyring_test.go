@@ -0,0 +1,157 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"testing"
+)
+
+// errAny stands for any error in the test tables.
+var errAny = errors.New("any error")
+
+// testKey returns a random AES-256 key.
+func testKey(t *testing.T) []byte {
+	t.Helper()
+
+	key := make([]byte, DataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// legacyCiphertext seals data under key the way ciphertexts were written before key IDs: nonce and sealed data.
+// The nonce starts with a zero byte, so the ciphertext never passes for one with a header.
+func legacyCiphertext(t *testing.T, key, data []byte) []byte {
+	t.Helper()
+
+	a, err := NewAes(0, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce := make([]byte, a.gcm.NonceSize())
+	if _, err := rand.Read(nonce[1:]); err != nil {
+		t.Fatal(err)
+	}
+	return a.gcm.Seal(nonce, nonce, data, nil)
+}
+
+func TestKeyID(t *testing.T) {
+	tests := []struct {
+		name       string
+		ciphertext []byte
+		wantID     uint32
+		wantOK     bool
+	}{
+		{"plain header", []byte{versionPlain, 0, 0, 0, 7, 0xAA}, 7, true},
+		{"bound header", []byte{versionBound, 0, 0, 1, 0}, 256, true},
+		{"data key", []byte{versionBound, 0, 0, 0, 0}, DataKeyID, true},
+		{"unknown version", []byte{0x03, 0, 0, 0, 7}, 0, false},
+		{"too short", []byte{versionPlain, 0, 0, 7}, 0, false},
+		{"empty", nil, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := KeyID(tt.ciphertext)
+			if id != tt.wantID || ok != tt.wantOK {
+				t.Errorf("KeyID() = %d, %v; want %d, %v", id, ok, tt.wantID, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestNewKeyring(t *testing.T) {
+	key := testKey(t)
+
+	tests := []struct {
+		name     string
+		keys     map[uint32][]byte
+		activeID uint32
+		legacy   []byte
+		wantErr  error
+	}{
+		{"single key", map[uint32][]byte{1: key}, 1, nil, nil},
+		{"with legacy key", map[uint32][]byte{1: key}, 1, testKey(t), nil},
+		{"active key missing", map[uint32][]byte{1: key}, 2, nil, ErrUnknownKey},
+		{"reserved data key ID", map[uint32][]byte{DataKeyID: key}, DataKeyID, nil, errAny},
+		{"short master key", map[uint32][]byte{1: key[:7]}, 1, nil, errAny},
+		{"short legacy key", map[uint32][]byte{1: key}, 1, key[:7], errAny},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := NewKeyring(tt.keys, tt.activeID, tt.legacy)
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Fatalf("NewKeyring() error = %v", err)
+			case tt.wantErr == nil && k.ActiveKeyID() != tt.activeID:
+				t.Errorf("ActiveKeyID() = %d, want %d", k.ActiveKeyID(), tt.activeID)
+			case tt.wantErr == errAny && err == nil:
+				t.Error("NewKeyring() succeeded, want an error")
+			case tt.wantErr != nil && tt.wantErr != errAny && !errors.Is(err, tt.wantErr):
+				t.Errorf("NewKeyring() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeyringDecrypt(t *testing.T) {
+	oldKey, newKey, legacyKey := testKey(t), testKey(t), testKey(t)
+	data := []byte("secret")
+
+	previous, err := NewKeyring(map[uint32][]byte{1: oldKey}, 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotated, err := NewKeyring(map[uint32][]byte{1: oldKey, 2: newKey}, 2, legacyKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unrelated, err := NewKeyring(map[uint32][]byte{3: testKey(t)}, 3, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypt := func(k *Keyring) []byte {
+		ct, err := k.Encrypt(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ct
+	}
+
+	tests := []struct {
+		name        string
+		keyring     *Keyring
+		ciphertext  []byte
+		wantCurrent bool
+		wantErr     bool
+	}{
+		{"active key", rotated, encrypt(rotated), true, false},
+		{"previous key", rotated, encrypt(previous), false, false},
+		{"headerless legacy ciphertext", rotated, legacyCiphertext(t, legacyKey, data), false, false},
+		{"unknown key", previous, encrypt(unrelated), false, true},
+		{"legacy ciphertext without legacy key", previous, legacyCiphertext(t, legacyKey, data), false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if current := tt.keyring.IsCurrent(tt.ciphertext); current != tt.wantCurrent {
+				t.Errorf("IsCurrent() = %v, want %v", current, tt.wantCurrent)
+			}
+
+			got, err := tt.keyring.Decrypt(tt.ciphertext)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Decrypt() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Decrypt() error = %v", err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Errorf("Decrypt() = %q, want %q", got, data)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"fmt"
+	"io"
+	"main/internal/otp"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP parameters (RFC 6238) shared with authenticator apps.
+const (
+	TOTPSecretSize = 20               // Length in bytes of generated secrets, as recommended for HMAC-SHA1.
+	TOTPDigits     = 6                // Number of digits in a code.
+	TOTPPeriod     = 30 * time.Second // Lifetime of a code.
+	TOTPSkew       = 1                // Number of periods a code is accepted before and after its own.
+)
+
+// totpEncoding is the unpadded base32 encoding authenticator apps expect secrets in.
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewTOTPSecret generates a random TOTP secret.
+func NewTOTPSecret() ([]byte, error) {
+	secret := make([]byte, TOTPSecretSize)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// EncodeTOTPSecret returns the base32 form of a secret for manual entry into an authenticator app.
+func EncodeTOTPSecret(secret []byte) string {
+	return totpEncoding.EncodeToString(secret)
+}
+
+// TOTPURI builds the otpauth:// URI authenticator apps import secrets from, usually via a QR code.
+func TOTPURI(issuer, account string, secret []byte) string {
+	params := url.Values{}
+	params.Set("secret", EncodeTOTPSecret(secret))
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(TOTPDigits))
+	params.Set("period", fmt.Sprint(int(TOTPPeriod/time.Second)))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: params.Encode(),
+	}
+	return u.String()
+}
+
+// TOTPStep returns the time step a moment falls into.
+func TOTPStep(t time.Time) int64 {
+	return t.Unix() / int64(TOTPPeriod/time.Second)
+}
+
+// TOTPCode computes the code of the given time step (HOTP of RFC 4226 with the step as counter).
+func TOTPCode(secret []byte, step int64) string {
+	code, _ := otp.HOTP(secret, otp.SHA1, TOTPDigits, uint64(step)) // SHA1 is always supported.
+	return code
+}
+
+// VerifyTOTP checks a code against the steps around t, allowing TOTPSkew periods of clock drift.
+// Steps up to lastStep are rejected, so an accepted code cannot be replayed.
+// It returns the matched step, which the caller stores as the new lastStep.
+func VerifyTOTP(secret []byte, code string, t time.Time, lastStep int64) (int64, bool) {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != TOTPDigits {
+		return 0, false
+	}
+
+	now := TOTPStep(t)
+	for step := now - TOTPSkew; step <= now+TOTPSkew; step++ {
+		if step <= lastStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(TOTPCode(secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,91 @@
+package crypto
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the shared secret of the RFC 4226 and RFC 6238 test vectors.
+var rfcSecret = []byte("12345678901234567890")
+
+func TestTOTPCode(t *testing.T) {
+	// Six-digit HOTP values of RFC 4226, appendix D.
+	tests := []struct {
+		step int64
+		want string
+	}{
+		{0, "755224"},
+		{1, "287082"},
+		{2, "359152"},
+		{3, "969429"},
+		{4, "338314"},
+		{5, "254676"},
+		{6, "287922"},
+		{7, "162583"},
+		{8, "399871"},
+		{9, "520489"},
+	}
+	for _, tt := range tests {
+		if got := TOTPCode(rfcSecret, tt.step); got != tt.want {
+			t.Errorf("TOTPCode(%d) = %s, want %s", tt.step, got, tt.want)
+		}
+	}
+}
+
+func TestVerifyTOTP(t *testing.T) {
+	now := time.Unix(59, 0) // Step 1, as in the first vector of RFC 6238.
+	step := TOTPStep(now)
+
+	tests := []struct {
+		name     string
+		code     string
+		lastStep int64
+		wantStep int64
+		wantOK   bool
+	}{
+		{"current step", TOTPCode(rfcSecret, step), -1, step, true},
+		{"previous step", TOTPCode(rfcSecret, step-1), -1, step - 1, true},
+		{"next step", TOTPCode(rfcSecret, step+1), -1, step + 1, true},
+		{"two steps late", TOTPCode(rfcSecret, step+2), -1, 0, false},
+		{"typed with spaces", " 287 082 ", -1, step, true},
+		{"replayed step", TOTPCode(rfcSecret, step), step, 0, false},
+		{"step before the last one", TOTPCode(rfcSecret, step-1), step - 1, 0, false},
+		{"later step after a replay", TOTPCode(rfcSecret, step+1), step, step + 1, true},
+		{"wrong code", "000000", -1, 0, false},
+		{"too short", "28708", -1, 0, false},
+		{"too long", "2870820", -1, 0, false},
+		{"empty", "", -1, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := VerifyTOTP(rfcSecret, tt.code, now, tt.lastStep)
+			if got != tt.wantStep || ok != tt.wantOK {
+				t.Errorf("VerifyTOTP() = %d, %v; want %d, %v", got, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestTOTPURI(t *testing.T) {
+	u, err := url.Parse(TOTPURI("GophKeeper", "alice", rfcSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/GophKeeper:alice" {
+		t.Errorf("TOTPURI() = %s, want an otpauth://totp/GophKeeper:alice URI", u)
+	}
+	want := map[string]string{
+		"secret":    "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ",
+		"issuer":    "GophKeeper",
+		"algorithm": "SHA1",
+		"digits":    "6",
+		"period":    "30",
+	}
+	for key, value := range want {
+		if got := u.Query().Get(key); got != value {
+			t.Errorf("parameter %s = %q, want %q", key, got, value)
+		}
+	}
+}
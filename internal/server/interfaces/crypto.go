@@ -1,12 +1,37 @@
 package interfaces
 
-// CryptoService defines the interface for encrypting and decrypting arbitrary byte slices.
-// Implementations must support two-way encryption for secure communication/storage of sensitive data.
-type CryptoService interface {
+import (
+	"context"
+	"main/internal/server/models"
+	"time"
+)
+
+// Cipher defines symmetric two-way encryption of arbitrary byte slices under a single fixed key.
+type Cipher interface {
 	Encrypt([]byte) ([]byte, error) // Encrypts plain text data returning the encrypted result along with any potential errors.
 	Decrypt([]byte) ([]byte, error) // Decrypts encrypted data back to its original form.
 }
 
+// Keyring extends Cipher with knowledge of the master key currently used for new encryptions.
+type Keyring interface {
+	Cipher
+	ActiveKeyID() uint32              // Returns the ID of the key used for new encryptions.
+	IsCurrent(ciphertext []byte) bool // Reports whether the ciphertext was produced with the active key.
+}
+
+// CryptoService defines the interface for encrypting and decrypting user data.
+// Implementations resolve the encryption key belonging to the owner of the record, so records of
+// different users are never protected by the same key, and bind every ciphertext to its location.
+type CryptoService interface {
+	Encrypt(ctx context.Context, cc models.CipherContext, data []byte) ([]byte, error) // Encrypts data stored at the given location.
+	Decrypt(ctx context.Context, cc models.CipherContext, data []byte) ([]byte, error) // Decrypts data read from the given location.
+}
+
+// KeyCache is implemented by crypto services keeping the unwrapped keys of users in memory.
+type KeyCache interface {
+	Forget(userID int64) // Drops the cached keys of a user.
+}
+
 // PassCryptoService outlines the contract for handling password-related security operations.
 // It includes methods for comparing passwords against hashed versions and creating new hashes.
 type PassCryptoService interface {
@@ -17,6 +42,6 @@ type PassCryptoService interface {
 // JWTService defines methods for validating and generating JSON Web Tokens (JWT).
 // Used primarily for authenticating requests between client-server communications.
 type JWTService interface {
-	Verify(tokenStr string) (int64, error) // Validates a JWT token extracting the associated user ID.
-	Generate(userID int64) (string, error) // Creates a new JWT token tied to a specific user ID.
+	Verify(tokenStr string) (int64, int64, error)                // Validates a JWT token extracting the associated user and session IDs.
+	Generate(userID, sessionID int64) (string, time.Time, error) // Creates a new JWT token tied to a user session, returning its expiration time.
 }
@@ -2,39 +2,209 @@ package interfaces
 
 import (
 	"context"
+	"io"
 	"main/internal/server/models"
+	"time"
 )
 
 // BinariesRepository defines the repository-level interface for binary data management.
 // It supports retrieval, addition, updating, and deletion of binary records associated with users.
 type BinariesRepository interface {
-	Get(ctx context.Context, title string, UserID int64) (*models.BinaryData, error) // Retrieves binary data by title and user ID.
-	Add(ctx context.Context, cond models.BinaryData) (string, error)                 // Adds new binary data.
-	Update(ctx context.Context, cond models.BinaryData) (string, error)              // Updates existing binary data.
-	Delete(ctx context.Context, title string, UserID int64) error                    // Deletes binary data by title and user ID.
+	Get(ctx context.Context, title string, UserID int64) (*models.BinaryData, error)                       // Retrieves binary data by title and user ID.
+	Touch(ctx context.Context, id int64) error                                                             // Counts a read of binary data by ID and stamps its time.
+	NextID(ctx context.Context) (int64, error)                                                             // Reserves the ID of a new binary data entry.
+	GetID(ctx context.Context, title string, UserID int64) (int64, error)                                  // Resolves the ID of binary data by title and user ID.
+	Add(ctx context.Context, cond models.BinaryData) (string, error)                                       // Adds new binary data.
+	Update(ctx context.Context, cond models.BinaryData) (string, error)                                    // Updates existing binary data.
+	Delete(ctx context.Context, title string, UserID int64) error                                          // Moves binary data to the trash by title and user ID.
+	Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error)                              // Gives binary data a new title.
+	List(ctx context.Context, filter models.ListFilter) ([]models.ListItem, error)                         // Lists a page of the user's binary data.
+	OpenChunks(ctx context.Context, id int64) (io.ReadCloser, error)                                       // Reads the ciphertext of binary data streamed before the blob store.
+	Legacy(ctx context.Context, limit int) ([]models.BinaryData, error)                                    // Lists binary data whose content is stored in the database.
+	Offload(ctx context.Context, cond models.BinaryData) (bool, error)                                     // Points binary data stored in the database to its new blob.
+	HasBlob(ctx context.Context, key string) (bool, error)                                                 // Reports whether a blob is referenced by binary data or its revisions.
+	History(ctx context.Context, title string, UserID int64) ([]models.Revision, error)                    // Lists the archived revisions of a title.
+	GetRevision(ctx context.Context, title string, UserID int64, revision int) (*models.BinaryData, error) // Retrieves an archived revision.
+}
+
+// BlobStore keeps the encrypted content of binary data outside the database.
+// Keys are generated by the caller; a blob is never modified once stored.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader) error                        // Stores the content read from r; nothing is stored if reading fails.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)                   // Reads a blob.
+	Delete(ctx context.Context, key string) error                                  // Removes a blob; removing a missing blob is not an error.
+	Walk(ctx context.Context, fn func(key string, modified time.Time) error) error // Calls fn for every stored blob.
+}
+
+// BreachCorpus looks passwords up in a local corpus of passwords exposed in known data breaches.
+type BreachCorpus interface {
+	Count(ctx context.Context, password []byte) (int64, error) // Returns how many times the password was seen in breaches; zero if never.
 }
 
 // PasswordsRepository outlines the interface for password data management.
 // Provides methods for retrieving, adding, modifying, and removing password entries linked to users.
 type PasswordsRepository interface {
-	Get(ctx context.Context, title string, UserID int64) (*models.Password, error) // Fetches password by title and user ID.
-	Add(ctx context.Context, cond models.Password) (string, error)                 // Adds a new password entry.
-	Update(ctx context.Context, cond models.Password) (string, error)              // Modifies an existing password entry.
-	Delete(ctx context.Context, title string, UserID int64) error                  // Removes a password entry by title and user ID.
+	Get(ctx context.Context, title string, UserID int64) (*models.Password, error)                       // Fetches password by title and user ID.
+	Touch(ctx context.Context, id int64) error                                                           // Counts a read of a password entry by ID and stamps its time.
+	NextID(ctx context.Context) (int64, error)                                                           // Reserves the ID of a new password entry.
+	NextFieldIDs(ctx context.Context, n int) ([]int64, error)                                            // Reserves the IDs of new custom fields.
+	GetID(ctx context.Context, title string, UserID int64) (int64, error)                                // Resolves the ID of a password entry by title and user ID.
+	Add(ctx context.Context, cond models.Password) (string, error)                                       // Adds a new password entry.
+	Update(ctx context.Context, cond models.Password) (string, error)                                    // Modifies an existing password entry.
+	Delete(ctx context.Context, title string, UserID int64) error                                        // Moves a password entry to the trash by title and user ID.
+	Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error)                            // Gives a password entry a new title.
+	List(ctx context.Context, filter models.ListFilter) ([]models.ListItem, error)                       // Lists a page of the user's password entries.
+	All(ctx context.Context, UserID int64) ([]models.Password, error)                                    // Fetches the encrypted passwords of all live entries of a user.
+	Mark(ctx context.Context, id int64, compromised bool) error                                          // Sets the breach flag of a password entry by ID.
+	History(ctx context.Context, title string, UserID int64) ([]models.Revision, error)                  // Lists the archived revisions of a title.
+	GetRevision(ctx context.Context, title string, UserID int64, revision int) (*models.Password, error) // Retrieves an archived revision.
 }
 
 // CardsRepository specifies the repository-level interface for credit card data management.
 // Supports fetching, inserting, updating, and deleting card records connected to users.
 type CardsRepository interface {
-	Get(ctx context.Context, title string, UserID int64) (*models.Card, error) // Obtains a credit card by title and user ID.
-	Add(ctx context.Context, cond models.Card) (string, error)                 // Adds a new credit card entry.
-	Update(ctx context.Context, cond models.Card) (string, error)              // Edits an existing credit card entry.
-	Delete(ctx context.Context, title string, UserID int64) error              // Eliminates a credit card by title and user ID.
+	Get(ctx context.Context, title string, UserID int64) (*models.Card, error)                       // Obtains a credit card by title and user ID.
+	Touch(ctx context.Context, id int64) error                                                       // Counts a read of a credit card by ID and stamps its time.
+	Reveal(ctx context.Context, id int64, UserID int64) error                                        // Records a reveal of the full number and security code of a credit card.
+	NextID(ctx context.Context) (int64, error)                                                       // Reserves the ID of a new credit card.
+	GetID(ctx context.Context, title string, UserID int64) (int64, error)                            // Resolves the ID of a credit card by title and user ID.
+	Add(ctx context.Context, cond models.Card) (string, error)                                       // Adds a new credit card entry.
+	Update(ctx context.Context, cond models.Card) (string, error)                                    // Edits an existing credit card entry.
+	Delete(ctx context.Context, title string, UserID int64) error                                    // Moves a credit card to the trash by title and user ID.
+	Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error)                        // Gives a credit card a new title.
+	List(ctx context.Context, filter models.ListFilter) ([]models.ListItem, error)                   // Lists a page of the user's credit cards.
+	History(ctx context.Context, title string, UserID int64) ([]models.Revision, error)              // Lists the archived revisions of a title.
+	GetRevision(ctx context.Context, title string, UserID int64, revision int) (*models.Card, error) // Retrieves an archived revision.
+}
+
+// NotesRepository specifies the repository-level interface for secure note management.
+// Supports fetching, inserting, updating, and deleting notes connected to users.
+type NotesRepository interface {
+	Get(ctx context.Context, title string, UserID int64) (*models.Note, error)                       // Obtains a note by title and user ID.
+	Touch(ctx context.Context, id int64) error                                                       // Counts a read of a note by ID and stamps its time.
+	NextID(ctx context.Context) (int64, error)                                                       // Reserves the ID of a new note.
+	GetID(ctx context.Context, title string, UserID int64) (int64, error)                            // Resolves the ID of a note by title and user ID.
+	Add(ctx context.Context, cond models.Note) (string, error)                                       // Adds a new note.
+	Update(ctx context.Context, cond models.Note) (string, error)                                    // Replaces the body of an existing note.
+	Delete(ctx context.Context, title string, UserID int64) error                                    // Moves a note to the trash by title and user ID.
+	Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error)                        // Gives a note a new title.
+	List(ctx context.Context, filter models.ListFilter) ([]models.ListItem, error)                   // Lists a page of the user's notes.
+	History(ctx context.Context, title string, UserID int64) ([]models.Revision, error)              // Lists the archived revisions of a title.
+	GetRevision(ctx context.Context, title string, UserID int64, revision int) (*models.Note, error) // Retrieves an archived revision.
+}
+
+// OTPRepository specifies the repository-level interface for authenticator secret management.
+// Supports fetching, inserting, updating, and deleting authenticator secrets connected to users.
+type OTPRepository interface {
+	Get(ctx context.Context, title string, UserID int64) (*models.OTP, error)                       // Obtains an authenticator secret by title and user ID.
+	Touch(ctx context.Context, id int64) error                                                      // Counts a read of an authenticator secret by ID and stamps its time.
+	NextID(ctx context.Context) (int64, error)                                                      // Reserves the ID of a new authenticator secret.
+	GetID(ctx context.Context, title string, UserID int64) (int64, error)                           // Resolves the ID of an authenticator secret by title and user ID.
+	Add(ctx context.Context, cond models.OTP) (string, error)                                       // Adds a new authenticator secret.
+	Update(ctx context.Context, cond models.OTP) (string, error)                                    // Replaces an existing authenticator secret.
+	Delete(ctx context.Context, title string, UserID int64) error                                   // Moves an authenticator secret to the trash by title and user ID.
+	Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error)                       // Gives an authenticator secret a new title.
+	List(ctx context.Context, filter models.ListFilter) ([]models.ListItem, error)                  // Lists a page of the user's authenticator secrets.
+	History(ctx context.Context, title string, UserID int64) ([]models.Revision, error)             // Lists the archived revisions of a title.
+	GetRevision(ctx context.Context, title string, UserID int64, revision int) (*models.OTP, error) // Retrieves an archived revision.
+}
+
+// SSHKeysRepository specifies the repository-level interface for SSH key management.
+// Supports fetching, inserting, updating, and deleting SSH key pairs connected to users.
+type SSHKeysRepository interface {
+	Get(ctx context.Context, title string, UserID int64) (*models.SSHKey, error)                       // Obtains an SSH key by title and user ID.
+	Touch(ctx context.Context, id int64) error                                                         // Counts a read of an SSH key by ID and stamps its time.
+	NextID(ctx context.Context) (int64, error)                                                         // Reserves the ID of a new SSH key.
+	GetID(ctx context.Context, title string, UserID int64) (int64, error)                              // Resolves the ID of an SSH key by title and user ID.
+	Add(ctx context.Context, cond models.SSHKey) (string, error)                                       // Adds a new SSH key.
+	Update(ctx context.Context, cond models.SSHKey) (string, error)                                    // Replaces the key pair of an existing SSH key.
+	Delete(ctx context.Context, title string, UserID int64) error                                      // Moves an SSH key to the trash by title and user ID.
+	Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error)                          // Gives an SSH key a new title.
+	List(ctx context.Context, filter models.ListFilter) ([]models.ListItem, error)                     // Lists a page of the user's SSH keys.
+	PublicKeys(ctx context.Context, UserID int64) ([]models.SSHPublicKey, error)                       // Lists the public keys of all the user's SSH keys.
+	History(ctx context.Context, title string, UserID int64) ([]models.Revision, error)                // Lists the archived revisions of a title.
+	GetRevision(ctx context.Context, title string, UserID int64, revision int) (*models.SSHKey, error) // Retrieves an archived revision.
+}
+
+// FoldersRepository defines storage of the folder tree of users and of the placement of their items.
+// Items are identified by their kind, the name of the table holding them.
+type FoldersRepository interface {
+	List(ctx context.Context, userID int64) ([]models.Folder, error)                               // Lists every folder of a user.
+	Add(ctx context.Context, cond models.Folder) (int64, error)                                    // Creates a folder.
+	Move(ctx context.Context, cond models.Folder) error                                            // Renames a folder and changes its parent.
+	Remove(ctx context.Context, id, userID int64) error                                            // Removes an empty folder.
+	Place(ctx context.Context, kind, title string, userID int64, placement models.Placement) error // Moves a live item to a folder and replaces its tags.
+	Placement(ctx context.Context, kind string, id int64) (*models.Placement, error)               // Retrieves the folder ID and the tags of an item.
+}
+
+// TrashRepository defines storage of deleted records of every kind waiting to be restored or purged.
+type TrashRepository interface {
+	List(ctx context.Context, userID int64, kind string) ([]models.TrashItem, error)      // Lists the trashed records of a user, of one kind or of all.
+	Restore(ctx context.Context, userID int64, kind, title string) (string, error)        // Moves a record out of the trash.
+	Purge(ctx context.Context, userID int64, kind, title string) error                    // Permanently removes a trashed record.
+	Expired(ctx context.Context, before time.Time, limit int) ([]models.TrashItem, error) // Lists records of all users trashed before a point in time.
+}
+
+// RecordsRepository defines storage of the IDs of records of every kind, including IDs reserved for records
+// that are yet to be created. Records are identified by their kind, the name of the table holding them.
+type RecordsRepository interface {
+	ID(ctx context.Context, kind, title string, userID int64) (int64, error)                  // Resolves the ID of a live record by title.
+	Reserve(ctx context.Context, kind string, userID int64, expired time.Time) (int64, error) // Reserves an ID, dropping reservations made before expired.
+	Claim(ctx context.Context, kind string, id, userID int64, since time.Time) error          // Consumes a reservation made since the given time.
 }
 
 // UsersRepository defines the interface for user account management.
 // Includes methods for registering new users and logging them in.
 type UsersRepository interface {
-	Register(ctx context.Context, cond models.User) (int64, error) // Registers a new user account.
-	Login(ctx context.Context, Login string) (*models.User, error) // Logs in a user by checking their credentials.
+	Register(ctx context.Context, cond models.User) (int64, error)         // Registers a new user account.
+	Login(ctx context.Context, Login string) (*models.User, error)         // Logs in a user by checking their credentials.
+	Vault(ctx context.Context, userID int64) (*models.VaultParams, error)  // Retrieves vault parameters; nil if the user has no vault.
+	SetHistoryRetention(ctx context.Context, userID int64, keep int) error // Changes the number of revisions kept per record.
+}
+
+// SessionsRepository defines storage for login sessions; refresh tokens are only stored as hashes.
+type SessionsRepository interface {
+	Add(ctx context.Context, session models.Session, refreshHash []byte) (*models.Session, error) // Creates a session.
+	Rotate(ctx context.Context, oldHash, newHash []byte) (*models.Session, error)                 // Replaces the refresh token of an active session.
+	RevokeReused(ctx context.Context, previousHash []byte) (bool, error)                          // Revokes the session a superseded refresh token belonged to.
+	IsActive(ctx context.Context, sessionID, userID int64) (bool, error)                          // Reports whether a session is neither revoked nor expired.
+	List(ctx context.Context, userID int64) ([]models.Session, error)                             // Lists the active sessions of a user.
+	Revoke(ctx context.Context, sessionID, userID int64) error                                    // Revokes an active session of a user.
+}
+
+// TOTPRepository defines storage for TOTP secrets, recovery codes and login challenges;
+// recovery codes and challenges are only stored as hashes.
+type TOTPRepository interface {
+	Save(ctx context.Context, userID int64, secret []byte) (string, error)                           // Stores an unconfirmed secret, returning the user's login.
+	Get(ctx context.Context, userID int64) (*models.TOTP, error)                                     // Retrieves the secret of a user.
+	Confirm(ctx context.Context, userID, step int64, codeHashes [][]byte) error                      // Enables the secret and replaces the recovery codes.
+	UseStep(ctx context.Context, userID, step int64) (bool, error)                                   // Records an accepted code, rejecting replays.
+	UseRecoveryCode(ctx context.Context, userID int64, codeHash []byte) (bool, error)                // Spends an unused recovery code.
+	Delete(ctx context.Context, userID int64) error                                                  // Removes the secret and recovery codes of a user.
+	AddChallenge(ctx context.Context, userID int64, challengeHash []byte, expiresAt time.Time) error // Stores a login challenge.
+	TakeChallenge(ctx context.Context, challengeHash []byte, maxAttempts int) (int64, error)         // Counts an attempt against a challenge and returns its user.
+	DeleteChallenge(ctx context.Context, challengeHash []byte) error                                 // Removes a completed challenge.
+}
+
+// CertificatesRepository defines storage for client certificate subjects mapped to users.
+type CertificatesRepository interface {
+	Add(ctx context.Context, userID int64, subject string, sessionID int64) error // Maps a subject to a user and session.
+	Delete(ctx context.Context, userID int64, subject string) (int64, error)      // Removes a subject mapped to a user, returning its session.
+	UserID(ctx context.Context, subject string) (int64, int64, error)             // Resolves the user and active session of a subject.
+	List(ctx context.Context, userID int64) ([]models.Certificate, error)         // Lists the subjects mapped to a user.
+}
+
+// UserKeysRepository defines storage for per-user data-encryption keys wrapped by the master key.
+type UserKeysRepository interface {
+	Get(ctx context.Context, userID int64) ([]byte, error)                 // Retrieves the wrapped key of a user.
+	Add(ctx context.Context, userID int64, wrapped []byte) ([]byte, error) // Stores a wrapped key, returning the key that ends up persisted.
+}
+
+// KeyRotationsRepository defines persistence of master key rotation jobs.
+// Advance processes one batch of rows in a transaction, passing each row to the callback for rewriting.
+type KeyRotationsRepository interface {
+	Start(ctx context.Context, masterKeyID uint32) (*models.KeyRotation, error) // Creates a rotation job for the given key.
+	List(ctx context.Context, limit int) ([]models.KeyRotation, error)          // Lists the most recent rotation jobs.
+	Advance(ctx context.Context, masterKeyID uint32, batch int,
+		fn func(ctx context.Context, phase string, rec *models.EncryptedRecord) (bool, error)) (*models.KeyRotation, error) // Advances the pending job by one batch.
 }
@@ -2,39 +2,170 @@ package interfaces
 
 import (
 	"context"
+	"io"
+	"main/internal/audit"
+	"main/internal/passgen"
 	"main/internal/server/models"
+	"time"
 )
 
 // BinariesService defines the business logic layer for managing binary data entities.
 // Implements methods for retrieving, adding, updating, and deleting binary resources.
 type BinariesService interface {
-	Get(ctx context.Context, title string, UserID int64) (*models.BinaryData, error) // Retrieves binary data by title and user ID.
-	Add(ctx context.Context, cond models.BinaryData) (string, error)                 // Adds new binary resource.
-	Update(ctx context.Context, cond models.BinaryData) (string, error)              // Updates existing binary resource.
-	Delete(ctx context.Context, title string, UserID int64) error                    // Deletes binary resource by title and user ID.
+	Get(ctx context.Context, title string, UserID int64) (*models.BinaryData, error)                     // Retrieves binary data by title and user ID.
+	Add(ctx context.Context, cond models.BinaryData) (string, error)                                     // Adds new binary resource.
+	Update(ctx context.Context, cond models.BinaryData) (string, error)                                  // Updates existing binary resource.
+	Delete(ctx context.Context, title string, UserID int64) error                                        // Moves binary resource to the trash by title and user ID.
+	Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error)                            // Gives a binary resource a new title.
+	List(ctx context.Context, query models.ListQuery) (*models.ListPage, error)                          // Lists a page of the user's binary resources.
+	Upload(ctx context.Context, cond models.BinaryData) (BinaryUpload, error)                            // Starts a streamed upload of a new binary resource.
+	Download(ctx context.Context, title string, UserID int64) (*models.BinaryData, io.ReadCloser, error) // Streams the content of a binary resource.
+	Offload(ctx context.Context, batch int) (int, error)                                                 // Moves binary resources stored in the database to the blob store.
+	CollectGarbage(ctx context.Context, grace time.Duration) (int, error)                                // Removes blobs no binary resource refers to.
+	History(ctx context.Context, title string, UserID int64) ([]models.Revision, error)                  // Lists the archived revisions of a binary resource.
+	Restore(ctx context.Context, title string, UserID int64, revision int) (string, error)               // Brings back an archived revision of a binary resource.
+}
+
+// BinaryUpload receives the content of a streamed binary resource.
+// Nothing is stored unless Commit succeeds.
+type BinaryUpload interface {
+	io.Writer
+	Commit(sum []byte) (string, error) // Checks the SHA-256 checksum of the content and stores the resource.
+	Abort() error                      // Discards the resource.
 }
 
 // PasswordsService outlines the service-layer interface for password data management.
 // Contains methods for getting, adding, updating, and removing password records belonging to users.
 type PasswordsService interface {
-	Get(ctx context.Context, title string, UserID int64) (*models.Password, error) // Fetches password by title and user ID.
-	Add(ctx context.Context, cond models.Password) (string, error)                 // Adds a new password entry.
-	Update(ctx context.Context, cond models.Password) (string, error)              // Modifies an existing password entry.
-	Delete(ctx context.Context, title string, UserID int64) error                  // Removes a password entry by title and user ID.
+	Get(ctx context.Context, title string, UserID int64) (*models.Password, error)         // Fetches password by title and user ID.
+	Add(ctx context.Context, cond models.Password) (string, error)                         // Adds a new password entry.
+	Update(ctx context.Context, cond models.Password) (string, error)                      // Modifies an existing password entry.
+	Delete(ctx context.Context, title string, UserID int64) error                          // Moves a password entry to the trash by title and user ID.
+	Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error)              // Gives a password entry a new title.
+	List(ctx context.Context, query models.ListQuery) (*models.ListPage, error)            // Lists a page of the user's password entries.
+	History(ctx context.Context, title string, UserID int64) ([]models.Revision, error)    // Lists the archived revisions of a password entry.
+	Restore(ctx context.Context, title string, UserID int64, revision int) (string, error) // Brings back an archived revision of a password entry.
+	Audit(ctx context.Context, UserID int64, policy audit.Policy) (*audit.Report, error)   // Reports the weak, reused, stale and breached passwords of a user.
+	AuditEntries(ctx context.Context, UserID int64) ([]models.Password, error)             // Fetches the sealed passwords of a vault account for a client-side audit.
+	Generate(ctx context.Context, policy passgen.Policy) (string, error)                   // Generates a password following a policy.
 }
 
 // CardsService specifies the business logic for credit card data management.
 // Offers methods for obtaining, saving, editing, and erasing credit card records linked to users.
 type CardsService interface {
-	Get(ctx context.Context, title string, UserID int64) (*models.Card, error) // Gets a credit card by title and user ID.
-	Add(ctx context.Context, cond models.Card) (string, error)                 // Adds a new credit card entry.
-	Update(ctx context.Context, cond models.Card) (string, error)              // Updates an existing credit card entry.
-	Delete(ctx context.Context, title string, UserID int64) error              // Deletes a credit card entry by title and user ID.
+	Get(ctx context.Context, title string, UserID int64) (*models.Card, error)             // Gets a credit card by title and user ID with its number masked.
+	Reveal(ctx context.Context, title string, UserID int64) (*models.Card, error)          // Gets a credit card with its full number and security code, recording the reveal.
+	Add(ctx context.Context, cond models.Card) (string, error)                             // Adds a new credit card entry.
+	Update(ctx context.Context, cond models.Card) (string, error)                          // Updates an existing credit card entry.
+	Delete(ctx context.Context, title string, UserID int64) error                          // Moves a credit card entry to the trash by title and user ID.
+	Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error)              // Gives a credit card entry a new title.
+	List(ctx context.Context, query models.ListQuery) (*models.ListPage, error)            // Lists a page of the user's credit cards.
+	History(ctx context.Context, title string, UserID int64) ([]models.Revision, error)    // Lists the archived revisions of a credit card.
+	Restore(ctx context.Context, title string, UserID int64, revision int) (string, error) // Brings back an archived revision of a credit card.
+}
+
+// NotesService specifies the business logic for secure note management.
+// Offers methods for obtaining, saving, editing, and erasing free-form text notes linked to users.
+type NotesService interface {
+	Get(ctx context.Context, title string, UserID int64) (*models.Note, error)             // Gets a note by title and user ID.
+	Add(ctx context.Context, cond models.Note) (string, error)                             // Adds a new note.
+	Update(ctx context.Context, cond models.Note) (string, error)                          // Replaces the body of an existing note.
+	Delete(ctx context.Context, title string, UserID int64) error                          // Moves a note to the trash by title and user ID.
+	Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error)              // Gives a note a new title.
+	List(ctx context.Context, query models.ListQuery) (*models.ListPage, error)            // Lists a page of the user's notes.
+	History(ctx context.Context, title string, UserID int64) ([]models.Revision, error)    // Lists the archived revisions of a note.
+	Restore(ctx context.Context, title string, UserID int64, revision int) (string, error) // Brings back an archived revision of a note.
+}
+
+// OTPService specifies the business logic for authenticator secret management and code generation.
+type OTPService interface {
+	Get(ctx context.Context, title string, UserID int64) (*models.OTP, error)              // Gets an authenticator secret by title and user ID.
+	Add(ctx context.Context, cond models.OTP) (string, error)                              // Adds a new authenticator secret.
+	Update(ctx context.Context, cond models.OTP) (string, error)                           // Replaces an existing authenticator secret.
+	Delete(ctx context.Context, title string, UserID int64) error                          // Moves an authenticator secret to the trash by title and user ID.
+	Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error)              // Gives an authenticator secret a new title.
+	List(ctx context.Context, query models.ListQuery) (*models.ListPage, error)            // Lists a page of the user's authenticator secrets.
+	History(ctx context.Context, title string, UserID int64) ([]models.Revision, error)    // Lists the archived revisions of an authenticator secret.
+	Restore(ctx context.Context, title string, UserID int64, revision int) (string, error) // Brings back an archived revision of an authenticator secret.
+	GenerateCode(ctx context.Context, title string, UserID int64) (*models.OTPCode, error) // Computes the current code of an authenticator secret.
+}
+
+// SSHKeysService specifies the business logic for SSH key management.
+// Public keys are validated and fingerprinted by the service, private keys are kept encrypted.
+type SSHKeysService interface {
+	Get(ctx context.Context, title string, UserID int64) (*models.SSHKey, error)           // Gets an SSH key by title and user ID.
+	Add(ctx context.Context, cond models.SSHKey) (string, error)                           // Adds a new SSH key.
+	Update(ctx context.Context, cond models.SSHKey) (string, error)                        // Replaces the key pair of an existing SSH key.
+	Delete(ctx context.Context, title string, UserID int64) error                          // Moves an SSH key to the trash by title and user ID.
+	Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error)              // Gives an SSH key a new title.
+	List(ctx context.Context, query models.ListQuery) (*models.ListPage, error)            // Lists a page of the user's SSH keys.
+	PublicKeys(ctx context.Context, UserID int64) ([]models.SSHPublicKey, error)           // Lists the public keys of all the user's SSH keys.
+	History(ctx context.Context, title string, UserID int64) ([]models.Revision, error)    // Lists the archived revisions of an SSH key.
+	Restore(ctx context.Context, title string, UserID int64, revision int) (string, error) // Brings back an archived revision of an SSH key.
+}
+
+// FoldersService defines the management of the folder tree items are placed in.
+// Folders are addressed by slash-separated paths; the empty path is the root.
+type FoldersService interface {
+	Create(ctx context.Context, userID int64, path string) error                                   // Creates a folder along with its missing ancestors.
+	Move(ctx context.Context, userID int64, path, parent, name string) error                       // Moves a folder under another one, optionally renaming it.
+	Remove(ctx context.Context, userID int64, path string) error                                   // Removes an empty folder.
+	List(ctx context.Context, userID int64) ([]string, error)                                      // Lists the paths of all folders of a user.
+	Place(ctx context.Context, userID int64, kind, title string, placement models.Placement) error // Moves a live item to a folder and replaces its tags.
+}
+
+// TrashService defines the management of deleted records waiting to be restored or purged.
+type TrashService interface {
+	List(ctx context.Context, userID int64, kind string) ([]models.TrashItem, error) // Lists the trashed records of a user, of one kind or of all.
+	Restore(ctx context.Context, userID int64, kind, title string) (string, error)   // Moves a record out of the trash.
+	Purge(ctx context.Context, userID int64, kind, title string) (int, error)        // Permanently removes trashed records.
+	PurgeExpired(ctx context.Context) (int, error)                                   // Permanently removes records kept in the trash past the retention.
+}
+
+// RecordsService defines the IDs vault accounts bind the ciphertexts of their records to.
+type RecordsService interface {
+	ID(ctx context.Context, userID int64, kind, title string) (int64, bool, error) // Returns the ID of a live record or reserves one for a new record.
 }
 
 // UsersService defines the service-level interface for user account management.
 // Methods include registering new users and processing log-in attempts.
 type UsersService interface {
-	Register(ctx context.Context, cond models.User) (int64, error) // Registers a new user account.
-	Login(ctx context.Context, cond models.User) (int64, error)    // Handles user log-in process.
+	Register(ctx context.Context, cond models.User) (int64, error)         // Registers a new user account.
+	Login(ctx context.Context, cond models.User) (int64, error)            // Handles user log-in process.
+	Vault(ctx context.Context, userID int64) (*models.VaultParams, error)  // Returns vault parameters; nil if the user has no vault.
+	SetHistoryRetention(ctx context.Context, userID int64, keep int) error // Changes the number of revisions kept per record.
+}
+
+// SessionsService defines the management of login sessions and the tokens issued for them.
+type SessionsService interface {
+	Start(ctx context.Context, session models.Session) (*models.Tokens, error)  // Opens a session and issues its first tokens.
+	Refresh(ctx context.Context, refreshToken string) (*models.Tokens, error)   // Exchanges a refresh token for a new token pair.
+	Authenticate(ctx context.Context, accessToken string) (int64, int64, error) // Verifies an access token and returns its user and session IDs.
+	List(ctx context.Context, userID int64) ([]models.Session, error)           // Lists the active sessions of a user.
+	Revoke(ctx context.Context, userID, sessionID int64) error                  // Revokes a session of a user.
+}
+
+// SecondFactorService defines TOTP two-factor authentication and the logins awaiting it.
+type SecondFactorService interface {
+	Enroll(ctx context.Context, userID int64) (*models.TOTPEnrollment, error) // Generates an unconfirmed secret for a user.
+	Confirm(ctx context.Context, userID int64, code string) ([]string, error) // Enables the secret and returns recovery codes.
+	Disable(ctx context.Context, userID int64, code string) error             // Removes the second factor after checking a code.
+	Challenge(ctx context.Context, userID int64) (string, error)              // Opens a login challenge; empty if no second factor is required.
+	Verify(ctx context.Context, challenge, code string) (int64, error)        // Completes a login challenge and returns the user ID.
+	Require(ctx context.Context, userID int64, code string) error             // Checks a code if the user has a second factor.
+}
+
+// CertificatesService defines authentication by verified client certificates under mutual TLS.
+type CertificatesService interface {
+	Bind(ctx context.Context, session models.Session, subject, code string) (int64, error) // Maps a certificate subject to a user under a new session.
+	Unbind(ctx context.Context, userID int64, subject string) error                        // Removes a certificate subject mapped to a user and ends its session.
+	List(ctx context.Context, userID int64) ([]models.Certificate, error)                  // Lists the certificate subjects mapped to a user.
+	Authenticate(ctx context.Context, subject string) (int64, int64, error)                // Returns the user and session a certificate subject is mapped to.
+}
+
+// KeyRotationService defines the management of background master key rotation jobs.
+type KeyRotationService interface {
+	Start(ctx context.Context) (*models.KeyRotation, error)   // Requests a job moving all ciphertexts to the active master key.
+	Status(ctx context.Context) ([]models.KeyRotation, error) // Reports the most recent jobs.
+	Step(ctx context.Context) (*models.KeyRotation, error)    // Advances the pending job by one batch.
 }
@@ -1,38 +1,373 @@
 package models
 
+import (
+	"main/internal/passgen"
+	"time"
+)
+
 // User represents a user entity with unique identification, login, and password attributes.
 type User struct {
-	ID       int64  // Unique identifier for the user.
-	Login    string // Username or email address for logging in.
-	Password string // Hashed password for authentication.
+	ID       int64        // Unique identifier for the user.
+	Login    string       // Username or email address for logging in.
+	Password string       // Hashed password for authentication.
+	Vault    *VaultParams // Key derivation parameters of a zero-knowledge vault; nil if the server encrypts the data.
+}
+
+// VaultParams holds the Argon2id parameters a client derives its vault key with.
+// Records of vault accounts are encrypted by the client, so the server stores them as opaque blobs.
+type VaultParams struct {
+	Salt    []byte // Random per-user KDF salt.
+	Time    uint32 // Number of Argon2id passes.
+	Memory  uint32 // Argon2id memory size in KiB.
+	Threads uint32 // Argon2id degree of parallelism.
+	Check   []byte // Known value encrypted with the vault key, letting the client detect a wrong master password.
+}
+
+// Session is a login of a user on a single device, backing its refresh token.
+// Access tokens name the session they were issued for and are rejected once it is revoked or expired.
+type Session struct {
+	ID         int64      // Unique identifier of the session.
+	UserID     int64      // Owner of the session.
+	UserAgent  string     // Client software that opened the session.
+	Address    string     // Network address the session was opened from.
+	CreatedAt  time.Time  // Time of the login.
+	LastUsedAt time.Time  // Time the refresh token was last exchanged.
+	ExpiresAt  time.Time  // Time after which the session can no longer be refreshed.
+	RevokedAt  *time.Time // Time the session was revoked; nil while it is active.
+}
+
+// Tokens is a pair of credentials issued for a session.
+type Tokens struct {
+	SessionID       int64     // Session the tokens belong to.
+	Access          string    // Short-lived signed access token.
+	AccessExpiresAt time.Time // Expiration time of the access token.
+	Refresh         string    // Opaque refresh token; only its hash is stored.
+}
+
+// Certificate is a client certificate subject bound to a user.
+// The binding is backed by a session of its own and ends with it.
+type Certificate struct {
+	Subject   string    // Subject of the client certificate.
+	SessionID int64     // Session backing the binding.
+	CreatedAt time.Time // Time the certificate was bound.
+	ExpiresAt time.Time // Time the session backing the binding expires.
+}
+
+// TOTP is the time-based one-time password second factor of a user (RFC 6238).
+type TOTP struct {
+	UserID    int64  // Owner of the second factor.
+	Secret    []byte // Shared secret, encrypted with the user's data key.
+	Confirmed bool   // Whether the user proved possession of the secret; only confirmed secrets are enforced.
+	LastStep  int64  // Time step of the last accepted code, preventing its replay.
+}
+
+// TOTPEnrollment is a freshly generated secret handed to the user for their authenticator app.
+type TOTPEnrollment struct {
+	Secret string // Base32-encoded secret for manual entry.
+	URI    string // otpauth:// URI carrying the secret, usually rendered as a QR code.
 }
 
 // Password stores password details associated with a particular user.
 // Fields such as login and password are stored in encrypted format.
 type Password struct {
-	ID       int64  // Unique identifier for this password entry.
-	Title    string // Title or label describing the password usage.
-	UserID   int64  // Foreign key linking to the owning user.
-	Login    []byte // Encrypted login credential.
-	Password []byte // Encrypted password itself.
+	ID            int64           // Unique identifier for this password entry.
+	Title         string          // Title or label describing the password usage.
+	UserID        int64           // Foreign key linking to the owning user.
+	Login         []byte          // Encrypted login credential.
+	Password      []byte          // Encrypted password itself.
+	Compromised   bool            // Whether the password was found in the breach corpus when it was last written or audited.
+	Generate      *passgen.Policy // Policy the password is generated with when it is written, replacing Password; nil keeps it.
+	Fields        []PasswordField // Custom fields in display order.
+	ReplaceFields bool            // Whether an update replaces the stored custom fields with Fields or keeps them.
+	Placement     Placement       // Folder and tags of the entry.
+	Stats         Stats           // Timestamps and access counter of the entry.
+}
+
+// PasswordField is a typed custom field of a password entry, such as the site URL or a security answer.
+// The name and value are encrypted on their own, bound to the ID of the field.
+type PasswordField struct {
+	ID    int64  // Unique identifier for this field.
+	Name  []byte // Encrypted name of the field.
+	Type  string // Field type: text, hidden, url or email.
+	Value []byte // Encrypted value of the field.
 }
 
 // Card encapsulates credit/debit card information, ensuring sensitive data remains encrypted.
 type Card struct {
-	ID         int64  // Unique identifier for this card entry.
-	Title      string // Descriptive title for identifying the card.
-	UserID     int64  // Foreign key pointing to the associated user.
-	Bank       []byte // Encrypted bank name.
-	Number     []byte // Encrypted card number.
-	DataEnd    []byte // Encrypted expiry date.
-	SecretCode []byte // Encrypted CVV code.
+	ID         int64      // Unique identifier for this card entry.
+	Title      string     // Descriptive title for identifying the card.
+	UserID     int64      // Foreign key pointing to the associated user.
+	Bank       []byte     // Encrypted bank name.
+	Number     []byte     // Encrypted card number.
+	DataEnd    []byte     // Encrypted expiry date.
+	SecretCode []byte     // Encrypted CVV code.
+	Brand      string     // Card brand detected from the number, kept in cleartext for listings; empty for cards stored before.
+	Placement  Placement  // Folder and tags of the card.
+	Stats      Stats      // Timestamps and access counter of the card.
+	Reveals    int64      // Number of times the full number and security code were revealed.
+	RevealedAt *time.Time // Time of the last reveal; nil if never revealed.
+}
+
+// Note holds free-form text, such as recovery instructions, license keys or runbooks.
+type Note struct {
+	ID        int64     // Unique identifier for this note.
+	Title     string    // Title identifying the note.
+	UserID    int64     // Foreign key referencing the owning user.
+	Body      []byte    // Encrypted text of the note.
+	Placement Placement // Folder and tags of the note.
+	Stats     Stats     // Timestamps and access counter of the note.
+}
+
+// OTP holds an authenticator secret (TOTP) together with the parameters its codes are generated with.
+// It may be linked to the password it is the second factor of.
+type OTP struct {
+	ID            int64     // Unique identifier for this secret.
+	Title         string    // Title identifying the secret.
+	UserID        int64     // Foreign key referencing the owning user.
+	PasswordID    int64     // Linked password entry; zero if there is none.
+	PasswordTitle string    // Title of the linked password entry; empty if there is none or it is in the trash.
+	Issuer        []byte    // Encrypted name of the service the secret belongs to.
+	Account       []byte    // Encrypted account name within the service.
+	Secret        []byte    // Encrypted base32 secret.
+	Algorithm     string    // HMAC algorithm: SHA1, SHA256 or SHA512.
+	Digits        int       // Number of digits in a code.
+	Period        int       // Lifetime of a code in seconds.
+	Placement     Placement // Folder and tags of the secret.
+	Stats         Stats     // Timestamps and access counter of the secret.
+}
+
+// SSHKey holds an SSH key pair. The public key and its fingerprint are stored in cleartext for listing.
+type SSHKey struct {
+	ID          int64     // Unique identifier for this key.
+	Title       string    // Title identifying the key.
+	UserID      int64     // Foreign key referencing the owning user.
+	PublicKey   string    // Public key as an authorized_keys line.
+	Fingerprint string    // SHA-256 fingerprint of the public key.
+	PrivateKey  []byte    // Encrypted private key as an unencrypted OpenSSH key file.
+	Placement   Placement // Folder and tags of the key.
+	Stats       Stats     // Timestamps and access counter of the key.
+}
+
+// SSHPublicKey is the cleartext part of a stored SSH key.
+type SSHPublicKey struct {
+	Title       string // Title identifying the key.
+	PublicKey   string // Public key as an authorized_keys line.
+	Fingerprint string // SHA-256 fingerprint of the public key.
+}
+
+// OTPCode is a code generated from an authenticator secret.
+type OTPCode struct {
+	Code      string        // Code valid at the time of generation.
+	ExpiresIn time.Duration // Time left until the code expires.
+	Period    time.Duration // Lifetime of every code of the secret.
 }
 
 // BinaryData represents generic binary blobs attached to users.
 // Useful for storing files, images, or other forms of binary data.
 type BinaryData struct {
-	ID     int64  // Unique identifier for this binary data entry.
-	Title  string // Label or description for the binary data.
-	UserID int64  // Foreign key referencing the owning user.
-	Data   []byte // Raw binary content.
+	ID        int64     // Unique identifier for this binary data entry.
+	Title     string    // Label or description for the binary data.
+	UserID    int64     // Foreign key referencing the owning user.
+	Data      []byte    // Raw binary content; only set for entries stored in the database before the blob store.
+	DataKey   []byte    // Encrypted content key of the entry's blob or chunks; nil for entries stored inline.
+	Size      int64     // Size of the content in bytes.
+	SHA256    []byte    // SHA-256 checksum of the content.
+	BlobKey   string    // Key of the blob holding the encrypted content; empty for content stored in the database.
+	Streamed  bool      // Whether the content was uploaded as a stream and must be downloaded as one.
+	Placement Placement // Folder and tags of the entry.
+	Stats     Stats     // Timestamps and access counter of the entry.
+}
+
+// Folder is a node of a user's folder tree. Root folders have no parent.
+type Folder struct {
+	ID       int64  // Unique identifier for this folder.
+	UserID   int64  // Foreign key referencing the owning user.
+	ParentID int64  // Parent folder; zero for root folders.
+	Name     string // Name of the folder, unique among its siblings.
+}
+
+// Stats tells when a record was created, last changed and last read, and how often it was read.
+// Renaming or placing a record does not change it; only its content does.
+type Stats struct {
+	CreatedAt   time.Time  // Time the record was created.
+	UpdatedAt   time.Time  // Time the content of the record was last replaced.
+	AccessedAt  *time.Time // Time the record was last read; nil if it never was.
+	AccessCount int64      // Number of times the record was read.
+}
+
+// Placement locates an item in the folder tree of its owner and lists its tags.
+// Updates only change the parts they are asked to replace.
+type Placement struct {
+	Folder        string   // Slash-separated path of the folder; empty for the root.
+	FolderID      int64    // Folder resolved from the path; zero for the root.
+	Tags          []string // Tags of the item, sorted and without duplicates.
+	ReplaceFolder bool     // Whether an update moves the item to Folder.
+	ReplaceTags   bool     // Whether an update replaces the tags of the item with Tags.
+}
+
+// Rename identifies a live record to give a new title; the record is found by ID or, if the ID is zero, by title.
+type Rename struct {
+	ID       int64  // Stable identifier of the record; zero to find it by title.
+	UserID   int64  // Owner of the record.
+	Title    string // Current title of the record, used when the ID is zero.
+	NewTitle string // Title the record is given.
+}
+
+// Revision describes an archived version of a record, taken when the record was updated or deleted.
+// Revisions are numbered per title, so they survive the record being deleted and added again.
+type Revision struct {
+	Revision   int       // Sequential number of the revision within its title, starting at 1.
+	Deleted    bool      // Whether the revision was archived by deleting the record.
+	ArchivedAt time.Time // Time the revision was archived.
+}
+
+// TrashKinds lists the kinds of records that can be moved to the trash, named after the tables holding them.
+var TrashKinds = []string{TablePasswords, TableCards, TableBinaries, TableNotes, TableOTP, TableSSHKeys}
+
+// TrashItem is a deleted record waiting in the trash to be restored or purged.
+// Each title keeps at most one record of a kind in the trash.
+type TrashItem struct {
+	Kind      string    // Kind of the record, named after its table.
+	UserID    int64     // Owner of the record.
+	Title     string    // Title of the record.
+	DeletedAt time.Time // Time the record was moved to the trash.
+	PurgeAt   time.Time // Time the record is going to be purged.
+}
+
+// SortField enumerates the attributes a record listing can be ordered by.
+type SortField int
+
+// Supported listing orders.
+const (
+	SortByTitle    SortField = iota // Order records alphabetically by title.
+	SortByDate                      // Order records by creation time.
+	SortByUpdated                   // Order records by the time their content was last replaced.
+	SortByAccessed                  // Order records by the time they were last read; never read records come first.
+)
+
+// ListQuery describes a page request as received from a client, with an opaque continuation cursor.
+type ListQuery struct {
+	UserID         int64     // Owner of the listed records.
+	TitlePrefix    string    // Only records whose title starts with this prefix are returned.
+	Folder         string    // Only records in this folder or its subfolders are returned; empty for all folders.
+	Tags           []string  // Only records carrying all of these tags are returned.
+	UpdatedBefore  time.Time // Only records whose content was last replaced before this time are returned; zero for all.
+	AccessedBefore time.Time // Only records not read since this time are returned, never read ones included; zero for all.
+	SortBy         SortField // Attribute the records are ordered by.
+	Descending     bool      // Reverses the ordering when set.
+	PageSize       int       // Requested number of records per page; zero selects the default.
+	Cursor         string    // Opaque cursor returned with the previous page; empty for the first page.
+}
+
+// ListCursor identifies the last record of a previously returned page.
+// The next page starts strictly after this position in the chosen ordering.
+type ListCursor struct {
+	ID         int64     // Identifier of the last returned record, used as a tie-breaker.
+	Title      string    // Title of the last returned record.
+	CreatedAt  time.Time // Creation time of the last returned record.
+	UpdatedAt  time.Time // Time the content of the last returned record was last replaced.
+	AccessedAt time.Time // Time the last returned record was last read; zero if it never was.
+}
+
+// ListFilter describes a single page request for listing the records of a user.
+type ListFilter struct {
+	UserID         int64       // Owner of the listed records.
+	TitlePrefix    string      // Only records whose title starts with this prefix are returned.
+	FolderID       int64       // Only records in this folder or its subfolders are returned; zero for all folders.
+	Tags           []string    // Only records carrying all of these tags are returned.
+	UpdatedBefore  time.Time   // Only records whose content was last replaced before this time are returned; zero for all.
+	AccessedBefore time.Time   // Only records not read since this time are returned, never read ones included; zero for all.
+	SortBy         SortField   // Attribute the records are ordered by.
+	Descending     bool        // Reverses the ordering when set.
+	Limit          int         // Maximum number of records to return.
+	After          *ListCursor // Position to continue from; nil requests the first page.
+}
+
+// ListItem is a short, non-sensitive view of a stored record used in listings.
+type ListItem struct {
+	ID    int64  // Unique identifier of the record.
+	Title string // Title of the record.
+	Stats Stats  // Timestamps and access counter of the record.
+	Label string // Cleartext detail listed with the record, such as the brand of a card; empty for most records.
+}
+
+// ListPage holds one page of listed records together with the cursor for the following page.
+type ListPage struct {
+	Items      []ListItem // Records of the current page.
+	NextCursor string     // Opaque cursor of the next page; empty when there are no more records.
+}
+
+// Names of the tables storing encrypted user records.
+const (
+	TablePasswords      = "passwords"       // Password entries.
+	TableCards          = "cards"           // Credit cards.
+	TableBinaries       = "binaries"        // Binary data.
+	TableNotes          = "notes"           // Secure notes.
+	TableOTP            = "otp_entries"     // Authenticator secrets.
+	TableSSHKeys        = "ssh_keys"        // SSH key pairs.
+	TablePasswordFields = "password_fields" // Custom fields of password entries.
+	TableUserTOTP       = "user_totp"       // TOTP secrets of users.
+
+	TablePasswordHistory      = "password_history"       // Archived revisions of password entries.
+	TableCardHistory          = "card_history"           // Archived revisions of credit cards.
+	TableBinaryHistory        = "binary_history"         // Archived revisions of binary data.
+	TableNoteHistory          = "note_history"           // Archived revisions of secure notes.
+	TableOTPHistory           = "otp_history"            // Archived revisions of authenticator secrets.
+	TableSSHKeyHistory        = "ssh_key_history"        // Archived revisions of SSH key pairs.
+	TablePasswordFieldHistory = "password_field_history" // Custom fields archived with password entry revisions.
+)
+
+// CipherContext identifies the place a ciphertext is stored in.
+// It is authenticated along with the ciphertext, so a value copied into another field,
+// record or user's account fails to decrypt.
+type CipherContext struct {
+	UserID   int64  // Owner of the record.
+	Table    string // Table holding the record.
+	Field    string // Column holding the ciphertext.
+	RecordID int64  // Identifier of the record within its table.
+}
+
+// Key rotation phases, processed in the listed order.
+// The record phases are named after the tables they walk.
+const (
+	RotationPhaseUserKeys       = "user_keys"         // Re-wrap per-user data keys with the new master key.
+	RotationPhasePasswords      = TablePasswords      // Re-encrypt password entries still in an outdated format.
+	RotationPhaseCards          = TableCards          // Re-encrypt credit cards still in an outdated format.
+	RotationPhaseBinaries       = TableBinaries       // Re-encrypt binary data still in an outdated format.
+	RotationPhaseNotes          = TableNotes          // Re-encrypt secure notes still in an outdated format.
+	RotationPhaseOTP            = TableOTP            // Re-encrypt authenticator secrets still in an outdated format.
+	RotationPhaseSSHKeys        = TableSSHKeys        // Re-encrypt SSH private keys still in an outdated format.
+	RotationPhasePasswordFields = TablePasswordFields // Re-encrypt custom fields of password entries still in an outdated format.
+
+	RotationPhasePasswordHistory      = TablePasswordHistory      // Re-encrypt archived password entries.
+	RotationPhaseCardHistory          = TableCardHistory          // Re-encrypt archived credit cards.
+	RotationPhaseBinaryHistory        = TableBinaryHistory        // Re-encrypt the content keys of archived binary data.
+	RotationPhaseNoteHistory          = TableNoteHistory          // Re-encrypt archived secure notes.
+	RotationPhaseOTPHistory           = TableOTPHistory           // Re-encrypt archived authenticator secrets.
+	RotationPhaseSSHKeyHistory        = TableSSHKeyHistory        // Re-encrypt archived SSH private keys.
+	RotationPhasePasswordFieldHistory = TablePasswordFieldHistory // Re-encrypt archived custom fields of password entries.
+)
+
+// KeyRotation tracks the progress of a background job moving stored ciphertexts to a new master key.
+// The job advances in batches and persists its position after each one, so it resumes after a restart.
+type KeyRotation struct {
+	ID          int64      // Unique identifier of the job.
+	MasterKeyID uint32     // Master key every ciphertext is moved to.
+	Phase       string     // Table currently being processed.
+	LastID      int64      // Key of the last processed row within the current phase.
+	Processed   int64      // Number of rows rewritten so far.
+	Skipped     int64      // Number of rows that could not be decrypted and were left untouched.
+	CreatedAt   time.Time  // Time the job was requested.
+	UpdatedAt   time.Time  // Time the job last advanced.
+	FinishedAt  *time.Time // Time the job completed; nil while it is in progress.
+}
+
+// EncryptedRecord is a raw row holding encrypted columns, as seen by the key rotation job.
+// Archived rows keep the location of the record they were taken from, which their ciphertexts are bound to.
+type EncryptedRecord struct {
+	ID       int64             // Row key within its table.
+	UserID   int64             // Owner of the row.
+	Table    string            // Table the ciphertexts are bound to.
+	RecordID int64             // Record the ciphertexts are bound to; equal to ID outside the history tables.
+	Fields   map[string][]byte // Encrypted column values indexed by column name.
 }
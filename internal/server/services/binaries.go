@@ -1,95 +1,514 @@
 package services
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"errors"
+	"hash"
+	"io"
 	"main/internal/server/interfaces"
 	"main/internal/server/models"
+	"main/internal/stream"
+	"time"
 )
 
 // Error definitions for common scenarios in binary service operations.
 var (
 	ErrBinaryAlreadyExists = errors.New("binary already exists") // Thrown when attempting to add a duplicate binary.
 	ErrBinaryNotFound      = errors.New("binary not found")      // Raised when get a non-existent binary.
+	ErrBinaryStreamed      = errors.New("binary is streamed")    // Raised when getting a streamed binary in one piece.
+	ErrChecksumMismatch    = errors.New("checksum mismatch")     // Raised when uploaded content does not match its checksum.
+	ErrBlobNotFound        = errors.New("blob not found")        // Raised by blob stores when opening a missing blob.
 )
 
+// errUploadAborted ends the blob write of an aborted upload.
+var errUploadAborted = errors.New("upload aborted")
+
+// blobKeySize is the number of random bytes in a blob key.
+const blobKeySize = 16
+
 // BinariesService manages business logic for binary data storage and retrieval.
 // It integrates with a repository for persistence and a crypto service for encryption/decryption.
+// The content is kept in a blob store and only the metadata in the repository. Every binary is encrypted
+// segment by segment (see package stream) under its own random content key, which is itself encrypted with
+// the crypto service, so rotating master keys never touches the blobs. Binaries stored in the database by
+// earlier versions remain readable and are moved to the blob store by Offload.
 type BinariesService struct {
 	r interfaces.BinariesRepository // Repository for accessing binary data storage.
 	c interfaces.CryptoService      // Service responsible for encryption and decryption.
+	b interfaces.BlobStore          // Store holding the encrypted content.
+	p placer                        // Places binaries in folders and tags them.
+	k binder                        // Keeps binaries under the IDs their content is bound to.
 }
 
 // NewBinariesService instantiates a new BinariesService instance with dependencies injected.
-func NewBinariesService(r interfaces.BinariesRepository, c interfaces.CryptoService) *BinariesService {
+func NewBinariesService(r interfaces.BinariesRepository, u interfaces.UsersRepository, f interfaces.FoldersRepository,
+	rr interfaces.RecordsRepository, c interfaces.CryptoService, b interfaces.BlobStore) *BinariesService {
 	return &BinariesService{
 		r: r,
 		c: c,
+		b: b,
+		p: newPlacer(f, models.TableBinaries),
+		k: newBinder(rr, u, models.TableBinaries),
 	}
 }
 
 // Get fetches a binary data item by title and user ID, then decrypts the content.
+// The read is counted, while the returned statistics are those from before it.
 func (s *BinariesService) Get(ctx context.Context, title string, UserID int64) (*models.BinaryData, error) {
 	result, err := s.r.Get(ctx, title, UserID)
 	if err != nil {
 		return nil, err
 	}
+	if result.Streamed {
+		return nil, ErrBinaryStreamed
+	}
+
+	content, err := s.open(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
 
-	result, err = s.decrypt(result)
+	result.Data, err = io.ReadAll(content)
 	if err != nil {
 		return nil, err
 	}
+
+	result.Placement, err = s.p.load(ctx, result.ID, UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.r.Touch(ctx, result.ID); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
-// Add inserts a new binary data item into storage after encrypting its contents.
+// Add inserts a new binary data item in its folder with its tags, writing its encrypted content to a new blob.
+// An item given an ID is stored under it, once the reservation of the ID is consumed.
 func (s *BinariesService) Add(ctx context.Context, cond models.BinaryData) (string, error) {
+	return s.p.write(ctx, cond.UserID, cond.Placement, func(placement models.Placement) (string, error) {
+		cond.Placement = placement
+		if err := s.k.claim(ctx, cond.ID, cond.UserID); err != nil {
+			return "", err
+		}
+		return s.add(ctx, cond)
+	})
+}
+
+// add stores a new binary data item under its ID or a freshly reserved one, so the content key can be bound to it.
+func (s *BinariesService) add(ctx context.Context, cond models.BinaryData) (string, error) {
 	var err error
 
-	cond, err = s.encrypt(cond)
-	if err != nil {
+	if cond.ID == 0 {
+		cond.ID, err = s.r.NextID(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if err := s.store(ctx, &cond); err != nil {
 		return "", err
 	}
 
 	result, err := s.r.Add(ctx, cond)
 	if err != nil {
+		s.discard(ctx, cond.BlobKey)
 		return "", err
 	}
 
 	return result, nil
 }
 
-// Update modifies an existing binary data item, first encrypting the updated content.
+// Update replaces the content of an existing binary data item with a new blob.
+// The item is moved and retagged only as far as its placement asks for it.
 func (s *BinariesService) Update(ctx context.Context, cond models.BinaryData) (string, error) {
-	var err error
+	return s.p.write(ctx, cond.UserID, cond.Placement, func(placement models.Placement) (string, error) {
+		cond.Placement = placement
+		return s.update(ctx, cond)
+	})
+}
 
-	cond, err = s.encrypt(cond)
+// update replaces the content of a binary data item; the record is resolved to its ID first, and the content key
+// is bound to that ID. The previous version is archived with its blob, which is collected once its revision is pruned.
+// An item given an ID is only replaced if it is still the record of the title.
+func (s *BinariesService) update(ctx context.Context, cond models.BinaryData) (string, error) {
+	current, err := s.r.Get(ctx, cond.Title, cond.UserID)
 	if err != nil {
 		return "", err
 	}
+	if err := sameRecord(cond.ID, current.ID); err != nil {
+		return "", err
+	}
+	if err := s.prepare(ctx, current); err != nil {
+		return "", err
+	}
+	cond.ID = current.ID
+
+	if err := s.store(ctx, &cond); err != nil {
+		return "", err
+	}
 
 	result, err := s.r.Update(ctx, cond)
 	if err != nil {
+		s.discard(ctx, cond.BlobKey)
 		return "", err
 	}
 
 	return result, nil
 }
 
-// Delete removes a binary data item identified by title and user ID.
+// Delete moves a binary data item identified by title and user ID to the trash.
+// Content still stored in the database is moved to a blob first, as the item is archived once purged
+// from the trash; its blob is collected when no record or revision refers to it anymore.
 func (s *BinariesService) Delete(ctx context.Context, title string, UserID int64) error {
-	err := s.r.Delete(ctx, title, UserID)
+	current, err := s.r.Get(ctx, title, UserID)
 	if err != nil {
 		return err
 	}
+	if err := s.prepare(ctx, current); err != nil {
+		return err
+	}
+
+	return s.r.Delete(ctx, title, UserID)
+}
+
+// Rename gives a binary data item found by ID or title a new title once the title is checked;
+// its content stays bound to the record ID, so nothing is re-encrypted or copied.
+func (s *BinariesService) Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error) {
+	if err := checkTitle(cond.NewTitle); err != nil {
+		return nil, err
+	}
+	return s.r.Rename(ctx, cond)
+}
+
+// List returns a page of the user's binary data items; only non-sensitive fields are listed, so nothing is decrypted.
+func (s *BinariesService) List(ctx context.Context, query models.ListQuery) (*models.ListPage, error) {
+	return s.p.paginate(ctx, query, s.r.List)
+}
+
+// Upload starts storing a new binary data item whose content is written to the returned upload.
+// The content is encrypted into a new blob on the fly and the item only appears once the upload is
+// committed with the SHA-256 checksum of the content, in its folder with its tags. An item given an ID is stored
+// under it, once the reservation of the ID is consumed.
+func (s *BinariesService) Upload(ctx context.Context, cond models.BinaryData) (interfaces.BinaryUpload, error) {
+	_, err := s.r.GetID(ctx, cond.Title, cond.UserID)
+	if err == nil {
+		return nil, ErrBinaryAlreadyExists
+	}
+	if !errors.Is(err, ErrBinaryNotFound) {
+		return nil, err
+	}
+
+	cond.Placement, err = s.p.resolve(ctx, cond.UserID, cond.Placement)
+	if err != nil {
+		return nil, err
+	}
+
+	if cond.ID != 0 {
+		err = s.k.claim(ctx, cond.ID, cond.UserID)
+	} else {
+		cond.ID, err = s.r.NextID(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := s.create(ctx, &cond)
+	if err != nil {
+		return nil, err
+	}
+
+	return &binaryUpload{
+		s:    s,
+		ctx:  ctx,
+		cond: cond,
+		w:    w,
+	}, nil
+}
+
+// Download fetches a binary data item by title and user ID and returns a reader of its decrypted content.
+// The returned item carries the size and SHA-256 checksum of the content, but no data.
+// Binaries added in one piece are served the same way, so clients can download any binary. The read is counted.
+func (s *BinariesService) Download(ctx context.Context, title string, UserID int64) (*models.BinaryData, io.ReadCloser, error) {
+	result, err := s.r.Get(ctx, title, UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result.Placement, err = s.p.load(ctx, result.ID, UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.open(ctx, result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.r.Touch(ctx, result.ID); err != nil {
+		content.Close()
+		return nil, nil, err
+	}
+	return result, content, nil
+}
+
+// Offload moves binary data items stored in the database by earlier versions to the blob store,
+// batch items at a time, and returns the number of items moved. Chunked content is copied as is,
+// since it is already encrypted under its content key; inline content is re-encrypted.
+func (s *BinariesService) Offload(ctx context.Context, batch int) (int, error) {
+	var moved int
+
+	for {
+		items, err := s.r.Legacy(ctx, batch)
+		if err != nil {
+			return moved, err
+		}
+		if len(items) == 0 {
+			return moved, nil
+		}
+
+		for _, item := range items {
+			ok, err := s.offload(ctx, item)
+			if err != nil {
+				return moved, err
+			}
+			if ok {
+				moved++
+			}
+		}
+	}
+}
+
+// CollectGarbage removes blobs no binary data item refers to and returns their number.
+// Such blobs are left behind by failed writes and interrupted clean-ups. Blobs younger than grace
+// are kept, since the item referring to them may not be committed yet.
+func (s *BinariesService) CollectGarbage(ctx context.Context, grace time.Duration) (int, error) {
+	var removed int
+	cutoff := time.Now().Add(-grace)
+
+	err := s.b.Walk(ctx, func(key string, modified time.Time) error {
+		if modified.After(cutoff) {
+			return nil
+		}
+
+		used, err := s.r.HasBlob(ctx, key)
+		if err != nil || used {
+			return err
+		}
+
+		if err := s.b.Delete(ctx, key); err != nil {
+			return err
+		}
+		removed++
+		return nil
+	})
+	return removed, err
+}
+
+// History lists the archived revisions of a binary data item, newest first.
+// Revisions are kept by title, so those of a deleted item are listed as well.
+func (s *BinariesService) History(ctx context.Context, title string, UserID int64) ([]models.Revision, error) {
+	return s.r.History(ctx, title, UserID)
+}
+
+// Restore brings back an archived revision of a binary data item like restore does for the other kinds.
+// The revision shares the blob of the archived version, so only its content key is encrypted anew.
+func (s *BinariesService) Restore(ctx context.Context, title string, UserID int64, revision int) (string, error) {
+	rev, err := s.r.GetRevision(ctx, title, UserID, revision)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := s.c.Decrypt(ctx, binaryField(UserID, rev.ID, "data_key"), rev.DataKey)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := s.k.restored(ctx, rev.ID, UserID)
+	if err != nil {
+		return "", err
+	}
+
+	current, err := s.r.Get(ctx, title, UserID)
+	if errors.Is(err, ErrBinaryNotFound) {
+		rev.ID = id
+		if rev.ID == 0 {
+			rev.ID, err = s.r.NextID(ctx)
+			if err != nil {
+				return "", err
+			}
+		}
+		rev.DataKey, err = s.c.Encrypt(ctx, binaryField(UserID, rev.ID, "data_key"), key)
+		if err != nil {
+			return "", err
+		}
+		return s.r.Add(ctx, *rev)
+	}
+	if err != nil {
+		return "", err
+	}
+	if err := sameRecord(id, current.ID); err != nil {
+		return "", err
+	}
+	if err := s.prepare(ctx, current); err != nil {
+		return "", err
+	}
+
+	rev.ID = current.ID
+	rev.DataKey, err = s.c.Encrypt(ctx, binaryField(UserID, rev.ID, "data_key"), key)
+	if err != nil {
+		return "", err
+	}
+	return s.r.Update(ctx, *rev)
+}
+
+// prepare moves the content of an item stored in the database by earlier versions to the blob store,
+// since only content held in blobs can be archived.
+func (s *BinariesService) prepare(ctx context.Context, item *models.BinaryData) error {
+	if item.BlobKey != "" {
+		return nil
+	}
+	_, err := s.offload(ctx, *item)
+	return err
+}
+
+// offload moves the content of a single item to a new blob and reports whether the item still needed it.
+func (s *BinariesService) offload(ctx context.Context, item models.BinaryData) (bool, error) {
+	if item.DataKey != nil {
+		chunks, err := s.r.OpenChunks(ctx, item.ID)
+		if err != nil {
+			return false, err
+		}
+		defer chunks.Close()
+
+		item.BlobKey, err = newBlobKey()
+		if err != nil {
+			return false, err
+		}
+		if err := s.b.Put(ctx, item.BlobKey, chunks); err != nil {
+			return false, err
+		}
+		// The content key may be re-encrypted by a key rotation meanwhile; keep the stored one.
+		item.DataKey = nil
+	} else {
+		decrypted, err := s.decrypt(ctx, &item)
+		if err != nil {
+			return false, err
+		}
+		item = *decrypted
+
+		if err := s.store(ctx, &item); err != nil {
+			return false, err
+		}
+	}
+
+	ok, err := s.r.Offload(ctx, item)
+	if err != nil || !ok {
+		s.discard(ctx, item.BlobKey)
+	}
+	return ok, err
+}
+
+// store encrypts the data of cond into a new blob and fills in its content key, blob key, size and checksum.
+func (s *BinariesService) store(ctx context.Context, cond *models.BinaryData) error {
+	w, err := s.create(ctx, cond)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(cond.Data); err != nil {
+		w.Abort()
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	cond.Data, cond.Size, cond.SHA256 = nil, w.size, w.hash.Sum(nil)
 	return nil
 }
 
+// create generates a content key for cond, stores it encrypted in cond and starts a new blob encrypted under it.
+func (s *BinariesService) create(ctx context.Context, cond *models.BinaryData) (*blobWriter, error) {
+	key := make([]byte, stream.KeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+
+	var err error
+	cond.DataKey, err = s.c.Encrypt(ctx, binaryField(cond.UserID, cond.ID, "data_key"), key)
+	if err != nil {
+		return nil, err
+	}
+
+	cond.BlobKey, err = newBlobKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return newBlobWriter(ctx, s.b, cond.BlobKey, key)
+}
+
+// open returns a reader of the decrypted content of an item, wherever it is stored.
+// Items stored inline lack a size and checksum, so they are filled in from the content.
+func (s *BinariesService) open(ctx context.Context, item *models.BinaryData) (io.ReadCloser, error) {
+	if item.DataKey == nil {
+		decrypted, err := s.decrypt(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+
+		sum := sha256.Sum256(decrypted.Data)
+		content := io.NopCloser(bytes.NewReader(decrypted.Data))
+		item.Size, item.SHA256, item.Data = int64(len(decrypted.Data)), sum[:], nil
+		return content, nil
+	}
+
+	key, err := s.c.Decrypt(ctx, binaryField(item.UserID, item.ID, "data_key"), item.DataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var src io.ReadCloser
+	if item.BlobKey != "" {
+		src, err = s.b.Open(ctx, item.BlobKey)
+	} else {
+		src, err = s.r.OpenChunks(ctx, item.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := stream.NewReader(src, key)
+	if err != nil {
+		src.Close()
+		return nil, err
+	}
+
+	return &binaryDownload{Reader: r, Closer: src}, nil
+}
+
+// discard removes a blob that is no longer referenced. Failures are left to the garbage collector,
+// and the removal is not cancelled with the request that caused it.
+func (s *BinariesService) discard(ctx context.Context, key string) {
+	if key == "" {
+		return
+	}
+	_ = s.b.Delete(context.WithoutCancel(ctx), key)
+}
+
 // decrypt takes a binary data item and decrypts its content using the configured crypto service.
-func (s *BinariesService) decrypt(result *models.BinaryData) (*models.BinaryData, error) {
+func (s *BinariesService) decrypt(ctx context.Context, result *models.BinaryData) (*models.BinaryData, error) {
 	var err error
 
-	result.Data, err = s.c.Decrypt(result.Data)
+	result.Data, err = s.c.Decrypt(ctx, binaryField(result.UserID, result.ID, "data"), result.Data)
 	if err != nil {
 		return nil, err
 	}
@@ -97,14 +516,142 @@ func (s *BinariesService) decrypt(result *models.BinaryData) (*models.BinaryData
 	return result, nil
 }
 
-// encrypt encrypts the binary data content prior to persisting it.
-func (s *BinariesService) encrypt(cond models.BinaryData) (models.BinaryData, error) {
-	var err error
+// binaryField locates an encrypted field of a binary data item, binding its ciphertext to the record.
+func binaryField(userID, id int64, field string) models.CipherContext {
+	return models.CipherContext{
+		UserID:   userID,
+		Table:    models.TableBinaries,
+		Field:    field,
+		RecordID: id,
+	}
+}
+
+// newBlobKey generates a random blob key.
+func newBlobKey() (string, error) {
+	raw := make([]byte, blobKeySize)
+	if _, err := io.ReadFull(rand.Reader, raw); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(raw), nil
+}
+
+// binaryUpload stores the content of a streamed binary data item and adds the item once committed.
+type binaryUpload struct {
+	s    *BinariesService  // Service the item is added with.
+	ctx  context.Context   // Context of the upload.
+	cond models.BinaryData // Item being uploaded.
+	w    *blobWriter       // Blob receiving the encrypted content.
+}
+
+// Write encrypts and stores the next part of the content.
+func (u *binaryUpload) Write(p []byte) (int, error) {
+	return u.w.Write(p)
+}
+
+// Commit adds the item if sum matches the SHA-256 checksum of the content, and discards it otherwise.
+func (u *binaryUpload) Commit(sum []byte) (string, error) {
+	digest := u.w.hash.Sum(nil)
+	if subtle.ConstantTimeCompare(digest, sum) != 1 {
+		u.w.Abort()
+		return "", ErrChecksumMismatch
+	}
+
+	if err := u.w.Close(); err != nil {
+		return "", err
+	}
+
+	u.cond.Size, u.cond.SHA256, u.cond.Streamed = u.w.size, digest, true
+	result, err := u.s.r.Add(u.ctx, u.cond)
+	if err != nil {
+		u.s.discard(u.ctx, u.cond.BlobKey)
+		return "", err
+	}
+	return result, nil
+}
+
+// Abort discards the item.
+func (u *binaryUpload) Abort() error {
+	u.w.Abort()
+	return nil
+}
+
+// blobWriter encrypts content into a new blob while tracking its size and checksum.
+// The blob store reads the ciphertext from a pipe in a separate goroutine.
+type blobWriter struct {
+	pw   *io.PipeWriter // Pipe the blob store reads from.
+	w    *stream.Writer // Stream encrypting the content into the pipe.
+	done chan error     // Result of storing the blob.
+	hash hash.Hash      // SHA-256 of the content written so far.
+	size int64          // Number of content bytes written so far.
+	err  error          // Result of Close or Abort, once called.
+	over bool           // Whether the blob write has ended.
+}
 
-	cond.Data, err = s.c.Encrypt(cond.Data)
+// newBlobWriter starts storing a blob encrypted under key.
+func newBlobWriter(ctx context.Context, b interfaces.BlobStore, blobKey string, key []byte) (*blobWriter, error) {
+	pr, pw := io.Pipe()
+	w := &blobWriter{
+		pw:   pw,
+		done: make(chan error, 1),
+		hash: sha256.New(),
+	}
+
+	go func() {
+		err := b.Put(ctx, blobKey, pr)
+		pr.CloseWithError(err)
+		w.done <- err
+	}()
+
+	var err error
+	w.w, err = stream.NewWriter(pw, key)
 	if err != nil {
-		return models.BinaryData{}, err
+		w.Abort()
+		return nil, err
+	}
+	return w, nil
+}
+
+// Write encrypts the next part of the content.
+func (w *blobWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.hash.Write(p[:n])
+	w.size += int64(n)
+	return n, err
+}
+
+// Close finishes the stream and waits until the blob is stored.
+func (w *blobWriter) Close() error {
+	if w.over {
+		return w.err
+	}
+	w.over = true
+
+	if err := w.w.Close(); err != nil {
+		w.pw.CloseWithError(err)
+		<-w.done
+		w.err = err
+		return err
 	}
 
-	return cond, nil
+	w.pw.Close()
+	w.err = <-w.done
+	return w.err
+}
+
+// Abort stops storing the blob; the blob store keeps nothing of it.
+func (w *blobWriter) Abort() {
+	if w.over {
+		return
+	}
+	w.over = true
+	w.err = errUploadAborted
+
+	w.pw.CloseWithError(errUploadAborted)
+	<-w.done
+}
+
+// binaryDownload decrypts the content of a binary data item and releases its source when closed.
+type binaryDownload struct {
+	io.Reader // Stream decrypting the content.
+	io.Closer // Blob or chunks being read.
 }
@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"main/internal/card"
 	"main/internal/server/interfaces"
 	"main/internal/server/models"
 )
@@ -11,41 +13,114 @@ import (
 var (
 	ErrCardAlreadyExists = errors.New("card already exists") // Thrown when attempting to add a duplicate card.
 	ErrCardNotFound      = errors.New("card not found")      // Raised when get a non-existent card.
+	ErrInvalidCard       = errors.New("invalid card data")   // Raised when the number, expiry date, security code or brand is malformed; wraps the reason.
 )
 
 // CardsService manages the lifecycle of credit card entities, integrating encryption for sensitive data.
+// Cards are validated and their brand detected before they are encrypted, unless the client encrypted them.
 type CardsService struct {
 	r interfaces.CardsRepository // Repository dependency for interacting with the persistent store.
+	u interfaces.UsersRepository // Repository telling vault accounts apart.
 	c interfaces.CryptoService   // Encryption service dependency for securing card data.
+	p placer                     // Places cards in folders and tags them.
+	k binder                     // Keeps cards under the IDs their fields are bound to.
 }
 
 // NewCardsService creates a new instance of CardsService with injected dependencies.
-func NewCardsService(r interfaces.CardsRepository, c interfaces.CryptoService) *CardsService {
+func NewCardsService(r interfaces.CardsRepository, u interfaces.UsersRepository, f interfaces.FoldersRepository,
+	rr interfaces.RecordsRepository, c interfaces.CryptoService) *CardsService {
 	return &CardsService{
 		r: r,
+		u: u,
 		c: c,
+		p: newPlacer(f, models.TableCards),
+		k: newBinder(rr, u, models.TableCards),
 	}
 }
 
-// Get retrieves a credit card by title and user ID, decrypting its confidential fields.
+// Get retrieves a credit card by title and user ID, decrypting its confidential fields. The number is masked down
+// to its last four digits and the security code left out; Reveal returns them in full. The numbers of vault accounts
+// arrive encrypted, so the client masks them. The read is counted, while the returned statistics are those from before it.
 func (s *CardsService) Get(ctx context.Context, title string, UserID int64) (*models.Card, error) {
+	result, err := s.get(ctx, title, UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := s.u.Vault(ctx, UserID)
+	if err != nil {
+		return nil, err
+	}
+	if params == nil {
+		result.Number = []byte(card.Mask(string(result.Number)))
+	}
+	result.SecretCode = nil
+	return result, nil
+}
+
+// Reveal retrieves a credit card like Get, but with its full number and security code, and records the reveal.
+func (s *CardsService) Reveal(ctx context.Context, title string, UserID int64) (*models.Card, error) {
+	result, err := s.get(ctx, title, UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.r.Reveal(ctx, result.ID, UserID); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// get loads and decrypts a credit card with its placement and counts the read.
+func (s *CardsService) get(ctx context.Context, title string, UserID int64) (*models.Card, error) {
 	result, err := s.r.Get(ctx, title, UserID)
 	if err != nil {
 		return nil, err
 	}
 
-	result, err = s.decrypt(result)
+	result, err = s.decrypt(ctx, result)
 	if err != nil {
 		return nil, err
 	}
+
+	result.Placement, err = s.p.load(ctx, result.ID, UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.r.Touch(ctx, result.ID); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
-// Add persists a new credit card, encrypting its sensitive fields beforehand.
+// Add persists a new credit card in its folder with its tags, validating it and encrypting its sensitive fields beforehand.
+// A card given an ID is stored under it, once the reservation of the ID is consumed.
 func (s *CardsService) Add(ctx context.Context, cond models.Card) (string, error) {
+	if err := s.validate(ctx, &cond); err != nil {
+		return "", err
+	}
+	return s.p.write(ctx, cond.UserID, cond.Placement, func(placement models.Placement) (string, error) {
+		cond.Placement = placement
+		if err := s.k.claim(ctx, cond.ID, cond.UserID); err != nil {
+			return "", err
+		}
+		return s.add(ctx, cond)
+	})
+}
+
+// add stores a new credit card under its ID or a freshly reserved one, so the ciphertexts can be bound to it.
+func (s *CardsService) add(ctx context.Context, cond models.Card) (string, error) {
 	var err error
 
-	cond, err = s.encrypt(cond)
+	if cond.ID == 0 {
+		cond.ID, err = s.r.NextID(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	cond, err = s.encrypt(ctx, cond)
 	if err != nil {
 		return "", err
 	}
@@ -58,11 +133,31 @@ func (s *CardsService) Add(ctx context.Context, cond models.Card) (string, error
 	return result, nil
 }
 
-// Update updates an existing credit card record, re-encrypting modified fields.
+// Update updates an existing credit card record, validating it and re-encrypting modified fields.
+// The card is moved and retagged only as far as its placement asks for it.
 func (s *CardsService) Update(ctx context.Context, cond models.Card) (string, error) {
-	var err error
+	if err := s.validate(ctx, &cond); err != nil {
+		return "", err
+	}
+	return s.p.write(ctx, cond.UserID, cond.Placement, func(placement models.Placement) (string, error) {
+		cond.Placement = placement
+		return s.update(ctx, cond)
+	})
+}
+
+// update replaces a credit card; the record is resolved to its ID first, and the ciphertexts are bound to that ID.
+// A card given an ID is only replaced if it is still the record of the title.
+func (s *CardsService) update(ctx context.Context, cond models.Card) (string, error) {
+	id, err := s.r.GetID(ctx, cond.Title, cond.UserID)
+	if err != nil {
+		return "", err
+	}
+	if err := sameRecord(cond.ID, id); err != nil {
+		return "", err
+	}
+	cond.ID = id
 
-	cond, err = s.encrypt(cond)
+	cond, err = s.encrypt(ctx, cond)
 	if err != nil {
 		return "", err
 	}
@@ -75,7 +170,8 @@ func (s *CardsService) Update(ctx context.Context, cond models.Card) (string, er
 	return result, nil
 }
 
-// Delete deletes a credit card record by title and user ID without needing decryption.
+// Delete moves a credit card record to the trash by title and user ID without needing decryption;
+// ErrCardNotFound is returned if there is none.
 func (s *CardsService) Delete(ctx context.Context, title string, UserID int64) error {
 	err := s.r.Delete(ctx, title, UserID)
 	if err != nil {
@@ -84,23 +180,106 @@ func (s *CardsService) Delete(ctx context.Context, title string, UserID int64) e
 	return nil
 }
 
+// Rename gives a credit card found by ID or title a new title once the title is checked;
+// its fields stay bound to the record ID, so nothing is re-encrypted.
+func (s *CardsService) Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error) {
+	if err := checkTitle(cond.NewTitle); err != nil {
+		return nil, err
+	}
+	return s.r.Rename(ctx, cond)
+}
+
+// List returns a page of the user's credit cards; only non-sensitive fields are listed, so nothing is decrypted.
+func (s *CardsService) List(ctx context.Context, query models.ListQuery) (*models.ListPage, error) {
+	return s.p.paginate(ctx, query, s.r.List)
+}
+
+// History lists the archived revisions of a credit card, newest first.
+// Revisions are kept by title, so those of a deleted credit card are listed as well.
+func (s *CardsService) History(ctx context.Context, title string, UserID int64) ([]models.Revision, error) {
+	return s.r.History(ctx, title, UserID)
+}
+
+// Restore brings back an archived revision of a credit card (see restore).
+// The revision is restored as it was archived, even if it would no longer pass as a new card,
+// e.g. once it expired; only a missing brand is detected.
+func (s *CardsService) Restore(ctx context.Context, title string, UserID int64, revision int) (string, error) {
+	rev, err := s.r.GetRevision(ctx, title, UserID, revision)
+	if err != nil {
+		return "", err
+	}
+
+	rev, err = s.decrypt(ctx, rev)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.normalize(ctx, rev); err != nil {
+		return "", err
+	}
+
+	return restore(ctx, s.k, rev, &rev.ID, UserID, ErrCardNotFound, s.update, s.add)
+}
+
+// validate checks the number, expiry date and security code of a card, normalizing them and detecting its brand.
+// The fields of vault accounts arrive encrypted, so only the brand the client detected is checked.
+func (s *CardsService) validate(ctx context.Context, cond *models.Card) error {
+	params, err := s.u.Vault(ctx, cond.UserID)
+	if err != nil {
+		return err
+	}
+	if params != nil {
+		if cond.Brand != "" && !card.ValidBrand(cond.Brand) {
+			return fmt.Errorf("%w: unknown brand %q", ErrInvalidCard, cond.Brand)
+		}
+		return nil
+	}
+
+	result, err := card.Validate(string(cond.Number), string(cond.DataEnd), string(cond.SecretCode))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidCard, err)
+	}
+	cond.Number = []byte(result.Number)
+	cond.DataEnd = []byte(result.Expiry.String())
+	cond.SecretCode = []byte(result.CVV)
+	cond.Brand = result.Brand
+	return nil
+}
+
+// normalize detects the brand of a revision archived before brands were, without validating the card.
+// The fields of vault accounts arrive encrypted, so their revisions are left as they are.
+func (s *CardsService) normalize(ctx context.Context, cond *models.Card) error {
+	if cond.Brand != "" {
+		return nil
+	}
+
+	params, err := s.u.Vault(ctx, cond.UserID)
+	if err != nil {
+		return err
+	}
+	if params == nil {
+		cond.Brand = card.Detect(card.Normalize(string(cond.Number)))
+	}
+	return nil
+}
+
 // decrypt deobfuscates encrypted fields of a credit card entity.
-func (s *CardsService) decrypt(result *models.Card) (*models.Card, error) {
+func (s *CardsService) decrypt(ctx context.Context, result *models.Card) (*models.Card, error) {
 	var err error
 
-	result.Bank, err = s.c.Decrypt(result.Bank)
+	result.Bank, err = s.c.Decrypt(ctx, cardField(result.UserID, result.ID, "bank"), result.Bank)
 	if err != nil {
 		return nil, err
 	}
-	result.Number, err = s.c.Decrypt(result.Number)
+	result.Number, err = s.c.Decrypt(ctx, cardField(result.UserID, result.ID, "number"), result.Number)
 	if err != nil {
 		return nil, err
 	}
-	result.DataEnd, err = s.c.Decrypt(result.DataEnd)
+	result.DataEnd, err = s.c.Decrypt(ctx, cardField(result.UserID, result.ID, "data_end"), result.DataEnd)
 	if err != nil {
 		return nil, err
 	}
-	result.SecretCode, err = s.c.Decrypt(result.SecretCode)
+	result.SecretCode, err = s.c.Decrypt(ctx, cardField(result.UserID, result.ID, "secret_code"), result.SecretCode)
 	if err != nil {
 		return nil, err
 	}
@@ -109,25 +288,35 @@ func (s *CardsService) decrypt(result *models.Card) (*models.Card, error) {
 }
 
 // encrypt secures the sensitive fields of a credit card entity before storage.
-func (s *CardsService) encrypt(cond models.Card) (models.Card, error) {
+func (s *CardsService) encrypt(ctx context.Context, cond models.Card) (models.Card, error) {
 	var err error
 
-	cond.Bank, err = s.c.Encrypt(cond.Bank)
+	cond.Bank, err = s.c.Encrypt(ctx, cardField(cond.UserID, cond.ID, "bank"), cond.Bank)
 	if err != nil {
 		return models.Card{}, err
 	}
-	cond.Number, err = s.c.Encrypt(cond.Number)
+	cond.Number, err = s.c.Encrypt(ctx, cardField(cond.UserID, cond.ID, "number"), cond.Number)
 	if err != nil {
 		return models.Card{}, err
 	}
-	cond.DataEnd, err = s.c.Encrypt(cond.DataEnd)
+	cond.DataEnd, err = s.c.Encrypt(ctx, cardField(cond.UserID, cond.ID, "data_end"), cond.DataEnd)
 	if err != nil {
 		return models.Card{}, err
 	}
-	cond.SecretCode, err = s.c.Encrypt(cond.SecretCode)
+	cond.SecretCode, err = s.c.Encrypt(ctx, cardField(cond.UserID, cond.ID, "secret_code"), cond.SecretCode)
 	if err != nil {
 		return models.Card{}, err
 	}
 
 	return cond, nil
 }
+
+// cardField locates an encrypted field of a credit card, binding its ciphertext to the record.
+func cardField(userID, id int64, field string) models.CipherContext {
+	return models.CipherContext{
+		UserID:   userID,
+		Table:    models.TableCards,
+		Field:    field,
+		RecordID: id,
+	}
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+)
+
+// Error definitions for common scenarios in client certificate operations.
+var (
+	ErrCertificateAlreadyBound = errors.New("client certificate already bound to a user") // Raised when the subject is already mapped.
+	ErrCertificateNotBound     = errors.New("client certificate not bound to a user")     // Raised when the subject is not mapped.
+)
+
+// CertificatesService maps the subjects of verified client certificates to users, so that a client
+// presenting its certificate under mutual TLS is authenticated without an access token.
+// A user binds the certificate of the current connection while authenticated by a token,
+// which proves possession of both the account and the certificate's private key; users with
+// a second factor also have to pass a code, as they would to log in. Every binding opens a session
+// of its own, whose tokens are never handed out: the certificate authenticates calls only while the
+// session is active, so revoking it like any other session locks a lost device out.
+type CertificatesService struct {
+	r  interfaces.CertificatesRepository // Repository persisting subject mappings.
+	ss interfaces.SessionsService        // Service opening and revoking the sessions backing the mappings.
+	tf interfaces.SecondFactorService    // Service checking the second factor of users binding a certificate.
+}
+
+// NewCertificatesService creates a new instance of CertificatesService with injected dependencies.
+func NewCertificatesService(r interfaces.CertificatesRepository, ss interfaces.SessionsService,
+	tf interfaces.SecondFactorService) *CertificatesService {
+	return &CertificatesService{
+		r:  r,
+		ss: ss,
+		tf: tf,
+	}
+}
+
+// Bind maps a client certificate subject to the user described by session and opens the session backing it,
+// returning its ID. The code is checked if the user has a second factor.
+func (s *CertificatesService) Bind(ctx context.Context, session models.Session, subject, code string) (int64, error) {
+	if err := s.tf.Require(ctx, session.UserID, code); err != nil {
+		return -1, err
+	}
+
+	session.UserAgent = "certificate " + subject
+	tokens, err := s.ss.Start(ctx, session)
+	if err != nil {
+		return -1, err
+	}
+
+	err = s.r.Add(ctx, session.UserID, subject, tokens.SessionID)
+	if err != nil {
+		return -1, errors.Join(err, s.ss.Revoke(ctx, session.UserID, tokens.SessionID))
+	}
+	return tokens.SessionID, nil
+}
+
+// Unbind removes a client certificate subject mapped to the user and revokes the session backing it.
+func (s *CertificatesService) Unbind(ctx context.Context, userID int64, subject string) error {
+	sessionID, err := s.r.Delete(ctx, userID, subject)
+	if err != nil {
+		return err
+	}
+
+	err = s.ss.Revoke(ctx, userID, sessionID)
+	if errors.Is(err, ErrSessionNotFound) {
+		return nil
+	}
+	return err
+}
+
+// List returns the client certificate subjects bound to the user whose sessions are active, newest first.
+func (s *CertificatesService) List(ctx context.Context, userID int64) ([]models.Certificate, error) {
+	return s.r.List(ctx, userID)
+}
+
+// Authenticate returns the user and the session a verified client certificate subject is mapped to.
+// Subjects whose session was revoked or expired are not bound.
+func (s *CertificatesService) Authenticate(ctx context.Context, subject string) (int64, int64, error) {
+	return s.r.UserID(ctx, subject)
+}
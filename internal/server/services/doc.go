@@ -6,10 +6,43 @@
 //
 //   - UsersService: Manages user registration and authentication using a UserRepository
 //     and a PassCryptoService for password hashing.
-//   - PasswordsService: Handles password data, encrypting and decrypting sensitive fields
-//     with the help of a CryptoService.
+//   - PasswordsService: Handles password data and typed custom fields (text, hidden, URL, email),
+//     encrypting and decrypting sensitive fields one by one with the help of a CryptoService.
+//     Audit reports weak, reused and stale passwords of regular accounts using the audit package.
+//     With a BreachCorpus, passwords of regular accounts are flagged as compromised when they are
+//     written, and the audit reports them and refreshes the flags. Passwords can be generated with
+//     the passgen package, either returned or stored straight into an entry without being returned.
 //   - CardsService: Manages credit card data, securing sensitive fields through encryption.
-//   - BinariesService: Stores and retrieves binary data, ensuring confidentiality via encryption.
+//     Cards of regular accounts are validated with the card package, which also detects their
+//     brand, kept in cleartext for listing. Get masks the number and leaves out the security code;
+//     Reveal returns them in full and records the reveal.
+//   - NotesService: Manages free-form text notes, encrypting their body.
+//   - OTPService: Manages authenticator secrets imported from otpauth:// URIs, optionally linked
+//     to a password, and generates their current codes unless the account is a vault one.
+//   - SSHKeysService: Manages SSH key pairs, keeping the validated public key and its fingerprint
+//     in cleartext for listing and encrypting the private key.
+//   - BinariesService: Stores and retrieves binary data, ensuring confidentiality via encryption;
+//     the content is kept in a BlobStore under a per-file content key, and large files are streamed.
+//   - Every update and purge of a password, card, note, authenticator secret, SSH key or binary archives
+//     its previous version; History lists the revisions of a title and Restore brings one back.
+//     UsersService sets how many are kept.
+//     Rename gives a record, found by its stable ID or its title, a new title in a single transaction,
+//     and its revisions follow it.
+//   - Records of every kind keep the time they were created, last changed and last read, and how often
+//     they were read; a Get counts as a read, while renaming or placing a record changes none of these.
+//     Listings can be ordered by these times and restricted to records not changed or read since a given time.
+//   - TrashService: Manages deleted records, which are hidden until they are restored or purged,
+//     either by the user or once the trash retention expires.
+//   - FoldersService: Manages the folder tree records of every kind are placed in; records also carry
+//     tags, and listings can be restricted to a folder with its subfolders and to a set of tags.
+//   - KeysService: Implements the CryptoService with per-user data-encryption keys that are
+//     stored wrapped by the master key (envelope encryption), binding every ciphertext
+//     to its owner, table, field and record. Unwrapped keys are cached for a bounded time and
+//     number of users, and dropped when a session of their user ends.
+//   - VaultCryptoService: Wraps the CryptoService and skips it for zero-knowledge vault accounts,
+//     whose records are encrypted by the client.
+//   - SecondFactorService: Manages TOTP two-factor authentication with one-time recovery codes
+//     and completes the logins awaiting a second factor.
 //
 // All services depend on repositories and crypto services defined in the interfaces package,
 // which allows for easy mocking and unit testing.
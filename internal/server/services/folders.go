@@ -0,0 +1,374 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits applied to folder names and item tags.
+const (
+	MaxFolderNameLen = 255 // Longest folder name in characters.
+	MaxTagLen        = 64  // Longest tag in characters.
+	MaxTags          = 32  // Most tags a single item can carry.
+)
+
+// Error definitions for common scenarios in folder and tag operations.
+var (
+	ErrFolderNotFound      = errors.New("folder not found")                        // Raised when a path does not name an existing folder.
+	ErrFolderAlreadyExists = errors.New("folder already exists")                   // Thrown when a folder of the same name exists next to it.
+	ErrFolderNotEmpty      = errors.New("folder is not empty")                     // Raised when removing a folder holding subfolders or items.
+	ErrInvalidFolder       = errors.New("invalid folder path")                     // Raised when a path has empty or overlong names.
+	ErrFolderCycle         = errors.New("folder cannot be moved into its subtree") // Raised when moving a folder under itself.
+	ErrInvalidTag          = errors.New("invalid tag")                             // Raised when a tag is empty, overlong or too many are given.
+	ErrItemNotFound        = errors.New("record not found")                        // Raised when placing a live record that does not exist.
+)
+
+// FoldersService manages the folder tree items of every kind are placed in.
+// Folders are addressed by slash-separated paths; their names are stored in cleartext, like titles.
+type FoldersService struct {
+	r interfaces.FoldersRepository // Repository dependency for interacting with the persistent store.
+}
+
+// NewFoldersService creates a new instance of FoldersService with injected dependencies.
+func NewFoldersService(r interfaces.FoldersRepository) *FoldersService {
+	return &FoldersService{
+		r: r,
+	}
+}
+
+// Create creates the folder at path along with its missing ancestors.
+// ErrFolderAlreadyExists is returned if the folder itself exists already.
+func (s *FoldersService) Create(ctx context.Context, userID int64, path string) error {
+	names, err := splitFolderPath(path)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return ErrInvalidFolder
+	}
+
+	tree, err := s.tree(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	var parentID int64
+	for i, name := range names {
+		id, ok := tree.child(parentID, name)
+		if ok && i == len(names)-1 {
+			return ErrFolderAlreadyExists
+		}
+		if !ok {
+			id, err = s.r.Add(ctx, models.Folder{UserID: userID, ParentID: parentID, Name: name})
+			if err != nil {
+				return err
+			}
+		}
+		parentID = id
+	}
+	return nil
+}
+
+// Move places the folder at path under the folder at parent, the root if it is empty, renaming it to name
+// unless name is empty. Items and subfolders move along with the folder.
+func (s *FoldersService) Move(ctx context.Context, userID int64, path, parent, name string) error {
+	tree, err := s.tree(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	folder, err := tree.resolve(path)
+	if err != nil {
+		return err
+	}
+	if folder == 0 {
+		return ErrInvalidFolder
+	}
+	parentID, err := tree.resolve(parent)
+	if err != nil {
+		return err
+	}
+	if tree.contains(folder, parentID) {
+		return ErrFolderCycle
+	}
+
+	cond := tree.byID[folder]
+	cond.ParentID = parentID
+	if name != "" {
+		names, err := splitFolderPath(name)
+		if err != nil {
+			return err
+		}
+		if len(names) != 1 {
+			return ErrInvalidFolder
+		}
+		cond.Name = names[0]
+	}
+	return s.r.Move(ctx, cond)
+}
+
+// Remove removes the empty folder at path; ErrFolderNotEmpty is returned if it still holds subfolders or items.
+func (s *FoldersService) Remove(ctx context.Context, userID int64, path string) error {
+	tree, err := s.tree(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	folder, err := tree.resolve(path)
+	if err != nil {
+		return err
+	}
+	if folder == 0 {
+		return ErrInvalidFolder
+	}
+	return s.r.Remove(ctx, folder, userID)
+}
+
+// List returns the paths of all folders of the user in alphabetical order.
+func (s *FoldersService) List(ctx context.Context, userID int64) ([]string, error) {
+	tree, err := s.tree(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(tree.byID))
+	for id := range tree.byID {
+		result = append(result, tree.path(id))
+	}
+	slices.Sort(result)
+	return result, nil
+}
+
+// Place moves the live item of the given kind and title to a folder and replaces its tags, each only if
+// the placement asks for it. The content of the item is left untouched, so it needs no decryption.
+func (s *FoldersService) Place(ctx context.Context, userID int64, kind, title string, in models.Placement) error {
+	p := newPlacer(s.r, kind)
+
+	placement, err := p.resolve(ctx, userID, in)
+	if err != nil {
+		return err
+	}
+	return p.place(ctx, title, userID, placement)
+}
+
+// tree loads the folder tree of a user.
+func (s *FoldersService) tree(ctx context.Context, userID int64) (*folderTree, error) {
+	return loadFolderTree(ctx, s.r, userID)
+}
+
+// folderTree is an in-memory view of the folder tree of a user, used to resolve paths.
+type folderTree struct {
+	byID     map[int64]models.Folder    // Folders by ID.
+	children map[int64]map[string]int64 // IDs of folders by parent ID and name; the root has ID zero.
+}
+
+// loadFolderTree reads every folder of a user into a folderTree.
+func loadFolderTree(ctx context.Context, r interfaces.FoldersRepository, userID int64) (*folderTree, error) {
+	folders, err := r.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := &folderTree{
+		byID:     make(map[int64]models.Folder, len(folders)),
+		children: make(map[int64]map[string]int64),
+	}
+	for _, folder := range folders {
+		tree.byID[folder.ID] = folder
+		if tree.children[folder.ParentID] == nil {
+			tree.children[folder.ParentID] = make(map[string]int64)
+		}
+		tree.children[folder.ParentID][folder.Name] = folder.ID
+	}
+	return tree, nil
+}
+
+// child returns the ID of the folder with the given name under the given parent.
+func (t *folderTree) child(parentID int64, name string) (int64, bool) {
+	id, ok := t.children[parentID][name]
+	return id, ok
+}
+
+// resolve returns the ID of the folder at path; zero stands for the root.
+func (t *folderTree) resolve(path string) (int64, error) {
+	names, err := splitFolderPath(path)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	for _, name := range names {
+		var ok bool
+		id, ok = t.child(id, name)
+		if !ok {
+			return 0, ErrFolderNotFound
+		}
+	}
+	return id, nil
+}
+
+// path returns the slash-separated path of a folder; the root has an empty path.
+func (t *folderTree) path(id int64) string {
+	var names []string
+	for id != 0 {
+		folder, ok := t.byID[id]
+		if !ok {
+			break
+		}
+		names = append(names, folder.Name)
+		id = folder.ParentID
+	}
+	slices.Reverse(names)
+	return strings.Join(names, "/")
+}
+
+// contains reports whether the folder id lies in the subtree of ancestor, the folder itself included.
+func (t *folderTree) contains(ancestor, id int64) bool {
+	for id != 0 {
+		if id == ancestor {
+			return true
+		}
+		id = t.byID[id].ParentID
+	}
+	return false
+}
+
+// splitFolderPath splits a slash-separated folder path into folder names.
+// Surrounding slashes are ignored, so the empty path and "/" both name the root.
+func splitFolderPath(path string) ([]string, error) {
+	path = strings.Trim(strings.TrimSpace(path), "/")
+	if path == "" {
+		return nil, nil
+	}
+
+	names := strings.Split(path, "/")
+	for i, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" || utf8.RuneCountInString(name) > MaxFolderNameLen {
+			return nil, ErrInvalidFolder
+		}
+		names[i] = name
+	}
+	return names, nil
+}
+
+// normalizeTags trims tags, drops duplicates and sorts them, rejecting empty or overlong tags.
+func normalizeTags(tags []string) ([]string, error) {
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || utf8.RuneCountInString(tag) > MaxTagLen {
+			return nil, ErrInvalidTag
+		}
+		result = append(result, tag)
+	}
+
+	slices.Sort(result)
+	result = slices.Compact(result)
+	if len(result) > MaxTags {
+		return nil, ErrInvalidTag
+	}
+	return result, nil
+}
+
+// placer places the items of one kind in folders and tags them on behalf of the service of that kind.
+type placer struct {
+	f    interfaces.FoldersRepository // Repository holding folders and tags.
+	kind string                       // Kind of the items, the name of the table holding them.
+}
+
+// newPlacer creates a placer for items of the given kind.
+func newPlacer(f interfaces.FoldersRepository, kind string) placer {
+	return placer{
+		f:    f,
+		kind: kind,
+	}
+}
+
+// resolve checks the placement requested for an item of the user before the item is written,
+// normalizing its tags and resolving its folder path to an ID.
+func (p placer) resolve(ctx context.Context, userID int64, in models.Placement) (models.Placement, error) {
+	if in.ReplaceTags {
+		tags, err := normalizeTags(in.Tags)
+		if err != nil {
+			return models.Placement{}, err
+		}
+		in.Tags = tags
+	}
+
+	if in.ReplaceFolder {
+		tree, err := loadFolderTree(ctx, p.f, userID)
+		if err != nil {
+			return models.Placement{}, err
+		}
+		in.FolderID, err = tree.resolve(in.Folder)
+		if err != nil {
+			return models.Placement{}, err
+		}
+	}
+	return in, nil
+}
+
+// place stores the resolved placement of the live item with the given title.
+func (p placer) place(ctx context.Context, title string, userID int64, in models.Placement) error {
+	return p.f.Place(ctx, p.kind, title, userID, in)
+}
+
+// load returns the placement of a stored item with its folder path.
+func (p placer) load(ctx context.Context, id, userID int64) (models.Placement, error) {
+	result, err := p.f.Placement(ctx, p.kind, id)
+	if err != nil {
+		return models.Placement{}, err
+	}
+
+	if result.FolderID != 0 {
+		tree, err := loadFolderTree(ctx, p.f, userID)
+		if err != nil {
+			return models.Placement{}, err
+		}
+		result.Folder = tree.path(result.FolderID)
+	}
+	return *result, nil
+}
+
+// paginate resolves the folder and tags a listing query is restricted to and fetches the requested page.
+func (p placer) paginate(ctx context.Context, query models.ListQuery, fetch func(context.Context, models.ListFilter) ([]models.ListItem, error)) (*models.ListPage, error) {
+	var folderID int64
+	if query.Folder != "" {
+		tree, err := loadFolderTree(ctx, p.f, query.UserID)
+		if err != nil {
+			return nil, err
+		}
+		folderID, err = tree.resolve(query.Folder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tags, err := normalizeTags(query.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return paginate(ctx, query, func(ctx context.Context, filter models.ListFilter) ([]models.ListItem, error) {
+		filter.FolderID = folderID
+		filter.Tags = tags
+		return fetch(ctx, filter)
+	})
+}
+
+// write resolves the requested placement of an item and writes the item with it using write,
+// so items are not written with a placement that cannot be resolved. The item is placed by the repository
+// in the transaction writing it, so a failed placement does not leave the item behind.
+func (p placer) write(ctx context.Context, userID int64, in models.Placement, write func(models.Placement) (string, error)) (string, error) {
+	placement, err := p.resolve(ctx, userID, in)
+	if err != nil {
+		return "", err
+	}
+	return write(placement)
+}
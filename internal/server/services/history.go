@@ -0,0 +1,6 @@
+package services
+
+import "errors"
+
+// ErrRevisionNotFound is raised when restoring a revision that was never archived or was already pruned.
+var ErrRevisionNotFound = errors.New("revision not found")
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/internal/server/crypto"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+	"sync"
+	"time"
+)
+
+// Error definitions for common scenarios in key service operations.
+var (
+	ErrUserKeyNotFound  = errors.New("user key not found") // Raised when a user has no data-encryption key yet.
+	ErrDecryptionFailed = errors.New("decryption failed")  // Raised when a ciphertext does not open with any applicable key.
+)
+
+// Bounds of the cache of unwrapped data-encryption keys.
+const (
+	keyCacheTTL  = 15 * time.Minute // Time an unwrapped key is kept after it was unwrapped.
+	keyCacheSize = 1024             // Number of users whose keys are kept at most.
+)
+
+// cachedKey is an unwrapped data-encryption key along with the time it is dropped from the cache.
+type cachedKey struct {
+	key     *crypto.Aes // Unwrapped data-encryption key.
+	expires time.Time   // Time after which the key is unwrapped again.
+}
+
+// KeysService implements per-user envelope encryption.
+// Every user owns a random data-encryption key (DEK) that is stored wrapped by the master
+// key-encryption key (KEK), so leaking one DEK exposes a single user only, and deleting the
+// wrapped key makes that user's records permanently unreadable.
+//
+// Ciphertexts are sealed with the cipher context as AES-GCM associated data, binding them to
+// their owner, table, field and record. Unbound ciphertexts written by earlier versions are
+// still accepted unless strict mode is enabled.
+//
+// Unwrapped keys are cached for keyCacheTTL, for at most keyCacheSize users, and dropped as soon as
+// a session of their user ends, so they do not linger in memory for the life of the process.
+type KeysService struct {
+	r      interfaces.UserKeysRepository // Repository holding the wrapped data-encryption keys.
+	master interfaces.Cipher             // Master key used to wrap and unwrap data-encryption keys.
+	strict bool                          // Rejects ciphertexts that are not bound to their location.
+	mu     sync.RWMutex                  // Guards the cache of unwrapped keys.
+	cache  map[int64]cachedKey           // Unwrapped data-encryption keys indexed by user ID.
+}
+
+// NewKeysService creates a new instance of KeysService with injected dependencies.
+// With strict set, only ciphertexts bound to their location are decrypted.
+func NewKeysService(r interfaces.UserKeysRepository, master interfaces.Cipher, strict bool) *KeysService {
+	return &KeysService{
+		r:      r,
+		master: master,
+		strict: strict,
+		cache:  make(map[int64]cachedKey),
+	}
+}
+
+// Forget drops the cached data-encryption key of the user; it is unwrapped again on its next use.
+func (s *KeysService) Forget(userID int64) {
+	s.mu.Lock()
+	delete(s.cache, userID)
+	s.mu.Unlock()
+}
+
+// Encrypt encrypts data with the data-encryption key of the record owner, creating the key on first use.
+// The ciphertext is bound to the given location.
+func (s *KeysService) Encrypt(ctx context.Context, cc models.CipherContext, data []byte) ([]byte, error) {
+	key, err := s.dataKey(ctx, cc.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return key.Seal(data, associatedData(cc))
+}
+
+// Decrypt decrypts data with the data-encryption key of the record owner.
+// A bound ciphertext opens only at the location it was encrypted for. Records written before
+// per-user keys were introduced are encrypted with the master key directly; like other unbound
+// ciphertexts they are readable outside of strict mode and get upgraded on the next update.
+func (s *KeysService) Decrypt(ctx context.Context, cc models.CipherContext, data []byte) ([]byte, error) {
+	if s.strict && !crypto.IsBound(data) {
+		return nil, fmt.Errorf("%w: %s.%s of record %d is not bound to its location", ErrDecryptionFailed, cc.Table, cc.Field, cc.RecordID)
+	}
+
+	key, err := s.dataKey(ctx, cc.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := key.Open(data, associatedData(cc))
+	if err != nil {
+		if !s.strict {
+			if legacy, legacyErr := s.master.Decrypt(data); legacyErr == nil {
+				return legacy, nil
+			}
+		}
+		return nil, fmt.Errorf("%w: %v", ErrDecryptionFailed, err)
+	}
+	return result, nil
+}
+
+// associatedData serializes the cipher context into the associated data authenticated by AES-GCM.
+func associatedData(cc models.CipherContext) []byte {
+	return fmt.Appendf(nil, "gophkeeper/%s/%s/%d/%d", cc.Table, cc.Field, cc.UserID, cc.RecordID)
+}
+
+// dataKey returns the unwrapped data-encryption key of the user, generating and storing a new one if needed.
+func (s *KeysService) dataKey(ctx context.Context, userID int64) (*crypto.Aes, error) {
+	now := time.Now()
+
+	s.mu.RLock()
+	cached, ok := s.cache[userID]
+	s.mu.RUnlock()
+	if ok && now.Before(cached.expires) {
+		return cached.key, nil
+	}
+
+	wrapped, err := s.r.Get(ctx, userID)
+	if errors.Is(err, ErrUserKeyNotFound) {
+		wrapped, err = s.newWrappedKey(ctx, userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := s.master.Decrypt(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unwrap key of user %d: %v", ErrDecryptionFailed, userID, err)
+	}
+	key, err := crypto.NewAes(crypto.DataKeyID, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.evict(now)
+	s.cache[userID] = cachedKey{key: key, expires: now.Add(keyCacheTTL)}
+	s.mu.Unlock()
+
+	return key, nil
+}
+
+// evict makes room for a key in a full cache, dropping the expired keys or, if none expired, the one expiring first.
+// The caller holds the lock.
+func (s *KeysService) evict(now time.Time) {
+	if len(s.cache) < keyCacheSize {
+		return
+	}
+
+	var (
+		oldest  int64
+		expires time.Time
+	)
+	for userID, cached := range s.cache {
+		if !now.Before(cached.expires) {
+			delete(s.cache, userID)
+			continue
+		}
+		if expires.IsZero() || cached.expires.Before(expires) {
+			oldest, expires = userID, cached.expires
+		}
+	}
+	if len(s.cache) >= keyCacheSize {
+		delete(s.cache, oldest)
+	}
+}
+
+// newWrappedKey generates a data-encryption key for the user and persists it wrapped by the master key.
+// The returned key is the one actually stored, which differs if another request created it concurrently.
+func (s *KeysService) newWrappedKey(ctx context.Context, userID int64) ([]byte, error) {
+	raw, err := crypto.NewDataKey()
+	if err != nil {
+		return nil, err
+	}
+
+	wrapped, err := s.master.Encrypt(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.r.Add(ctx, userID, wrapped)
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"main/internal/server/models"
+	"time"
+)
+
+// Page size limits applied to every listing.
+const (
+	DefaultPageSize = 20  // Page size used when the client does not specify one.
+	MaxPageSize     = 100 // Upper bound for a single page.
+)
+
+// ErrInvalidCursor is returned when a listing cursor cannot be decoded or does not match the requested ordering.
+var ErrInvalidCursor = errors.New("invalid list cursor")
+
+// cursorPayload is the serialized form of a listing cursor handed out to clients.
+type cursorPayload struct {
+	SortBy     models.SortField `json:"s"` // Ordering the cursor was issued for.
+	ID         int64            `json:"i"` // Identifier of the last returned record.
+	Title      string           `json:"t"` // Title of the last returned record.
+	CreatedAt  time.Time        `json:"c"` // Creation time of the last returned record.
+	UpdatedAt  time.Time        `json:"u"` // Time the content of the last returned record was last replaced.
+	AccessedAt time.Time        `json:"a"` // Time the last returned record was last read; zero if it never was.
+}
+
+// paginate resolves a client listing query into a repository filter, fetches one extra record
+// to detect whether another page exists, and encodes the cursor of the following page.
+func paginate(ctx context.Context, query models.ListQuery, fetch func(context.Context, models.ListFilter) ([]models.ListItem, error)) (*models.ListPage, error) {
+	size := query.PageSize
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+	if size > MaxPageSize {
+		size = MaxPageSize
+	}
+
+	after, err := decodeCursor(query.Cursor, query.SortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := fetch(ctx, models.ListFilter{
+		UserID:         query.UserID,
+		TitlePrefix:    query.TitlePrefix,
+		UpdatedBefore:  query.UpdatedBefore,
+		AccessedBefore: query.AccessedBefore,
+		SortBy:         query.SortBy,
+		Descending:     query.Descending,
+		Limit:          size + 1,
+		After:          after,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.ListPage{Items: items}
+	if len(items) > size {
+		page.Items = items[:size]
+		page.NextCursor, err = encodeCursor(page.Items[size-1], query.SortBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// encodeCursor serializes the position of the given record into an opaque URL-safe cursor.
+func encodeCursor(item models.ListItem, sortBy models.SortField) (string, error) {
+	payload := cursorPayload{
+		SortBy:    sortBy,
+		ID:        item.ID,
+		Title:     item.Title,
+		CreatedAt: item.Stats.CreatedAt,
+		UpdatedAt: item.Stats.UpdatedAt,
+	}
+	if item.Stats.AccessedAt != nil {
+		payload.AccessedAt = *item.Stats.AccessedAt
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// decodeCursor parses an opaque cursor, returning nil for an empty one.
+// Cursors issued for a different ordering are rejected.
+func decodeCursor(cursor string, sortBy models.SortField) (*models.ListCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var payload cursorPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if payload.SortBy != sortBy || payload.ID <= 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return &models.ListCursor{
+		ID:         payload.ID,
+		Title:      payload.Title,
+		CreatedAt:  payload.CreatedAt,
+		UpdatedAt:  payload.UpdatedAt,
+		AccessedAt: payload.AccessedAt,
+	}, nil
+}
@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+)
+
+// Error definitions for common scenarios in note service operations.
+var (
+	ErrNoteAlreadyExists = errors.New("note already exists") // Thrown when attempting to add a duplicate note.
+	ErrNoteNotFound      = errors.New("note not found")      // Raised when get a non-existent note.
+)
+
+// NotesService manages the lifecycle of notes, integrating encryption for sensitive data.
+type NotesService struct {
+	r interfaces.NotesRepository // Repository dependency for interacting with the persistent store.
+	c interfaces.CryptoService   // Encryption service dependency for securing note bodies.
+	p placer                     // Places notes in folders and tags them.
+	k binder                     // Keeps notes under the IDs their bodies are bound to.
+}
+
+// NewNotesService creates a new instance of NotesService with injected dependencies.
+func NewNotesService(r interfaces.NotesRepository, u interfaces.UsersRepository, f interfaces.FoldersRepository,
+	rr interfaces.RecordsRepository, c interfaces.CryptoService) *NotesService {
+	return &NotesService{
+		r: r,
+		c: c,
+		p: newPlacer(f, models.TableNotes),
+		k: newBinder(rr, u, models.TableNotes),
+	}
+}
+
+// Get retrieves a note by title and user ID, decrypting its body.
+// The read is counted, while the returned statistics are those from before it.
+func (s *NotesService) Get(ctx context.Context, title string, UserID int64) (*models.Note, error) {
+	result, err := s.r.Get(ctx, title, UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err = s.decrypt(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Placement, err = s.p.load(ctx, result.ID, UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.r.Touch(ctx, result.ID); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Add persists a new note in its folder with its tags, encrypting its body beforehand.
+// A note given an ID is stored under it, once the reservation of the ID is consumed.
+func (s *NotesService) Add(ctx context.Context, cond models.Note) (string, error) {
+	return s.p.write(ctx, cond.UserID, cond.Placement, func(placement models.Placement) (string, error) {
+		cond.Placement = placement
+		if err := s.k.claim(ctx, cond.ID, cond.UserID); err != nil {
+			return "", err
+		}
+		return s.add(ctx, cond)
+	})
+}
+
+// add stores a new note under its ID or a freshly reserved one, so the ciphertext can be bound to it.
+func (s *NotesService) add(ctx context.Context, cond models.Note) (string, error) {
+	var err error
+
+	if cond.ID == 0 {
+		cond.ID, err = s.r.NextID(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	cond, err = s.encrypt(ctx, cond)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := s.r.Add(ctx, cond)
+	if err != nil {
+		return "", err
+	}
+
+	return result, nil
+}
+
+// Update replaces the body of an existing note, encrypting it anew.
+// The note is moved and retagged only as far as its placement asks for it.
+func (s *NotesService) Update(ctx context.Context, cond models.Note) (string, error) {
+	return s.p.write(ctx, cond.UserID, cond.Placement, func(placement models.Placement) (string, error) {
+		cond.Placement = placement
+		return s.update(ctx, cond)
+	})
+}
+
+// update replaces a note; the record is resolved to its ID first, and the ciphertext is bound to that ID.
+// A note given an ID is only replaced if it is still the record of the title.
+func (s *NotesService) update(ctx context.Context, cond models.Note) (string, error) {
+	id, err := s.r.GetID(ctx, cond.Title, cond.UserID)
+	if err != nil {
+		return "", err
+	}
+	if err := sameRecord(cond.ID, id); err != nil {
+		return "", err
+	}
+	cond.ID = id
+
+	cond, err = s.encrypt(ctx, cond)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := s.r.Update(ctx, cond)
+	if err != nil {
+		return "", err
+	}
+
+	return result, nil
+}
+
+// Delete moves a note to the trash by title and user ID without needing decryption;
+// ErrNoteNotFound is returned if there is none.
+func (s *NotesService) Delete(ctx context.Context, title string, UserID int64) error {
+	err := s.r.Delete(ctx, title, UserID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Rename gives a note found by ID or title a new title once the title is checked;
+// its body stays bound to the record ID, so nothing is re-encrypted.
+func (s *NotesService) Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error) {
+	if err := checkTitle(cond.NewTitle); err != nil {
+		return nil, err
+	}
+	return s.r.Rename(ctx, cond)
+}
+
+// List returns a page of the user's notes; only titles are listed, so nothing is decrypted.
+func (s *NotesService) List(ctx context.Context, query models.ListQuery) (*models.ListPage, error) {
+	return s.p.paginate(ctx, query, s.r.List)
+}
+
+// History lists the archived revisions of a note, newest first.
+// Revisions are kept by title, so those of a deleted note are listed as well.
+func (s *NotesService) History(ctx context.Context, title string, UserID int64) ([]models.Revision, error) {
+	return s.r.History(ctx, title, UserID)
+}
+
+// Restore brings back an archived revision of a note (see restore).
+func (s *NotesService) Restore(ctx context.Context, title string, UserID int64, revision int) (string, error) {
+	rev, err := s.r.GetRevision(ctx, title, UserID, revision)
+	if err != nil {
+		return "", err
+	}
+
+	rev, err = s.decrypt(ctx, rev)
+	if err != nil {
+		return "", err
+	}
+
+	return restore(ctx, s.k, rev, &rev.ID, UserID, ErrNoteNotFound, s.Update, s.add)
+}
+
+// decrypt deobfuscates the body of a note.
+func (s *NotesService) decrypt(ctx context.Context, result *models.Note) (*models.Note, error) {
+	var err error
+
+	result.Body, err = s.c.Decrypt(ctx, noteField(result.UserID, result.ID, "body"), result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// encrypt secures the body of a note before storage.
+func (s *NotesService) encrypt(ctx context.Context, cond models.Note) (models.Note, error) {
+	var err error
+
+	cond.Body, err = s.c.Encrypt(ctx, noteField(cond.UserID, cond.ID, "body"), cond.Body)
+	if err != nil {
+		return models.Note{}, err
+	}
+
+	return cond, nil
+}
+
+// noteField locates an encrypted field of a note, binding its ciphertext to the record.
+func noteField(userID, id int64, field string) models.CipherContext {
+	return models.CipherContext{
+		UserID:   userID,
+		Table:    models.TableNotes,
+		Field:    field,
+		RecordID: id,
+	}
+}
@@ -0,0 +1,308 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/otp"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+	"time"
+)
+
+// Error definitions for common scenarios in authenticator secret operations.
+var (
+	ErrOTPAlreadyExists       = errors.New("authenticator secret already exists")                        // Thrown when attempting to add a duplicate secret.
+	ErrOTPNotFound            = errors.New("authenticator secret not found")                             // Raised when get a non-existent secret.
+	ErrInvalidOTP             = errors.New("invalid authenticator secret")                               // Raised when the secret or its code parameters are unusable.
+	ErrOTPClientSide          = errors.New("codes of vault accounts are generated by the client")        // Raised when the server cannot read the secret of a vault account.
+	ErrLinkedPasswordNotFound = errors.New("password to link the authenticator secret to was not found") // Raised when linking a secret to a non-existent password.
+)
+
+// OTPService manages authenticator secrets (TOTP) and generates their codes.
+// The issuer, account and secret are encrypted; the code parameters are stored as they are.
+// Codes of vault accounts cannot be generated here, because the server never sees their secrets.
+type OTPService struct {
+	r interfaces.OTPRepository       // Repository dependency for interacting with the persistent store.
+	p interfaces.PasswordsRepository // Repository resolving the passwords secrets are linked to.
+	u interfaces.UsersRepository     // Repository telling vault accounts apart.
+	c interfaces.CryptoService       // Encryption service dependency for securing the secrets.
+	f placer                         // Places secrets in folders and tags them.
+	k binder                         // Keeps secrets under the IDs their fields are bound to.
+}
+
+// NewOTPService creates a new instance of OTPService with injected dependencies.
+func NewOTPService(r interfaces.OTPRepository, p interfaces.PasswordsRepository, u interfaces.UsersRepository, f interfaces.FoldersRepository,
+	rr interfaces.RecordsRepository, c interfaces.CryptoService) *OTPService {
+	return &OTPService{
+		r: r,
+		p: p,
+		u: u,
+		c: c,
+		f: newPlacer(f, models.TableOTP),
+		k: newBinder(rr, u, models.TableOTP),
+	}
+}
+
+// Get retrieves an authenticator secret by title and user ID, decrypting its confidential fields.
+// The read is counted, while the returned statistics are those from before it.
+func (s *OTPService) Get(ctx context.Context, title string, UserID int64) (*models.OTP, error) {
+	result, err := s.r.Get(ctx, title, UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err = s.decrypt(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Placement, err = s.f.load(ctx, result.ID, UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.r.Touch(ctx, result.ID); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Add persists a new authenticator secret in its folder with its tags, linked to the password titled
+// PasswordTitle unless it is empty. The code parameters are validated, and so is the secret unless the client encrypted it.
+// A secret given an ID is stored under it, once the reservation of the ID is consumed.
+func (s *OTPService) Add(ctx context.Context, cond models.OTP) (string, error) {
+	var err error
+
+	cond, err = s.prepare(ctx, cond)
+	if err != nil {
+		return "", err
+	}
+	return s.f.write(ctx, cond.UserID, cond.Placement, func(placement models.Placement) (string, error) {
+		cond.Placement = placement
+		if err := s.k.claim(ctx, cond.ID, cond.UserID); err != nil {
+			return "", err
+		}
+		return s.add(ctx, cond)
+	})
+}
+
+// Update replaces an existing authenticator secret and its password link, archiving the previous version.
+// The secret is moved and retagged only as far as its placement asks for it.
+func (s *OTPService) Update(ctx context.Context, cond models.OTP) (string, error) {
+	var err error
+
+	cond, err = s.prepare(ctx, cond)
+	if err != nil {
+		return "", err
+	}
+	return s.f.write(ctx, cond.UserID, cond.Placement, func(placement models.Placement) (string, error) {
+		cond.Placement = placement
+		return s.update(ctx, cond)
+	})
+}
+
+// Delete moves an authenticator secret to the trash by title and user ID without needing decryption;
+// ErrOTPNotFound is returned if there is none.
+func (s *OTPService) Delete(ctx context.Context, title string, UserID int64) error {
+	err := s.r.Delete(ctx, title, UserID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Rename gives an authenticator secret found by ID or title a new title once the title is checked;
+// its fields stay bound to the record ID, so nothing is re-encrypted.
+func (s *OTPService) Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error) {
+	if err := checkTitle(cond.NewTitle); err != nil {
+		return nil, err
+	}
+	return s.r.Rename(ctx, cond)
+}
+
+// List returns a page of the user's authenticator secrets; only titles are listed, so nothing is decrypted.
+func (s *OTPService) List(ctx context.Context, query models.ListQuery) (*models.ListPage, error) {
+	return s.f.paginate(ctx, query, s.r.List)
+}
+
+// History lists the archived revisions of an authenticator secret, newest first.
+// Revisions are kept by title, so those of a deleted secret are listed as well.
+func (s *OTPService) History(ctx context.Context, title string, UserID int64) ([]models.Revision, error) {
+	return s.r.History(ctx, title, UserID)
+}
+
+// Restore brings back an archived revision of an authenticator secret (see restore).
+// The revision keeps its password link unless that password has been purged meanwhile.
+func (s *OTPService) Restore(ctx context.Context, title string, UserID int64, revision int) (string, error) {
+	rev, err := s.r.GetRevision(ctx, title, UserID, revision)
+	if err != nil {
+		return "", err
+	}
+
+	rev, err = s.decrypt(ctx, rev)
+	if err != nil {
+		return "", err
+	}
+
+	return restore(ctx, s.k, rev, &rev.ID, UserID, ErrOTPNotFound, s.update, s.add)
+}
+
+// GenerateCode computes the current code of an authenticator secret and the time left until it expires.
+// It fails with ErrOTPClientSide for vault accounts, whose secrets the server cannot decrypt.
+func (s *OTPService) GenerateCode(ctx context.Context, title string, UserID int64) (*models.OTPCode, error) {
+	vault, err := s.isVault(ctx, UserID)
+	if err != nil {
+		return nil, err
+	}
+	if vault {
+		return nil, ErrOTPClientSide
+	}
+
+	result, err := s.Get(ctx, title, UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := otp.DecodeSecret(string(result.Secret))
+	if err != nil {
+		return nil, ErrInvalidOTP
+	}
+
+	code, expiresIn, err := otp.Code(secret, result.Algorithm, result.Digits, result.Period, time.Now())
+	if err != nil {
+		return nil, ErrInvalidOTP
+	}
+
+	return &models.OTPCode{
+		Code:      code,
+		ExpiresIn: expiresIn,
+		Period:    time.Duration(result.Period) * time.Second,
+	}, nil
+}
+
+// prepare validates an authenticator secret received from a client and resolves the password it is linked to.
+func (s *OTPService) prepare(ctx context.Context, cond models.OTP) (models.OTP, error) {
+	if err := otp.Validate(cond.Algorithm, cond.Digits, cond.Period); err != nil {
+		return models.OTP{}, ErrInvalidOTP
+	}
+
+	vault, err := s.isVault(ctx, cond.UserID)
+	if err != nil {
+		return models.OTP{}, err
+	}
+	if !vault {
+		if _, err := otp.DecodeSecret(string(cond.Secret)); err != nil {
+			return models.OTP{}, ErrInvalidOTP
+		}
+	}
+
+	cond.PasswordID = 0
+	if cond.PasswordTitle != "" {
+		cond.PasswordID, err = s.p.GetID(ctx, cond.PasswordTitle, cond.UserID)
+		if errors.Is(err, ErrPasswordNotFound) {
+			return models.OTP{}, ErrLinkedPasswordNotFound
+		}
+		if err != nil {
+			return models.OTP{}, err
+		}
+	}
+	return cond, nil
+}
+
+// add stores a new authenticator secret under its ID or a freshly reserved one, so the ciphertexts can be bound to it.
+func (s *OTPService) add(ctx context.Context, cond models.OTP) (string, error) {
+	var err error
+
+	if cond.ID == 0 {
+		cond.ID, err = s.r.NextID(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	cond, err = s.encrypt(ctx, cond)
+	if err != nil {
+		return "", err
+	}
+
+	return s.r.Add(ctx, cond)
+}
+
+// update replaces an authenticator secret; the record is resolved to its ID first, and the ciphertexts are bound to that ID.
+// A secret given an ID is only replaced if it is still the record of the title.
+func (s *OTPService) update(ctx context.Context, cond models.OTP) (string, error) {
+	id, err := s.r.GetID(ctx, cond.Title, cond.UserID)
+	if err != nil {
+		return "", err
+	}
+	if err := sameRecord(cond.ID, id); err != nil {
+		return "", err
+	}
+	cond.ID = id
+
+	cond, err = s.encrypt(ctx, cond)
+	if err != nil {
+		return "", err
+	}
+
+	return s.r.Update(ctx, cond)
+}
+
+// isVault reports whether the user encrypts records on the client side.
+func (s *OTPService) isVault(ctx context.Context, userID int64) (bool, error) {
+	params, err := s.u.Vault(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return params != nil, nil
+}
+
+// decrypt deobfuscates encrypted fields of an authenticator secret.
+func (s *OTPService) decrypt(ctx context.Context, result *models.OTP) (*models.OTP, error) {
+	var err error
+
+	result.Issuer, err = s.c.Decrypt(ctx, otpField(result.UserID, result.ID, "issuer"), result.Issuer)
+	if err != nil {
+		return nil, err
+	}
+	result.Account, err = s.c.Decrypt(ctx, otpField(result.UserID, result.ID, "account"), result.Account)
+	if err != nil {
+		return nil, err
+	}
+	result.Secret, err = s.c.Decrypt(ctx, otpField(result.UserID, result.ID, "secret"), result.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// encrypt secures the sensitive fields of an authenticator secret before storage.
+func (s *OTPService) encrypt(ctx context.Context, cond models.OTP) (models.OTP, error) {
+	var err error
+
+	cond.Issuer, err = s.c.Encrypt(ctx, otpField(cond.UserID, cond.ID, "issuer"), cond.Issuer)
+	if err != nil {
+		return models.OTP{}, err
+	}
+	cond.Account, err = s.c.Encrypt(ctx, otpField(cond.UserID, cond.ID, "account"), cond.Account)
+	if err != nil {
+		return models.OTP{}, err
+	}
+	cond.Secret, err = s.c.Encrypt(ctx, otpField(cond.UserID, cond.ID, "secret"), cond.Secret)
+	if err != nil {
+		return models.OTP{}, err
+	}
+
+	return cond, nil
+}
+
+// otpField locates an encrypted field of an authenticator secret, binding its ciphertext to the record.
+func otpField(userID, id int64, field string) models.CipherContext {
+	return models.CipherContext{
+		UserID:   userID,
+		Table:    models.TableOTP,
+		Field:    field,
+		RecordID: id,
+	}
+}
@@ -3,49 +3,113 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"main/internal/audit"
+	"main/internal/customfield"
+	"main/internal/passgen"
 	"main/internal/server/interfaces"
 	"main/internal/server/models"
+	"time"
 )
 
 // Error definitions for common scenarios in password service operations.
 var (
-	ErrPasswordAlreadyExists = errors.New("password already exists") // Thrown when attempting to add a duplicate password.
-	ErrPasswordNotFound      = errors.New("password not found")      // Raised when get a non-existent password.
+	ErrPasswordAlreadyExists = errors.New("password already exists")                                 // Thrown when attempting to add a duplicate password.
+	ErrPasswordNotFound      = errors.New("password not found")                                      // Raised when get a non-existent password.
+	ErrInvalidField          = errors.New("invalid custom field")                                    // Raised when custom fields are malformed; wraps the reason.
+	ErrAuditClientSide       = errors.New("passwords of vault accounts are audited by the client")   // Raised when the server cannot read the passwords of a vault account.
+	ErrAuditServerSide       = errors.New("passwords of regular accounts are audited by the server") // Raised when fetching the passwords of a regular account for a client-side audit.
+	ErrGenerateClientSide    = errors.New("passwords of vault accounts are generated by the client") // Raised when asked to store a generated password the server cannot encrypt for a vault account.
 )
 
 // PasswordsService manages the lifecycle of password entities, incorporating encryption for sensitive fields.
+// Custom fields are encrypted one by one; their names and values are validated unless the client encrypted them.
+// Passwords are checked against the breach corpus, if one is configured, whenever they are written or audited.
 type PasswordsService struct {
 	r interfaces.PasswordsRepository // Dependency for interacting with the underlying password repository.
+	u interfaces.UsersRepository     // Repository telling vault accounts apart.
 	c interfaces.CryptoService       // Encryption service for protecting sensitive password data.
+	b interfaces.BreachCorpus        // Corpus of breached passwords; nil disables breach checks.
+	p placer                         // Places password entries in folders and tags them.
+	k binder                         // Keeps password entries under the IDs their fields are bound to.
 }
 
 // NewPasswordsService creates a new instance of PasswordsService with injected dependencies.
-func NewPasswordsService(r interfaces.PasswordsRepository, c interfaces.CryptoService) *PasswordsService {
+// The breach corpus is optional.
+func NewPasswordsService(r interfaces.PasswordsRepository, u interfaces.UsersRepository, f interfaces.FoldersRepository,
+	rr interfaces.RecordsRepository, c interfaces.CryptoService, b interfaces.BreachCorpus) *PasswordsService {
 	return &PasswordsService{
 		r: r,
+		u: u,
 		c: c,
+		b: b,
+		p: newPlacer(f, models.TablePasswords),
+		k: newBinder(rr, u, models.TablePasswords),
 	}
 }
 
 // Get retrieves a password by title and user ID, decrypting its sensitive fields.
+// The read is counted, while the returned statistics are those from before it.
 func (s *PasswordsService) Get(ctx context.Context, title string, UserID int64) (*models.Password, error) {
 	result, err := s.r.Get(ctx, title, UserID)
 	if err != nil {
 		return nil, err
 	}
 
-	result, err = s.decrypt(result)
+	result, err = s.decrypt(ctx, result)
 	if err != nil {
 		return nil, err
 	}
+
+	result.Placement, err = s.p.load(ctx, result.ID, UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.r.Touch(ctx, result.ID); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
-// Add saves a new password entry, first encrypting its sensitive fields.
+// Add saves a new password entry in its folder with its tags, first validating its custom fields,
+// generating its password if asked to, and encrypting its sensitive fields. An entry given an ID is stored under it,
+// once the reservation of the ID is consumed.
 func (s *PasswordsService) Add(ctx context.Context, cond models.Password) (string, error) {
+	if err := s.validateFields(ctx, cond); err != nil {
+		return "", err
+	}
+	if err := s.generate(ctx, &cond); err != nil {
+		return "", err
+	}
+	return s.p.write(ctx, cond.UserID, cond.Placement, func(placement models.Placement) (string, error) {
+		cond.Placement = placement
+		if err := s.k.claim(ctx, cond.ID, cond.UserID); err != nil {
+			return "", err
+		}
+		return s.add(ctx, cond)
+	})
+}
+
+// add stores a new password entry under its ID or a freshly reserved one, and its custom fields under freshly
+// reserved IDs, so the ciphertexts can be bound to them.
+// The entry is flagged if its password is found in the breach corpus.
+func (s *PasswordsService) add(ctx context.Context, cond models.Password) (string, error) {
 	var err error
 
-	cond, err = s.encrypt(cond)
+	if cond.ID == 0 {
+		cond.ID, err = s.r.NextID(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	cond.Compromised, err = s.breached(ctx, cond)
+	if err != nil {
+		return "", err
+	}
+
+	cond, err = s.encrypt(ctx, cond)
 	if err != nil {
 		return "", err
 	}
@@ -59,10 +123,43 @@ func (s *PasswordsService) Add(ctx context.Context, cond models.Password) (strin
 }
 
 // Update modifies an existing password record, re-encrypting its sensitive fields.
+// The custom fields are validated and replaced if ReplaceFields is set, and kept otherwise;
+// the new password is generated if asked to, and the entry is moved and retagged only as far
+// as its placement asks for it.
 func (s *PasswordsService) Update(ctx context.Context, cond models.Password) (string, error) {
-	var err error
+	if !cond.ReplaceFields {
+		cond.Fields = nil
+	} else if err := s.validateFields(ctx, cond); err != nil {
+		return "", err
+	}
+	if err := s.generate(ctx, &cond); err != nil {
+		return "", err
+	}
+	return s.p.write(ctx, cond.UserID, cond.Placement, func(placement models.Placement) (string, error) {
+		cond.Placement = placement
+		return s.update(ctx, cond)
+	})
+}
+
+// update replaces a password entry; the record is resolved to its ID first, and the ciphertexts are bound to that ID.
+// An entry given an ID is only replaced if it is still the record of the title.
+// The breach flag is set again for the new password.
+func (s *PasswordsService) update(ctx context.Context, cond models.Password) (string, error) {
+	id, err := s.r.GetID(ctx, cond.Title, cond.UserID)
+	if err != nil {
+		return "", err
+	}
+	if err := sameRecord(cond.ID, id); err != nil {
+		return "", err
+	}
+	cond.ID = id
 
-	cond, err = s.encrypt(cond)
+	cond.Compromised, err = s.breached(ctx, cond)
+	if err != nil {
+		return "", err
+	}
+
+	cond, err = s.encrypt(ctx, cond)
 	if err != nil {
 		return "", err
 	}
@@ -75,7 +172,7 @@ func (s *PasswordsService) Update(ctx context.Context, cond models.Password) (st
 	return result, nil
 }
 
-// Delete permanently removes a password entry by title and user ID.
+// Delete moves a password entry to the trash by title and user ID; ErrPasswordNotFound is returned if there is none.
 func (s *PasswordsService) Delete(ctx context.Context, title string, UserID int64) error {
 	err := s.r.Delete(ctx, title, UserID)
 	if err != nil {
@@ -84,34 +181,268 @@ func (s *PasswordsService) Delete(ctx context.Context, title string, UserID int6
 	return nil
 }
 
+// Rename gives a password entry found by ID or title a new title once the title is checked;
+// its login, password and custom fields stay bound to the record ID, so nothing is re-encrypted.
+func (s *PasswordsService) Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error) {
+	if err := checkTitle(cond.NewTitle); err != nil {
+		return nil, err
+	}
+	return s.r.Rename(ctx, cond)
+}
+
+// List returns a page of the user's password entries; only non-sensitive fields are listed, so nothing is decrypted.
+func (s *PasswordsService) List(ctx context.Context, query models.ListQuery) (*models.ListPage, error) {
+	return s.p.paginate(ctx, query, s.r.List)
+}
+
+// History lists the archived revisions of a password entry, newest first.
+// Revisions are kept by title, so those of a deleted password entry are listed as well.
+func (s *PasswordsService) History(ctx context.Context, title string, UserID int64) ([]models.Revision, error) {
+	return s.r.History(ctx, title, UserID)
+}
+
+// Restore brings back an archived revision of a password entry (see restore).
+// The custom fields of the current version are replaced with those of the revision.
+func (s *PasswordsService) Restore(ctx context.Context, title string, UserID int64, revision int) (string, error) {
+	rev, err := s.r.GetRevision(ctx, title, UserID, revision)
+	if err != nil {
+		return "", err
+	}
+
+	rev, err = s.decrypt(ctx, rev)
+	if err != nil {
+		return "", err
+	}
+
+	rev.ReplaceFields = true
+	return restore(ctx, s.k, rev, &rev.ID, UserID, ErrPasswordNotFound, s.update, s.add)
+}
+
+// Audit decrypts the passwords of all live entries of a user and reports the weak, reused, stale and breached ones.
+// Only the passwords are decrypted, and reading them for an audit is not counted as an access. With a breach corpus,
+// the breach flags of the entries are brought up to date, as the corpus may have grown since they were written.
+// It fails with ErrAuditClientSide for vault accounts, whose passwords the server cannot decrypt.
+func (s *PasswordsService) Audit(ctx context.Context, UserID int64, policy audit.Policy) (*audit.Report, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	params, err := s.u.Vault(ctx, UserID)
+	if err != nil {
+		return nil, err
+	}
+	if params != nil {
+		return nil, ErrAuditClientSide
+	}
+
+	items, err := s.r.All(ctx, UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]audit.Entry, 0, len(items))
+	for _, item := range items {
+		password, err := s.c.Decrypt(ctx, passwordField(item.UserID, item.ID, "password"), item.Password)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := audit.Entry{
+			Title:     item.Title,
+			Password:  string(password),
+			UpdatedAt: item.Stats.UpdatedAt,
+		}
+		if s.b != nil {
+			entry.Breaches, err = s.b.Count(ctx, password)
+			if err != nil {
+				return nil, err
+			}
+			if compromised := entry.Breaches > 0; compromised != item.Compromised {
+				if err := s.r.Mark(ctx, item.ID, compromised); err != nil {
+					return nil, err
+				}
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	return audit.Run(entries, policy, time.Now())
+}
+
+// AuditEntries returns the sealed passwords of all live entries of a vault account in one go, so the client
+// can audit them without fetching each entry; unlike Get, this is not counted as reading the entries.
+// Logins and custom fields are left out. It fails with ErrAuditServerSide for regular accounts,
+// whose passwords Audit reports on without handing them out.
+func (s *PasswordsService) AuditEntries(ctx context.Context, UserID int64) ([]models.Password, error) {
+	params, err := s.u.Vault(ctx, UserID)
+	if err != nil {
+		return nil, err
+	}
+	if params == nil {
+		return nil, ErrAuditServerSide
+	}
+	return s.r.All(ctx, UserID)
+}
+
+// Generate returns a password following a policy without storing it.
+func (s *PasswordsService) Generate(ctx context.Context, policy passgen.Policy) (string, error) {
+	return passgen.Generate(policy)
+}
+
+// generate replaces the password of an entry with one generated following its Generate policy, if it has one.
+// It fails with ErrGenerateClientSide for vault accounts, as the server cannot encrypt the password for them.
+func (s *PasswordsService) generate(ctx context.Context, cond *models.Password) error {
+	if cond.Generate == nil {
+		return nil
+	}
+
+	params, err := s.u.Vault(ctx, cond.UserID)
+	if err != nil {
+		return err
+	}
+	if params != nil {
+		return ErrGenerateClientSide
+	}
+
+	password, err := passgen.Generate(*cond.Generate)
+	if err != nil {
+		return err
+	}
+	cond.Password = []byte(password)
+	return nil
+}
+
+// breached tells whether the password of an entry, still in clear, is in the breach corpus. Nothing is checked
+// without a corpus or for vault accounts, whose passwords arrive encrypted by the client.
+func (s *PasswordsService) breached(ctx context.Context, cond models.Password) (bool, error) {
+	if s.b == nil {
+		return false, nil
+	}
+
+	params, err := s.u.Vault(ctx, cond.UserID)
+	if err != nil {
+		return false, err
+	}
+	if params != nil {
+		return false, nil
+	}
+
+	count, err := s.b.Count(ctx, cond.Password)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
 // decrypt deobfuscates encrypted fields of a password entity.
-func (s *PasswordsService) decrypt(result *models.Password) (*models.Password, error) {
+func (s *PasswordsService) decrypt(ctx context.Context, result *models.Password) (*models.Password, error) {
 	var err error
 
-	result.Login, err = s.c.Decrypt(result.Login)
+	result.Login, err = s.c.Decrypt(ctx, passwordField(result.UserID, result.ID, "login"), result.Login)
 	if err != nil {
 		return nil, err
 	}
-	result.Password, err = s.c.Decrypt(result.Password)
+	result.Password, err = s.c.Decrypt(ctx, passwordField(result.UserID, result.ID, "password"), result.Password)
 	if err != nil {
 		return nil, err
 	}
+	for i := range result.Fields {
+		field := &result.Fields[i]
+		field.Name, err = s.c.Decrypt(ctx, customField(result.UserID, field.ID, "name"), field.Name)
+		if err != nil {
+			return nil, err
+		}
+		field.Value, err = s.c.Decrypt(ctx, customField(result.UserID, field.ID, "value"), field.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return result, nil
 }
 
 // encrypt secures the sensitive fields of a password entity before storage.
-func (s *PasswordsService) encrypt(cond models.Password) (models.Password, error) {
+// Custom fields get new IDs, so their ciphertexts are never bound to the fields they replace.
+func (s *PasswordsService) encrypt(ctx context.Context, cond models.Password) (models.Password, error) {
 	var err error
 
-	cond.Login, err = s.c.Encrypt(cond.Login)
+	if len(cond.Fields) > 0 {
+		ids, err := s.r.NextFieldIDs(ctx, len(cond.Fields))
+		if err != nil {
+			return models.Password{}, err
+		}
+
+		fields := make([]models.PasswordField, len(cond.Fields))
+		for i, field := range cond.Fields {
+			fields[i] = models.PasswordField{ID: ids[i], Type: field.Type}
+			fields[i].Name, err = s.c.Encrypt(ctx, customField(cond.UserID, ids[i], "name"), field.Name)
+			if err != nil {
+				return models.Password{}, err
+			}
+			fields[i].Value, err = s.c.Encrypt(ctx, customField(cond.UserID, ids[i], "value"), field.Value)
+			if err != nil {
+				return models.Password{}, err
+			}
+		}
+		cond.Fields = fields
+	}
+
+	cond.Login, err = s.c.Encrypt(ctx, passwordField(cond.UserID, cond.ID, "login"), cond.Login)
 	if err != nil {
 		return models.Password{}, err
 	}
-	cond.Password, err = s.c.Encrypt(cond.Password)
+	cond.Password, err = s.c.Encrypt(ctx, passwordField(cond.UserID, cond.ID, "password"), cond.Password)
 	if err != nil {
 		return models.Password{}, err
 	}
 
 	return cond, nil
 }
+
+// validateFields checks the custom fields of a password entry. The names and values of vault accounts
+// arrive encrypted, so only their number and types are checked.
+func (s *PasswordsService) validateFields(ctx context.Context, cond models.Password) error {
+	params, err := s.u.Vault(ctx, cond.UserID)
+	if err != nil {
+		return err
+	}
+
+	fields := make([]customfield.Field, len(cond.Fields))
+	for i, field := range cond.Fields {
+		if !customfield.ValidType(field.Type) {
+			return fmt.Errorf("%w: %w", ErrInvalidField, customfield.ErrUnknownType)
+		}
+		fields[i] = customfield.Field{Name: string(field.Name), Type: field.Type, Value: string(field.Value)}
+	}
+
+	if params != nil {
+		if len(fields) > customfield.MaxFields {
+			return fmt.Errorf("%w: %w", ErrInvalidField, customfield.ErrTooManyFields)
+		}
+		return nil
+	}
+	if err := customfield.ValidateAll(fields); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidField, err)
+	}
+	return nil
+}
+
+// passwordField locates an encrypted field of a password entry, binding its ciphertext to the record.
+func passwordField(userID, id int64, field string) models.CipherContext {
+	return models.CipherContext{
+		UserID:   userID,
+		Table:    models.TablePasswords,
+		Field:    field,
+		RecordID: id,
+	}
+}
+
+// customField locates an encrypted part of a custom field of a password entry, binding its ciphertext to the field.
+func customField(userID, id int64, field string) models.CipherContext {
+	return models.CipherContext{
+		UserID:   userID,
+		Table:    models.TablePasswordFields,
+		Field:    field,
+		RecordID: id,
+	}
+}
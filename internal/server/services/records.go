@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/server/interfaces"
+	"time"
+)
+
+// Error definitions for common scenarios in record ID operations.
+var (
+	ErrRecordNotFound     = errors.New("record not found")              // Raised when no live record of the kind has the title.
+	ErrInvalidReservation = errors.New("record ID is not reserved")     // Raised when creating a record under an ID not reserved for it.
+	ErrRecordReplaced     = errors.New("record was replaced meanwhile") // Raised when writing a record under the ID of a record it replaced.
+)
+
+// reservationTTL is the time a reserved record ID stays valid for creating the record.
+const reservationTTL = 24 * time.Hour
+
+// RecordsService hands out the IDs vault accounts bind the ciphertexts of their records to.
+// The ID of a live record is returned as is; an ID for a new record is reserved for the user
+// and consumed when the record is created under it.
+type RecordsService struct {
+	r interfaces.RecordsRepository // Repository resolving and reserving record IDs.
+}
+
+// NewRecordsService creates a new instance of RecordsService with injected dependencies.
+func NewRecordsService(r interfaces.RecordsRepository) *RecordsService {
+	return &RecordsService{
+		r: r,
+	}
+}
+
+// ID returns the ID of the user's live record of the given kind and title, or reserves an ID for a new one,
+// reporting whether it did. Reservations are valid for a day.
+func (s *RecordsService) ID(ctx context.Context, userID int64, kind, title string) (int64, bool, error) {
+	if err := checkTitle(title); err != nil {
+		return 0, false, err
+	}
+
+	id, err := s.r.ID(ctx, kind, title, userID)
+	if err == nil {
+		return id, false, nil
+	}
+	if !errors.Is(err, ErrRecordNotFound) {
+		return 0, false, err
+	}
+
+	now := time.Now()
+	id, err = s.r.Reserve(ctx, kind, userID, now.Add(-reservationTTL))
+	if err != nil {
+		return 0, false, err
+	}
+	return id, true, nil
+}
+
+// binder keeps the records of one kind under the IDs their ciphertexts are bound to
+// on behalf of the service of that kind.
+type binder struct {
+	r    interfaces.RecordsRepository // Repository consuming reserved record IDs.
+	u    interfaces.UsersRepository   // Repository telling vault accounts apart.
+	kind string                       // Kind of the records, the name of the table holding them.
+}
+
+// newBinder creates a binder for records of the given kind.
+func newBinder(r interfaces.RecordsRepository, u interfaces.UsersRepository, kind string) binder {
+	return binder{
+		r:    r,
+		u:    u,
+		kind: kind,
+	}
+}
+
+// claim consumes the reservation of the ID a new record of the user is created under.
+// A zero ID leaves the choice of the ID to the service.
+func (b binder) claim(ctx context.Context, id, userID int64) error {
+	if id == 0 {
+		return nil
+	}
+	return b.r.Claim(ctx, b.kind, id, userID, time.Now().Add(-reservationTTL))
+}
+
+// restored returns the ID a revision archived from the record with the given ID is restored under.
+// Revisions of vault accounts stay under their ID, which their ciphertexts are bound to;
+// those of regular accounts are re-encrypted for whatever record they end up in, so zero is returned.
+func (b binder) restored(ctx context.Context, id, userID int64) (int64, error) {
+	vault, err := b.u.Vault(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if vault == nil {
+		return 0, nil
+	}
+	return id, nil
+}
+
+// restore writes a decrypted revision back, replacing the current version of its record, which is archived in
+// turn, or adding the record again if update reports it deleted with notFound. Either way the revision is
+// encrypted anew for the record it ends up in; a revision of a vault account keeps the ID of the record it was
+// archived from, so it can only be restored into that record (see binder.restored).
+func restore[T any](ctx context.Context, b binder, rev *T, id *int64, userID int64, notFound error,
+	update, add func(context.Context, T) (string, error)) (string, error) {
+	var err error
+
+	*id, err = b.restored(ctx, *id, userID)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := update(ctx, *rev)
+	if errors.Is(err, notFound) {
+		return add(ctx, *rev)
+	}
+	return result, err
+}
+
+// sameRecord checks that a record written under the given ID is the live record resolved by its title.
+// A zero ID is not checked.
+func sameRecord(id, live int64) error {
+	if id != 0 && id != live {
+		return ErrRecordReplaced
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxTitleLen is the longest title of a record in characters.
+const MaxTitleLen = 255
+
+// ErrInvalidTitle is raised when a record is renamed to an empty or overlong title.
+var ErrInvalidTitle = errors.New("invalid title")
+
+// checkTitle rejects titles that are blank or longer than MaxTitleLen.
+func checkTitle(title string) error {
+	if strings.TrimSpace(title) == "" || utf8.RuneCountInString(title) > MaxTitleLen {
+		return ErrInvalidTitle
+	}
+	return nil
+}
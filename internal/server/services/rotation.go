@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/internal/server/crypto"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+)
+
+// Error definitions for common scenarios in key rotation operations.
+var (
+	ErrRotationInProgress = errors.New("key rotation already in progress") // Thrown when starting a job while another one is unfinished.
+	ErrNoPendingRotation  = errors.New("no pending key rotation")          // Raised when there is no job this server can advance.
+)
+
+// RotationHistorySize is the number of most recent rotation jobs reported by Status.
+const RotationHistorySize = 10
+
+// RotationService moves stored ciphertexts to the active master key in the background.
+// Data-encryption keys are re-wrapped with the active master key, and records that are still
+// encrypted in an outdated format (for example directly with a master key, before per-user keys
+// existed, or without being bound to their location) are re-encrypted with their owner's data key. The server keeps serving meanwhile:
+// every ciphertext stays readable with either the old or the new key until the job finishes.
+type RotationService struct {
+	r      interfaces.KeyRotationsRepository // Repository persisting jobs and walking encrypted tables.
+	master interfaces.Keyring                // Master keyring holding the target key.
+	c      interfaces.CryptoService          // Service re-encrypting records with per-user keys.
+	batch  int                               // Number of rows processed per transaction.
+}
+
+// NewRotationService creates a new instance of RotationService with injected dependencies.
+func NewRotationService(r interfaces.KeyRotationsRepository, master interfaces.Keyring, c interfaces.CryptoService, batch int) *RotationService {
+	return &RotationService{
+		r:      r,
+		master: master,
+		c:      c,
+		batch:  batch,
+	}
+}
+
+// Start requests a rotation job moving every ciphertext to the active master key.
+func (s *RotationService) Start(ctx context.Context) (*models.KeyRotation, error) {
+	return s.r.Start(ctx, s.master.ActiveKeyID())
+}
+
+// Status returns the most recent rotation jobs, newest first.
+func (s *RotationService) Status(ctx context.Context) ([]models.KeyRotation, error) {
+	return s.r.List(ctx, RotationHistorySize)
+}
+
+// Step advances the pending job targeting the active master key by one batch and returns its new state.
+// ErrNoPendingRotation is returned when there is nothing this server can do.
+func (s *RotationService) Step(ctx context.Context) (*models.KeyRotation, error) {
+	return s.r.Advance(ctx, s.master.ActiveKeyID(), s.batch, s.rewrite)
+}
+
+// rewrite brings the encrypted fields of a single row up to date and reports whether any of them changed.
+// NULL columns, such as the inline data of a streamed binary, are left alone.
+func (s *RotationService) rewrite(ctx context.Context, phase string, rec *models.EncryptedRecord) (bool, error) {
+	changed := false
+
+	for name, value := range rec.Fields {
+		if value == nil {
+			continue
+		}
+
+		var (
+			updated []byte
+			err     error
+		)
+		if phase == models.RotationPhaseUserKeys {
+			updated, err = s.rewrap(value)
+		} else {
+			updated, err = s.reencrypt(ctx, models.CipherContext{
+				UserID:   rec.UserID,
+				Table:    rec.Table,
+				Field:    name,
+				RecordID: rec.RecordID,
+			}, value)
+		}
+		if err != nil {
+			return false, err
+		}
+		if updated != nil {
+			rec.Fields[name] = updated
+			changed = true
+		}
+	}
+
+	return changed, nil
+}
+
+// rewrap re-encrypts a wrapped data key with the active master key.
+// It returns nil if the key is already wrapped with the active master key.
+func (s *RotationService) rewrap(wrapped []byte) ([]byte, error) {
+	if s.master.IsCurrent(wrapped) {
+		return nil, nil
+	}
+
+	raw, err := s.master.Decrypt(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDecryptionFailed, err)
+	}
+	return s.master.Encrypt(raw)
+}
+
+// reencrypt re-encrypts a record field with the owner's data key, binding it to its location.
+// It returns nil if the field is already encrypted with a data key in the current format.
+func (s *RotationService) reencrypt(ctx context.Context, cc models.CipherContext, value []byte) ([]byte, error) {
+	if id, ok := crypto.KeyID(value); ok && id == crypto.DataKeyID && crypto.IsBound(value) {
+		return nil, nil
+	}
+
+	plain, err := s.c.Decrypt(ctx, cc, value)
+	if err != nil {
+		return nil, err
+	}
+	return s.c.Encrypt(ctx, cc, plain)
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+	"time"
+)
+
+// Error definitions for common scenarios in session operations.
+var (
+	ErrSessionNotFound     = errors.New("session not found")          // Raised when a session does not exist or is no longer active.
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")      // Raised when a refresh token is unknown, expired or revoked.
+	ErrRefreshTokenReused  = errors.New("refresh token reused")       // Raised when a superseded refresh token is presented again.
+	ErrSessionRevoked      = errors.New("session revoked or expired") // Raised when an access token belongs to an inactive session.
+)
+
+// refreshTokenSize is the number of random bytes in a refresh token.
+const refreshTokenSize = 32
+
+// SessionsService issues short-lived access tokens backed by server-side sessions.
+// Each login opens a session holding the hash of a refresh token. The refresh token is rotated on
+// every use, and presenting a superseded one revokes the whole session, because it means the
+// token was stolen. Access tokens are accepted only while their session is active, so revoking
+// a session locks the device out immediately. Ending a session also drops the cached keys of its user.
+type SessionsService struct {
+	r   interfaces.SessionsRepository // Repository persisting sessions.
+	j   interfaces.JWTService         // Service signing and verifying access tokens.
+	k   interfaces.KeyCache           // Cache of unwrapped keys emptied when a session ends.
+	ttl time.Duration                 // Lifetime of a session, after which the user must log in again.
+}
+
+// NewSessionsService creates a new instance of SessionsService with injected dependencies.
+func NewSessionsService(r interfaces.SessionsRepository, j interfaces.JWTService, k interfaces.KeyCache, ttl time.Duration) *SessionsService {
+	return &SessionsService{
+		r:   r,
+		j:   j,
+		k:   k,
+		ttl: ttl,
+	}
+}
+
+// Start opens a session for the user described by session and issues its first token pair.
+func (s *SessionsService) Start(ctx context.Context, session models.Session) (*models.Tokens, error) {
+	refresh, hash, err := newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	session.ExpiresAt = time.Now().Add(s.ttl)
+	result, err := s.r.Add(ctx, session, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issue(result, refresh)
+}
+
+// Refresh exchanges a refresh token for a new token pair of the same session.
+// A superseded refresh token revokes the session and fails with ErrRefreshTokenReused.
+func (s *SessionsService) Refresh(ctx context.Context, refreshToken string) (*models.Tokens, error) {
+	oldHash := hashRefreshToken(refreshToken)
+
+	refresh, newHash, err := newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.r.Rotate(ctx, oldHash, newHash)
+	if errors.Is(err, ErrSessionNotFound) {
+		reused, err := s.r.RevokeReused(ctx, oldHash)
+		if err != nil {
+			return nil, err
+		}
+		if reused {
+			return nil, ErrRefreshTokenReused
+		}
+		return nil, ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issue(session, refresh)
+}
+
+// Authenticate verifies an access token and ensures its session is still active.
+func (s *SessionsService) Authenticate(ctx context.Context, accessToken string) (int64, int64, error) {
+	userID, sessionID, err := s.j.Verify(accessToken)
+	if err != nil {
+		return -1, -1, err
+	}
+
+	active, err := s.r.IsActive(ctx, sessionID, userID)
+	if err != nil {
+		return -1, -1, err
+	}
+	if !active {
+		return -1, -1, ErrSessionRevoked
+	}
+	return userID, sessionID, nil
+}
+
+// List returns the active sessions of the user, newest first.
+func (s *SessionsService) List(ctx context.Context, userID int64) ([]models.Session, error) {
+	return s.r.List(ctx, userID)
+}
+
+// Revoke revokes a session of the user; its tokens stop working immediately.
+// The cached keys of the user are dropped as well.
+func (s *SessionsService) Revoke(ctx context.Context, userID, sessionID int64) error {
+	if err := s.r.Revoke(ctx, sessionID, userID); err != nil {
+		return err
+	}
+	s.k.Forget(userID)
+	return nil
+}
+
+// issue signs an access token for the session and pairs it with the refresh token.
+func (s *SessionsService) issue(session *models.Session, refresh string) (*models.Tokens, error) {
+	access, expiresAt, err := s.j.Generate(session.UserID, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Tokens{
+		SessionID:       session.ID,
+		Access:          access,
+		AccessExpiresAt: expiresAt,
+		Refresh:         refresh,
+	}, nil
+}
+
+// newRefreshToken generates a random refresh token together with the hash to store.
+func newRefreshToken() (string, []byte, error) {
+	raw := make([]byte, refreshTokenSize)
+	if _, err := io.ReadFull(rand.Reader, raw); err != nil {
+		return "", nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	return token, hashRefreshToken(token), nil
+}
+
+// hashRefreshToken returns the stored form of a refresh token.
+// Refresh tokens are long random strings, so a fast hash is sufficient.
+func hashRefreshToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
@@ -0,0 +1,242 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+	"main/internal/sshkey"
+)
+
+// Error definitions for common scenarios in SSH key operations.
+var (
+	ErrSSHKeyAlreadyExists = errors.New("SSH key already exists") // Thrown when attempting to add a duplicate key.
+	ErrSSHKeyNotFound      = errors.New("SSH key not found")      // Raised when get a non-existent key.
+	ErrInvalidSSHKey       = errors.New("invalid SSH key pair")   // Raised when a key does not parse or the pair does not belong together.
+)
+
+// SSHKeysService manages SSH key pairs.
+// The public key is normalized and fingerprinted here and stored in cleartext, so keys can be listed
+// without decryption; the private key is encrypted. The private key of a vault account arrives encrypted
+// by the client, so only its public key can be checked.
+type SSHKeysService struct {
+	r interfaces.SSHKeysRepository // Repository dependency for interacting with the persistent store.
+	u interfaces.UsersRepository   // Repository telling vault accounts apart.
+	c interfaces.CryptoService     // Encryption service dependency for securing private keys.
+	p placer                       // Places keys in folders and tags them.
+	k binder                       // Keeps keys under the IDs their private keys are bound to.
+}
+
+// NewSSHKeysService creates a new instance of SSHKeysService with injected dependencies.
+func NewSSHKeysService(r interfaces.SSHKeysRepository, u interfaces.UsersRepository, f interfaces.FoldersRepository,
+	rr interfaces.RecordsRepository, c interfaces.CryptoService) *SSHKeysService {
+	return &SSHKeysService{
+		r: r,
+		u: u,
+		c: c,
+		p: newPlacer(f, models.TableSSHKeys),
+		k: newBinder(rr, u, models.TableSSHKeys),
+	}
+}
+
+// Get retrieves an SSH key by title and user ID, decrypting its private key.
+// The read is counted, while the returned statistics are those from before it.
+func (s *SSHKeysService) Get(ctx context.Context, title string, UserID int64) (*models.SSHKey, error) {
+	result, err := s.r.Get(ctx, title, UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err = s.decrypt(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Placement, err = s.p.load(ctx, result.ID, UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.r.Touch(ctx, result.ID); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Add persists a new SSH key pair in its folder with its tags after validating it;
+// the fingerprint is computed from the public key. A key given an ID is stored under it, once the reservation
+// of the ID is consumed.
+func (s *SSHKeysService) Add(ctx context.Context, cond models.SSHKey) (string, error) {
+	var err error
+
+	cond, err = s.prepare(ctx, cond)
+	if err != nil {
+		return "", err
+	}
+	return s.p.write(ctx, cond.UserID, cond.Placement, func(placement models.Placement) (string, error) {
+		cond.Placement = placement
+		if err := s.k.claim(ctx, cond.ID, cond.UserID); err != nil {
+			return "", err
+		}
+		return s.add(ctx, cond)
+	})
+}
+
+// Update replaces the key pair of an existing SSH key after validating it.
+// The key is moved and retagged only as far as its placement asks for it.
+func (s *SSHKeysService) Update(ctx context.Context, cond models.SSHKey) (string, error) {
+	var err error
+
+	cond, err = s.prepare(ctx, cond)
+	if err != nil {
+		return "", err
+	}
+	return s.p.write(ctx, cond.UserID, cond.Placement, func(placement models.Placement) (string, error) {
+		cond.Placement = placement
+		return s.update(ctx, cond)
+	})
+}
+
+// Delete moves an SSH key to the trash by title and user ID without needing decryption;
+// ErrSSHKeyNotFound is returned if there is none.
+func (s *SSHKeysService) Delete(ctx context.Context, title string, UserID int64) error {
+	err := s.r.Delete(ctx, title, UserID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Rename gives an SSH key found by ID or title a new title once the title is checked;
+// its private key stays bound to the record ID, so nothing is re-encrypted.
+func (s *SSHKeysService) Rename(ctx context.Context, cond models.Rename) (*models.ListItem, error) {
+	if err := checkTitle(cond.NewTitle); err != nil {
+		return nil, err
+	}
+	return s.r.Rename(ctx, cond)
+}
+
+// List returns a page of the user's SSH keys; only titles are listed, so nothing is decrypted.
+func (s *SSHKeysService) List(ctx context.Context, query models.ListQuery) (*models.ListPage, error) {
+	return s.p.paginate(ctx, query, s.r.List)
+}
+
+// PublicKeys lists the public keys and fingerprints of all the user's SSH keys; nothing is decrypted.
+func (s *SSHKeysService) PublicKeys(ctx context.Context, UserID int64) ([]models.SSHPublicKey, error) {
+	return s.r.PublicKeys(ctx, UserID)
+}
+
+// History lists the archived revisions of an SSH key, newest first.
+// Revisions are kept by title, so those of a deleted key are listed as well.
+func (s *SSHKeysService) History(ctx context.Context, title string, UserID int64) ([]models.Revision, error) {
+	return s.r.History(ctx, title, UserID)
+}
+
+// Restore brings back an archived revision of an SSH key (see restore).
+func (s *SSHKeysService) Restore(ctx context.Context, title string, UserID int64, revision int) (string, error) {
+	rev, err := s.r.GetRevision(ctx, title, UserID, revision)
+	if err != nil {
+		return "", err
+	}
+
+	rev, err = s.decrypt(ctx, rev)
+	if err != nil {
+		return "", err
+	}
+
+	return restore(ctx, s.k, rev, &rev.ID, UserID, ErrSSHKeyNotFound, s.update, s.add)
+}
+
+// prepare validates an SSH key pair received from a client, normalizing the public key and computing its fingerprint.
+func (s *SSHKeysService) prepare(ctx context.Context, cond models.SSHKey) (models.SSHKey, error) {
+	pub, comment, err := sshkey.ParsePublicKey(cond.PublicKey)
+	if err != nil {
+		return models.SSHKey{}, ErrInvalidSSHKey
+	}
+	cond.PublicKey = sshkey.AuthorizedKey(pub, comment)
+	cond.Fingerprint = sshkey.Fingerprint(pub)
+
+	params, err := s.u.Vault(ctx, cond.UserID)
+	if err != nil {
+		return models.SSHKey{}, err
+	}
+	if params == nil {
+		if err := sshkey.Validate(cond.PrivateKey, pub); err != nil {
+			return models.SSHKey{}, ErrInvalidSSHKey
+		}
+	}
+	return cond, nil
+}
+
+// add stores a new SSH key under its ID or a freshly reserved one, so the ciphertext can be bound to it.
+func (s *SSHKeysService) add(ctx context.Context, cond models.SSHKey) (string, error) {
+	var err error
+
+	if cond.ID == 0 {
+		cond.ID, err = s.r.NextID(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	cond, err = s.encrypt(ctx, cond)
+	if err != nil {
+		return "", err
+	}
+
+	return s.r.Add(ctx, cond)
+}
+
+// update replaces an SSH key pair; the record is resolved to its ID first, and the ciphertext is bound to that ID.
+// A key given an ID is only replaced if it is still the record of the title.
+func (s *SSHKeysService) update(ctx context.Context, cond models.SSHKey) (string, error) {
+	id, err := s.r.GetID(ctx, cond.Title, cond.UserID)
+	if err != nil {
+		return "", err
+	}
+	if err := sameRecord(cond.ID, id); err != nil {
+		return "", err
+	}
+	cond.ID = id
+
+	cond, err = s.encrypt(ctx, cond)
+	if err != nil {
+		return "", err
+	}
+
+	return s.r.Update(ctx, cond)
+}
+
+// decrypt deobfuscates the private key of an SSH key.
+func (s *SSHKeysService) decrypt(ctx context.Context, result *models.SSHKey) (*models.SSHKey, error) {
+	var err error
+
+	result.PrivateKey, err = s.c.Decrypt(ctx, sshKeyField(result.UserID, result.ID, "private_key"), result.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// encrypt secures the private key of an SSH key before storage.
+func (s *SSHKeysService) encrypt(ctx context.Context, cond models.SSHKey) (models.SSHKey, error) {
+	var err error
+
+	cond.PrivateKey, err = s.c.Encrypt(ctx, sshKeyField(cond.UserID, cond.ID, "private_key"), cond.PrivateKey)
+	if err != nil {
+		return models.SSHKey{}, err
+	}
+
+	return cond, nil
+}
+
+// sshKeyField locates an encrypted field of an SSH key, binding its ciphertext to the record.
+func sshKeyField(userID, id int64, field string) models.CipherContext {
+	return models.CipherContext{
+		UserID:   userID,
+		Table:    models.TableSSHKeys,
+		Field:    field,
+		RecordID: id,
+	}
+}
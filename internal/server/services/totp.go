@@ -0,0 +1,251 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"io"
+	"main/internal/server/crypto"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+	"strings"
+	"time"
+)
+
+// Error definitions for common scenarios in second factor operations.
+var (
+	ErrTOTPAlreadyEnabled  = errors.New("two-factor authentication already enabled") // Raised when enrolling a user whose secret is already confirmed.
+	ErrTOTPNotEnabled      = errors.New("two-factor authentication not enabled")     // Raised when the user has no (confirmed) secret.
+	ErrInvalidSecondFactor = errors.New("invalid second factor code")                // Raised when a TOTP or recovery code is wrong or already used.
+	ErrInvalidChallenge    = errors.New("invalid login challenge")                   // Raised when a login challenge is unknown, expired or out of attempts.
+)
+
+// Second factor settings.
+const (
+	TOTPIssuer           = "GophKeeper"    // Issuer shown by authenticator apps.
+	RecoveryCodeCount    = 10              // Number of recovery codes issued on confirmation.
+	recoveryCodeSize     = 10              // Number of base32 characters in a recovery code.
+	challengeTokenSize   = 32              // Number of random bytes in a login challenge.
+	challengeTTL         = 5 * time.Minute // Time a user has to complete a login with the second factor.
+	maxChallengeAttempts = 5               // Number of codes that may be tried against one login challenge.
+)
+
+// recoveryEncoding renders recovery codes in lowercase base32, which is easy to read and type.
+var recoveryEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// SecondFactorService manages TOTP second factors (RFC 6238) and the logins awaiting them.
+// Secrets are encrypted with the server-side CryptoService even for vault accounts, because the
+// server has to compute the codes. A secret is only enforced once the user confirms it with a code;
+// confirmation also issues one-time recovery codes, which are stored as hashes. A password login of
+// such a user yields a short-lived challenge that is exchanged for a session by a valid code.
+type SecondFactorService struct {
+	r interfaces.TOTPRepository // Repository persisting secrets, recovery codes and challenges.
+	c interfaces.CryptoService  // Service encrypting secrets at rest.
+}
+
+// NewSecondFactorService creates a new instance of SecondFactorService with injected dependencies.
+func NewSecondFactorService(r interfaces.TOTPRepository, c interfaces.CryptoService) *SecondFactorService {
+	return &SecondFactorService{
+		r: r,
+		c: c,
+	}
+}
+
+// Enroll generates a new secret for the user, replacing any unconfirmed one.
+// The secret is not enforced until it is confirmed.
+func (s *SecondFactorService) Enroll(ctx context.Context, userID int64) (*models.TOTPEnrollment, error) {
+	secret, err := crypto.NewTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := s.c.Encrypt(ctx, totpField(userID), secret)
+	if err != nil {
+		return nil, err
+	}
+
+	login, err := s.r.Save(ctx, userID, encrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TOTPEnrollment{
+		Secret: crypto.EncodeTOTPSecret(secret),
+		URI:    crypto.TOTPURI(TOTPIssuer, login, secret),
+	}, nil
+}
+
+// Confirm enables the enrolled secret after checking a code produced from it,
+// returning the recovery codes. They are shown once and cannot be retrieved later.
+func (s *SecondFactorService) Confirm(ctx context.Context, userID int64, code string) ([]string, error) {
+	t, err := s.r.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if t.Confirmed {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+
+	secret, err := s.c.Decrypt(ctx, totpField(userID), t.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	step, ok := crypto.VerifyTOTP(secret, code, time.Now(), t.LastStep)
+	if !ok {
+		return nil, ErrInvalidSecondFactor
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.r.Confirm(ctx, userID, step, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// Disable removes the second factor of the user; a valid TOTP or recovery code is required.
+func (s *SecondFactorService) Disable(ctx context.Context, userID int64, code string) error {
+	if err := s.check(ctx, userID, code); err != nil {
+		return err
+	}
+	return s.r.Delete(ctx, userID)
+}
+
+// Challenge opens a login challenge for a user with a confirmed second factor.
+// It returns an empty string for users who log in with the password alone.
+func (s *SecondFactorService) Challenge(ctx context.Context, userID int64) (string, error) {
+	t, err := s.r.Get(ctx, userID)
+	if errors.Is(err, ErrTOTPNotEnabled) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if !t.Confirmed {
+		return "", nil
+	}
+
+	raw := make([]byte, challengeTokenSize)
+	if _, err := io.ReadFull(rand.Reader, raw); err != nil {
+		return "", err
+	}
+	challenge := base64.RawURLEncoding.EncodeToString(raw)
+
+	err = s.r.AddChallenge(ctx, userID, hashSecret(challenge), time.Now().Add(challengeTTL))
+	if err != nil {
+		return "", err
+	}
+	return challenge, nil
+}
+
+// Verify completes a login challenge with a TOTP or recovery code and returns the user ID.
+// Each challenge accepts a limited number of attempts and is consumed on success.
+func (s *SecondFactorService) Verify(ctx context.Context, challenge, code string) (int64, error) {
+	hash := hashSecret(challenge)
+
+	userID, err := s.r.TakeChallenge(ctx, hash, maxChallengeAttempts)
+	if err != nil {
+		return -1, err
+	}
+
+	if err := s.check(ctx, userID, code); err != nil {
+		return -1, err
+	}
+
+	if err := s.r.DeleteChallenge(ctx, hash); err != nil {
+		return -1, err
+	}
+	return userID, nil
+}
+
+// Require checks a TOTP or recovery code of a user with a confirmed second factor,
+// guarding actions that grant access like a login does. Users without one pass without a code.
+func (s *SecondFactorService) Require(ctx context.Context, userID int64, code string) error {
+	err := s.check(ctx, userID, code)
+	if errors.Is(err, ErrTOTPNotEnabled) {
+		return nil
+	}
+	return err
+}
+
+// check accepts a current TOTP code, at most once per time step, or an unused recovery code.
+func (s *SecondFactorService) check(ctx context.Context, userID int64, code string) error {
+	t, err := s.r.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !t.Confirmed {
+		return ErrTOTPNotEnabled
+	}
+
+	secret, err := s.c.Decrypt(ctx, totpField(userID), t.Secret)
+	if err != nil {
+		return err
+	}
+
+	if step, ok := crypto.VerifyTOTP(secret, code, time.Now(), t.LastStep); ok {
+		used, err := s.r.UseStep(ctx, userID, step)
+		if err != nil {
+			return err
+		}
+		if used {
+			return nil
+		}
+		return ErrInvalidSecondFactor
+	}
+
+	used, err := s.r.UseRecoveryCode(ctx, userID, hashSecret(normalizeRecoveryCode(code)))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrInvalidSecondFactor
+	}
+	return nil
+}
+
+// totpField returns the encryption context of the TOTP secret of a user.
+func totpField(userID int64) models.CipherContext {
+	return models.CipherContext{
+		UserID:   userID,
+		Table:    models.TableUserTOTP,
+		Field:    "secret",
+		RecordID: userID,
+	}
+}
+
+// newRecoveryCodes generates the recovery codes shown to the user together with the hashes to store.
+func newRecoveryCodes() ([]string, [][]byte, error) {
+	codes := make([]string, RecoveryCodeCount)
+	hashes := make([][]byte, RecoveryCodeCount)
+
+	raw := make([]byte, recoveryCodeSize*5/8)
+	for i := range codes {
+		if _, err := io.ReadFull(rand.Reader, raw); err != nil {
+			return nil, nil, err
+		}
+		code := recoveryEncoding.EncodeToString(raw)
+		codes[i] = code[:recoveryCodeSize/2] + "-" + code[recoveryCodeSize/2:]
+		hashes[i] = hashSecret(code)
+	}
+	return codes, hashes, nil
+}
+
+// normalizeRecoveryCode strips the separators and case a user may type a recovery code with.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+// hashSecret returns the stored form of a high-entropy secret such as a challenge or recovery code.
+func hashSecret(secret string) []byte {
+	sum := sha256.Sum256([]byte(secret))
+	return sum[:]
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+)
+
+func TestNewRecoveryCodes(t *testing.T) {
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != RecoveryCodeCount || len(hashes) != RecoveryCodeCount {
+		t.Fatalf("got %d codes and %d hashes, want %d of each", len(codes), len(hashes), RecoveryCodeCount)
+	}
+
+	format := regexp.MustCompile(`^[a-z2-7]{5}-[a-z2-7]{5}$`)
+	seen := make(map[string]bool, len(codes))
+	for i, code := range codes {
+		if !format.MatchString(code) {
+			t.Errorf("code %q does not match %s", code, format)
+		}
+		if seen[code] {
+			t.Errorf("code %q issued twice", code)
+		}
+		seen[code] = true
+
+		if !bytes.Equal(hashSecret(normalizeRecoveryCode(code)), hashes[i]) {
+			t.Errorf("hash of code %q does not match the stored one", code)
+		}
+	}
+}
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want string
+	}{
+		{"as shown", "abcde-fgh23", "abcdefgh23"},
+		{"without separator", "abcdefgh23", "abcdefgh23"},
+		{"upper case", "ABCDE-FGH23", "abcdefgh23"},
+		{"spaces", " abcde fgh23 ", "abcdefgh23"},
+		{"several separators", "ab-cd-e fg-h23", "abcdefgh23"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeRecoveryCode(tt.code); got != tt.want {
+				t.Errorf("normalizeRecoveryCode(%q) = %q, want %q", tt.code, got, tt.want)
+			}
+		})
+	}
+}
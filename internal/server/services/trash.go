@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+	"time"
+)
+
+// Error definitions for common scenarios in trash operations.
+var (
+	ErrTrashItemNotFound = errors.New("trash item not found")            // Raised when restoring or purging a record that is not in the trash.
+	ErrTrashTitleTaken   = errors.New("title is taken by a live record") // Raised when restoring a record whose title was reused meanwhile.
+	ErrUnknownKind       = errors.New("unknown record kind")             // Raised when naming a record kind that cannot be trashed.
+)
+
+// trashPurgeBatch is the number of expired records fetched per kind at a time by PurgeExpired.
+const trashPurgeBatch = 100
+
+// TrashService manages records deleted by the other services. Deleted records are hidden from Get and List
+// and wait in the trash until they are restored, purged by the user, or purged once the retention expires.
+// Purged records are archived as deleted revisions, so they stay restorable within the history retention.
+type TrashService struct {
+	r         interfaces.TrashRepository // Repository holding the trashed records.
+	retention time.Duration              // Time a record is kept in the trash before it is purged.
+}
+
+// NewTrashService creates a new instance of TrashService with injected dependencies.
+func NewTrashService(r interfaces.TrashRepository, retention time.Duration) *TrashService {
+	return &TrashService{
+		r:         r,
+		retention: retention,
+	}
+}
+
+// List returns the trashed records of the user, of the given kind or of every kind if it is empty,
+// together with the time each of them is going to be purged.
+func (s *TrashService) List(ctx context.Context, userID int64, kind string) ([]models.TrashItem, error) {
+	result, err := s.r.List(ctx, userID, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range result {
+		result[i].PurgeAt = result[i].DeletedAt.Add(s.retention)
+	}
+	return result, nil
+}
+
+// Restore moves a record of the given kind and title out of the trash.
+// It fails with ErrTrashTitleTaken if a live record of the same kind has been given the title meanwhile.
+func (s *TrashService) Restore(ctx context.Context, userID int64, kind, title string) (string, error) {
+	return s.r.Restore(ctx, userID, kind, title)
+}
+
+// Purge permanently removes the trashed record of the given kind and title and returns the number of removed
+// records. An empty title empties the trash, of the given kind or of every kind if that is empty as well.
+func (s *TrashService) Purge(ctx context.Context, userID int64, kind, title string) (int, error) {
+	if title != "" {
+		if err := s.r.Purge(ctx, userID, kind, title); err != nil {
+			return 0, err
+		}
+		return 1, nil
+	}
+
+	items, err := s.r.List(ctx, userID, kind)
+	if err != nil {
+		return 0, err
+	}
+	return s.purge(ctx, items)
+}
+
+// PurgeExpired permanently removes the records of all users kept in the trash longer than the retention
+// and returns their number.
+func (s *TrashService) PurgeExpired(ctx context.Context) (int, error) {
+	var purged int
+
+	for {
+		items, err := s.r.Expired(ctx, time.Now().Add(-s.retention), trashPurgeBatch)
+		if err != nil || len(items) == 0 {
+			return purged, err
+		}
+
+		n, err := s.purge(ctx, items)
+		purged += n
+		if err != nil {
+			return purged, err
+		}
+	}
+}
+
+// purge permanently removes the given records and returns the number of removed ones.
+// Records restored or purged concurrently are skipped.
+func (s *TrashService) purge(ctx context.Context, items []models.TrashItem) (int, error) {
+	var purged int
+
+	for _, item := range items {
+		err := s.r.Purge(ctx, item.UserID, item.Kind, item.Title)
+		if errors.Is(err, ErrTrashItemNotFound) {
+			continue
+		}
+		if err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
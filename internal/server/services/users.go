@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
 	"main/internal/server/interfaces"
 	"main/internal/server/models"
 	"time"
@@ -13,8 +14,23 @@ var (
 	ErrLoginAlreadyExists = errors.New("login already exists")           // Thrown when attempting to register a duplicate login.
 	ErrInvalidCredentials = errors.New("login or password is not valid") // Raised when invalid credentials are presented during login.
 	ErrUserNotFound       = errors.New("user not found")                 // Raised when attempting to authenticate a non-existent user.
+	ErrInvalidVaultParams = errors.New("invalid vault parameters")       // Raised when vault key derivation parameters are out of range.
+	ErrInvalidRetention   = errors.New("invalid history retention")      // Raised when the number of kept revisions is out of range.
 )
 
+// Accepted ranges of the vault key derivation parameters chosen by clients.
+const (
+	MinVaultSaltSize  = 16          // Minimal salt length in bytes.
+	MaxVaultTime      = 10          // Maximal number of Argon2id passes.
+	MinVaultMemory    = 19 * 1024   // Minimal Argon2id memory size in KiB.
+	MaxVaultMemory    = 1024 * 1024 // Maximal Argon2id memory size in KiB.
+	MaxVaultThreads   = 16          // Maximal Argon2id parallelism.
+	MaxVaultCheckSize = 256         // Maximal length of the encrypted check value.
+)
+
+// MaxHistoryRetention is the largest number of revisions a user may keep per record.
+const MaxHistoryRetention = 100
+
 // UsersService encapsulates user-related business logic, handling registration and authentication processes.
 type UsersService struct {
 	r interfaces.UsersRepository   // Dependency for interacting with the user repository.
@@ -30,10 +46,17 @@ func NewUsersService(r interfaces.UsersRepository, c interfaces.PassCryptoServic
 }
 
 // Register performs user registration, hashing the provided password and persisting the user data.
+// A user registered with vault parameters encrypts the records on the client side.
 func (s *UsersService) Register(ctx context.Context, cond models.User) (int64, error) {
 	ctx, cancel := context.WithTimeout(ctx, 3*time.Second) // Set timeout for the operation.
 	defer cancel()
 
+	if cond.Vault != nil {
+		if err := validateVault(cond.Vault); err != nil {
+			return -1, err
+		}
+	}
+
 	hash, err := s.c.Hash(cond.Password)
 	if err != nil {
 		return -1, err
@@ -64,3 +87,34 @@ func (s *UsersService) Login(ctx context.Context, cond models.User) (int64, erro
 
 	return result.ID, nil
 }
+
+// Vault returns the vault parameters of the user, or nil if the server encrypts the user's records.
+func (s *UsersService) Vault(ctx context.Context, userID int64) (*models.VaultParams, error) {
+	return s.r.Vault(ctx, userID)
+}
+
+// SetHistoryRetention changes the number of archived revisions kept per record of the user.
+// Revisions beyond the new limit are dropped at once; zero turns the history off.
+func (s *UsersService) SetHistoryRetention(ctx context.Context, userID int64, keep int) error {
+	if keep < 0 || keep > MaxHistoryRetention {
+		return fmt.Errorf("%w: must be between 0 and %d", ErrInvalidRetention, MaxHistoryRetention)
+	}
+	return s.r.SetHistoryRetention(ctx, userID, keep)
+}
+
+// validateVault ensures client-chosen key derivation parameters are within the accepted ranges.
+func validateVault(v *models.VaultParams) error {
+	switch {
+	case len(v.Salt) < MinVaultSaltSize:
+		return fmt.Errorf("%w: salt must be at least %d bytes", ErrInvalidVaultParams, MinVaultSaltSize)
+	case v.Time < 1 || v.Time > MaxVaultTime:
+		return fmt.Errorf("%w: time must be between 1 and %d", ErrInvalidVaultParams, MaxVaultTime)
+	case v.Memory < MinVaultMemory || v.Memory > MaxVaultMemory:
+		return fmt.Errorf("%w: memory must be between %d and %d KiB", ErrInvalidVaultParams, MinVaultMemory, MaxVaultMemory)
+	case v.Threads < 1 || v.Threads > MaxVaultThreads:
+		return fmt.Errorf("%w: threads must be between 1 and %d", ErrInvalidVaultParams, MaxVaultThreads)
+	case len(v.Check) == 0 || len(v.Check) > MaxVaultCheckSize:
+		return fmt.Errorf("%w: check value must be between 1 and %d bytes", ErrInvalidVaultParams, MaxVaultCheckSize)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"main/internal/server/interfaces"
+	"main/internal/server/models"
+	"sync"
+)
+
+// VaultCryptoService wraps a CryptoService, skipping it for zero-knowledge vault accounts.
+// Records of vault accounts arrive encrypted by the client with a key the server never sees,
+// so they are stored and returned exactly as received.
+type VaultCryptoService struct {
+	c      interfaces.CryptoService   // Service encrypting the records of regular accounts.
+	r      interfaces.UsersRepository // Repository providing the per-user vault flag.
+	mu     sync.RWMutex               // Guards the cache of vault flags.
+	vaults map[int64]bool             // Vault flags indexed by user ID; the mode never changes after registration.
+}
+
+// NewVaultCryptoService creates a new instance of VaultCryptoService with injected dependencies.
+func NewVaultCryptoService(c interfaces.CryptoService, r interfaces.UsersRepository) *VaultCryptoService {
+	return &VaultCryptoService{
+		c:      c,
+		r:      r,
+		vaults: make(map[int64]bool),
+	}
+}
+
+// Encrypt encrypts data of a regular account and returns data of a vault account unchanged.
+func (s *VaultCryptoService) Encrypt(ctx context.Context, cc models.CipherContext, data []byte) ([]byte, error) {
+	vault, err := s.isVault(ctx, cc.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if vault {
+		return data, nil
+	}
+	return s.c.Encrypt(ctx, cc, data)
+}
+
+// Decrypt decrypts data of a regular account and returns data of a vault account unchanged.
+func (s *VaultCryptoService) Decrypt(ctx context.Context, cc models.CipherContext, data []byte) ([]byte, error) {
+	vault, err := s.isVault(ctx, cc.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if vault {
+		return data, nil
+	}
+	return s.c.Decrypt(ctx, cc, data)
+}
+
+// isVault reports whether the user encrypts records on the client side.
+func (s *VaultCryptoService) isVault(ctx context.Context, userID int64) (bool, error) {
+	s.mu.RLock()
+	vault, ok := s.vaults[userID]
+	s.mu.RUnlock()
+	if ok {
+		return vault, nil
+	}
+
+	params, err := s.r.Vault(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	vault = params != nil
+
+	s.mu.Lock()
+	s.vaults[userID] = vault
+	s.mu.Unlock()
+
+	return vault, nil
+}
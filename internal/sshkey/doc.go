@@ -0,0 +1,8 @@
+// Package sshkey parses and validates the SSH key pairs users keep in their vault.
+// Private keys are accepted in the OpenSSH format or as PEM (PKCS#1, PKCS#8, SEC 1), optionally
+// protected by a passphrase, and are normalized to unencrypted OpenSSH keys before they are stored
+// encrypted. Public keys are kept in the authorized_keys format together with their SHA-256 fingerprint.
+//
+// The package is shared by the server, which checks that stored key pairs belong together, and the client,
+// which reads key files, decrypts passphrase-protected keys and serves the keys through its ssh-agent.
+package sshkey
@@ -0,0 +1,111 @@
+package sshkey
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"golang.org/x/crypto/ssh"
+	"strings"
+)
+
+// Errors returned for malformed or mismatching keys.
+var (
+	ErrInvalidPrivateKey  = errors.New("invalid SSH private key")                       // The private key cannot be parsed or its type is unsupported.
+	ErrInvalidPublicKey   = errors.New("invalid SSH public key")                        // The public key is not a single authorized_keys line.
+	ErrPassphraseRequired = errors.New("SSH private key is protected by a passphrase")  // The private key is encrypted and no passphrase was given.
+	ErrWrongPassphrase    = errors.New("wrong passphrase of SSH private key")           // The passphrase does not decrypt the private key.
+	ErrKeyMismatch        = errors.New("SSH public key does not match the private key") // The key pair does not belong together.
+)
+
+// Key is a parsed private key together with its public half.
+type Key struct {
+	PrivateKey crypto.PrivateKey // Parsed private key, as accepted by ssh-agent keyrings.
+	PublicKey  ssh.PublicKey     // Public half of the key.
+	Comment    string            // Free-form comment, usually user@host.
+}
+
+// ParsePrivateKey parses a private key file, decrypting it with the passphrase if it is protected.
+// ErrPassphraseRequired is returned for a protected key without a passphrase, so the caller can ask for one.
+func ParsePrivateKey(data []byte, passphrase []byte, comment string) (*Key, error) {
+	var (
+		raw any
+		err error
+	)
+	if len(passphrase) > 0 {
+		raw, err = ssh.ParseRawPrivateKeyWithPassphrase(data, passphrase)
+	} else {
+		raw, err = ssh.ParseRawPrivateKey(data)
+	}
+
+	var missing *ssh.PassphraseMissingError
+	switch {
+	case errors.As(err, &missing):
+		return nil, ErrPassphraseRequired
+	case errors.Is(err, x509.IncorrectPasswordError):
+		return nil, ErrWrongPassphrase
+	case err != nil:
+		return nil, ErrInvalidPrivateKey
+	}
+
+	signer, err := ssh.NewSignerFromKey(raw)
+	if err != nil {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	return &Key{
+		PrivateKey: raw,
+		PublicKey:  signer.PublicKey(),
+		Comment:    comment,
+	}, nil
+}
+
+// Marshal encodes the private key as an unencrypted OpenSSH key file, the form keys are stored in.
+func (k *Key) Marshal() ([]byte, error) {
+	block, err := ssh.MarshalPrivateKey(k.PrivateKey, k.Comment)
+	if err != nil {
+		return nil, ErrInvalidPrivateKey
+	}
+	return pem.EncodeToMemory(block), nil
+}
+
+// AuthorizedKey returns the public key as an authorized_keys line, with the comment if there is one.
+func (k *Key) AuthorizedKey() string {
+	return AuthorizedKey(k.PublicKey, k.Comment)
+}
+
+// ParsePublicKey parses a single authorized_keys line, returning the key and its comment.
+func ParsePublicKey(line string) (ssh.PublicKey, string, error) {
+	pub, comment, _, rest, err := ssh.ParseAuthorizedKey([]byte(line))
+	if err != nil || len(bytes.TrimSpace(rest)) > 0 {
+		return nil, "", ErrInvalidPublicKey
+	}
+	return pub, comment, nil
+}
+
+// AuthorizedKey formats a public key as an authorized_keys line without the trailing newline.
+func AuthorizedKey(pub ssh.PublicKey, comment string) string {
+	line := strings.TrimSuffix(string(ssh.MarshalAuthorizedKey(pub)), "\n")
+	if comment != "" {
+		line += " " + comment
+	}
+	return line
+}
+
+// Fingerprint returns the SHA-256 fingerprint of a public key as printed by ssh-keygen -l.
+func Fingerprint(pub ssh.PublicKey) string {
+	return ssh.FingerprintSHA256(pub)
+}
+
+// Validate checks that a stored private key parses and belongs to the public key.
+func Validate(private []byte, public ssh.PublicKey) error {
+	key, err := ParsePrivateKey(private, nil, "")
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(key.PublicKey.Marshal(), public.Marshal()) {
+		return ErrKeyMismatch
+	}
+	return nil
+}
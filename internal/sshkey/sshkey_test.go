@@ -0,0 +1,154 @@
+package sshkey
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"golang.org/x/crypto/ssh"
+	"strings"
+	"testing"
+)
+
+// newKeyFile generates an Ed25519 key file, protected by the passphrase unless it is empty.
+func newKeyFile(t *testing.T, passphrase string) ([]byte, ssh.PublicKey) {
+	t.Helper()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var block *pem.Block
+	if passphrase == "" {
+		block, err = ssh.MarshalPrivateKey(priv, "alice@host")
+	} else {
+		block, err = ssh.MarshalPrivateKeyWithPassphrase(priv, "alice@host", []byte(passphrase))
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(block), sshPub
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	plain, plainPub := newKeyFile(t, "")
+	protected, protectedPub := newKeyFile(t, "secret")
+
+	tests := []struct {
+		name       string
+		data       []byte
+		passphrase string
+		wantPub    ssh.PublicKey
+		wantErr    error
+	}{
+		{"unprotected", plain, "", plainPub, nil},
+		{"protected with passphrase", protected, "secret", protectedPub, nil},
+		{"protected without passphrase", protected, "", nil, ErrPassphraseRequired},
+		{"protected with wrong passphrase", protected, "guess", nil, ErrWrongPassphrase},
+		{"not a key", []byte("hello"), "", nil, ErrInvalidPrivateKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := ParsePrivateKey(tt.data, []byte(tt.passphrase), "comment")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParsePrivateKey() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && Fingerprint(key.PublicKey) != Fingerprint(tt.wantPub) {
+				t.Errorf("ParsePrivateKey() public key = %s, want %s", Fingerprint(key.PublicKey), Fingerprint(tt.wantPub))
+			}
+		})
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	protected, pub := newKeyFile(t, "secret")
+
+	key, err := ParsePrivateKey(protected, []byte("secret"), "alice@host")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored, err := key.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Validate(stored, pub); err != nil {
+		t.Errorf("Validate() of the stored key error = %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	plain, pub := newKeyFile(t, "")
+	_, otherPub := newKeyFile(t, "")
+	protected, protectedPub := newKeyFile(t, "secret")
+
+	tests := []struct {
+		name    string
+		private []byte
+		public  ssh.PublicKey
+		wantErr error
+	}{
+		{"matching pair", plain, pub, nil},
+		{"other public key", plain, otherPub, ErrKeyMismatch},
+		{"protected private key", protected, protectedPub, ErrPassphraseRequired},
+		{"not a key", []byte("hello"), pub, ErrInvalidPrivateKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(tt.private, tt.public); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	_, pub := newKeyFile(t, "")
+	line := AuthorizedKey(pub, "alice@host")
+
+	tests := []struct {
+		name        string
+		line        string
+		wantComment string
+		wantErr     error
+	}{
+		{"with comment", line, "alice@host", nil},
+		{"without comment", AuthorizedKey(pub, ""), "", nil},
+		{"trailing newline", line + "\n", "alice@host", nil},
+		{"two keys", line + "\n" + line, "", ErrInvalidPublicKey},
+		{"not a key", "ssh-ed25519 hello", "", ErrInvalidPublicKey},
+		{"empty", "", "", ErrInvalidPublicKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, comment, err := ParsePublicKey(tt.line)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParsePublicKey() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if comment != tt.wantComment || Fingerprint(got) != Fingerprint(pub) {
+				t.Errorf("ParsePublicKey() = %s, %q; want %s, %q", Fingerprint(got), comment, Fingerprint(pub), tt.wantComment)
+			}
+		})
+	}
+}
+
+func TestAuthorizedKey(t *testing.T) {
+	_, pub := newKeyFile(t, "")
+
+	line := AuthorizedKey(pub, "alice@host")
+	if !strings.HasPrefix(line, "ssh-ed25519 ") || !strings.HasSuffix(line, " alice@host") {
+		t.Errorf("AuthorizedKey() = %q, want an ssh-ed25519 line ending in the comment", line)
+	}
+	if strings.Contains(AuthorizedKey(pub, ""), "\n") {
+		t.Error("AuthorizedKey() kept the trailing newline")
+	}
+	if fp := Fingerprint(pub); !strings.HasPrefix(fp, "SHA256:") {
+		t.Errorf("Fingerprint() = %q, want a SHA256 fingerprint", fp)
+	}
+}
@@ -0,0 +1,13 @@
+// Package stream implements a streaming AEAD for data too large to encrypt in a single message.
+// It follows the STREAM construction of Hoang, Reyhanitabar, Rogaway and Vizár: the plaintext is
+// cut into fixed-size segments, each sealed with AES-256-GCM under a nonce made of a per-stream
+// prefix, the segment counter and a flag marking the final segment. Reordering, dropping or
+// duplicating segments, as well as truncating the stream, therefore fail authentication.
+//
+// Every stream derives its own segment key from the caller's key and a random salt with HKDF-SHA256,
+// so a single long-lived key can encrypt any number of streams. The salt and the nonce prefix are
+// written into a header in front of the first segment.
+//
+// The package is shared by the server, which encrypts uploaded binaries at rest, and the client,
+// which encrypts files of zero-knowledge vault accounts before uploading them.
+package stream
@@ -0,0 +1,237 @@
+package stream
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// Stream format parameters.
+const (
+	SegmentSize = 64 * 1024 // Plaintext bytes per segment; only the final segment may be shorter.
+	KeySize     = 32        // Length of keys accepted by NewWriter and NewReader.
+
+	version    = 0x01                               // Format version written into the header.
+	saltSize   = 16                                 // Length of the salt of the segment key derivation.
+	prefixSize = 7                                  // Length of the random nonce prefix.
+	headerSize = 1 + saltSize + prefixSize          // Version, salt and nonce prefix.
+	tagSize    = 16                                 // AES-GCM authentication tag.
+	sealedSize = SegmentSize + tagSize              // Ciphertext bytes of a full segment.
+	keyInfo    = "gophkeeper-stream-v1 segment key" // HKDF info binding derived keys to this format.
+)
+
+// Error definitions for stream operations.
+var (
+	ErrInvalidHeader = errors.New("stream: invalid header")                // The header is missing, truncated or of an unknown version.
+	ErrInvalidStream = errors.New("stream: segment authentication failed") // A segment was modified, reordered or the stream truncated.
+	ErrTooLong       = errors.New("stream: too many segments")             // The segment counter would overflow.
+	ErrClosed        = errors.New("stream: write to closed stream")        // Write called after Close.
+	ErrInvalidKey    = errors.New("stream: key must be 32 bytes long")     // The key has the wrong length.
+)
+
+// Writer encrypts a stream written to it and passes the ciphertext to the underlying writer.
+type Writer struct {
+	w       io.Writer   // Destination of the header and sealed segments.
+	aead    cipher.AEAD // Segment cipher keyed with the derived key.
+	nonce   []byte      // Nonce buffer holding the prefix.
+	counter uint32      // Index of the next segment.
+	buf     []byte      // Plaintext of the pending segment.
+	out     []byte      // Buffer for sealed segments.
+	closed  bool        // Whether the final segment was written.
+}
+
+// NewWriter starts a stream encrypted under key and writes its header to w.
+// Close must be called to write the final segment; it does not close w.
+func NewWriter(w io.Writer, key []byte) (*Writer, error) {
+	header := make([]byte, headerSize)
+	header[0] = version
+	if _, err := io.ReadFull(rand.Reader, header[1:]); err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(key, header[1:1+saltSize])
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	copy(nonce, header[1+saltSize:])
+
+	return &Writer{
+		w:     w,
+		aead:  aead,
+		nonce: nonce,
+		buf:   make([]byte, 0, SegmentSize),
+		out:   make([]byte, 0, sealedSize),
+	}, nil
+}
+
+// Write encrypts p. Full segments are written out once more data follows them,
+// because only then is it known that they are not the final one.
+func (s *Writer) Write(p []byte) (int, error) {
+	if s.closed {
+		return 0, ErrClosed
+	}
+
+	n := 0
+	for len(p) > 0 {
+		if len(s.buf) == SegmentSize {
+			if err := s.flush(false); err != nil {
+				return n, err
+			}
+		}
+		k := copy(s.buf[len(s.buf):SegmentSize], p)
+		s.buf = s.buf[:len(s.buf)+k]
+		p = p[k:]
+		n += k
+	}
+	return n, nil
+}
+
+// Close writes the pending data as the final segment. An empty stream still gets a final segment,
+// so that its absence is detected as truncation.
+func (s *Writer) Close() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	return s.flush(true)
+}
+
+// flush seals the pending segment and writes it out.
+func (s *Writer) flush(last bool) error {
+	if s.counter == math.MaxUint32 {
+		return ErrTooLong
+	}
+	setNonce(s.nonce, s.counter, last)
+	s.counter++
+
+	s.out = s.aead.Seal(s.out[:0], s.nonce, s.buf, nil)
+	s.buf = s.buf[:0]
+	_, err := s.w.Write(s.out)
+	return err
+}
+
+// Reader decrypts a stream read from the underlying reader.
+// Data is only returned after the segment holding it has been authenticated.
+type Reader struct {
+	r       *bufio.Reader // Source of the sealed segments.
+	aead    cipher.AEAD   // Segment cipher keyed with the derived key.
+	nonce   []byte        // Nonce buffer holding the prefix.
+	counter uint32        // Index of the next segment.
+	in      []byte        // Buffer for sealed segments.
+	plain   []byte        // Decrypted data not yet returned.
+	done    bool          // Whether the final segment was read.
+}
+
+// NewReader reads the stream header from r and prepares the decryption of its segments.
+func NewReader(r io.Reader, key []byte) (*Reader, error) {
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, ErrInvalidHeader
+		}
+		return nil, err
+	}
+	if header[0] != version {
+		return nil, ErrInvalidHeader
+	}
+
+	aead, err := newAEAD(key, header[1:1+saltSize])
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	copy(nonce, header[1+saltSize:])
+
+	return &Reader{
+		r:     bufio.NewReaderSize(r, sealedSize+1),
+		aead:  aead,
+		nonce: nonce,
+		in:    make([]byte, sealedSize),
+	}, nil
+}
+
+// Read returns decrypted data. It fails with ErrInvalidStream if a segment does not authenticate,
+// including when the stream ends before its final segment.
+func (s *Reader) Read(p []byte) (int, error) {
+	for len(s.plain) == 0 {
+		if s.done {
+			return 0, io.EOF
+		}
+		if err := s.next(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, s.plain)
+	s.plain = s.plain[n:]
+	return n, nil
+}
+
+// next reads and opens the following segment. A segment is final if the stream ends right after it.
+func (s *Reader) next() error {
+	n, err := io.ReadFull(s.r, s.in)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	last := n < sealedSize
+	if !last {
+		if _, err := s.r.Peek(1); errors.Is(err, io.EOF) {
+			last = true
+		} else if err != nil {
+			return err
+		}
+	}
+
+	if s.counter == math.MaxUint32 {
+		return ErrTooLong
+	}
+	setNonce(s.nonce, s.counter, last)
+	s.counter++
+
+	plain, err := s.aead.Open(s.in[:0], s.nonce, s.in[:n], nil)
+	if err != nil {
+		return ErrInvalidStream
+	}
+	s.plain = plain
+	s.done = last
+	return nil
+}
+
+// newAEAD derives the segment key of a stream and creates its cipher.
+func newAEAD(key, salt []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	segmentKey, err := hkdf.Key(sha256.New, key, salt, keyInfo, KeySize)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(segmentKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// setNonce writes the segment counter and the final segment flag after the nonce prefix.
+func setNonce(nonce []byte, counter uint32, last bool) {
+	binary.BigEndian.PutUint32(nonce[prefixSize:], counter)
+	nonce[prefixSize+4] = 0
+	if last {
+		nonce[prefixSize+4] = 1
+	}
+}
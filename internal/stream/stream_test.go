@@ -0,0 +1,139 @@
+package stream
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+// testKey is the key every test stream is encrypted under.
+var testKey = bytes.Repeat([]byte{0x42}, KeySize)
+
+// seal encrypts content into a stream under testKey.
+func seal(t *testing.T, content []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// open decrypts a whole stream, reporting errors of the header and of the segments alike.
+func open(key, data []byte) ([]byte, error) {
+	r, err := NewReader(bytes.NewReader(data), key)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+// content returns n bytes of recognizable plaintext.
+func content(n int) []byte {
+	res := make([]byte, n)
+	for i := range res {
+		res[i] = byte(i % 251)
+	}
+	return res
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		size int
+	}{
+		{"empty", 0},
+		{"single byte", 1},
+		{"short segment", SegmentSize - 1},
+		{"full segment", SegmentSize},
+		{"one byte over a segment", SegmentSize + 1},
+		{"several full segments", 3 * SegmentSize},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := content(tt.size)
+			sealed := seal(t, want)
+
+			segments := max((tt.size+SegmentSize-1)/SegmentSize, 1) // A full segment at the end is the final one.
+			if size := headerSize + tt.size + segments*tagSize; len(sealed) != size {
+				t.Errorf("sealed size = %d, want %d", len(sealed), size)
+			}
+
+			got, err := open(testKey, sealed)
+			if err != nil {
+				t.Fatalf("open() error = %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Error("open() returned other content")
+			}
+		})
+	}
+}
+
+func TestTampering(t *testing.T) {
+	sealed := seal(t, content(2*SegmentSize+100))
+	first := headerSize                // Offset of the first segment.
+	second := headerSize + sealedSize  // Offset of the second segment.
+	final := headerSize + 2*sealedSize // Offset of the final, short segment.
+	otherKey := bytes.Repeat([]byte{0x24}, KeySize)
+
+	flip := func(i int) []byte {
+		res := bytes.Clone(sealed)
+		res[i] ^= 0x01
+		return res
+	}
+	swapped := bytes.Clone(sealed)
+	copy(swapped[first:second], sealed[second:final])
+	copy(swapped[second:final], sealed[first:second])
+
+	tests := []struct {
+		name    string
+		key     []byte
+		data    []byte
+		wantErr error
+	}{
+		{"modified segment", testKey, flip(second + 10), ErrInvalidStream},
+		{"modified tag", testKey, flip(final - 1), ErrInvalidStream},
+		{"modified salt", testKey, flip(1), ErrInvalidStream},
+		{"modified nonce prefix", testKey, flip(headerSize - 1), ErrInvalidStream},
+		{"reordered segments", testKey, swapped, ErrInvalidStream},
+		{"truncated at a segment boundary", testKey, sealed[:final], ErrInvalidStream},
+		{"truncated within a segment", testKey, sealed[:final+10], ErrInvalidStream},
+		{"final segment dropped from a full stream", testKey, sealed[:second], ErrInvalidStream},
+		{"trailing data", testKey, append(bytes.Clone(sealed), 0), ErrInvalidStream},
+		{"other key", otherKey, sealed, ErrInvalidStream},
+		{"unknown version", testKey, flip(0), ErrInvalidHeader},
+		{"truncated header", testKey, sealed[:headerSize-1], ErrInvalidHeader},
+		{"empty", testKey, nil, ErrInvalidHeader},
+		{"short key", testKey[:16], sealed, ErrInvalidKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := open(tt.key, tt.data); !errors.Is(err, tt.wantErr) {
+				t.Errorf("open() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWriteAfterClose(t *testing.T) {
+	w, err := NewWriter(io.Discard, testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("late")); !errors.Is(err, ErrClosed) {
+		t.Errorf("Write() error = %v, want %v", err, ErrClosed)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,17 +23,220 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SortField int32
+
+const (
+	SortField_SORT_FIELD_TITLE    SortField = 0
+	SortField_SORT_FIELD_DATE     SortField = 1
+	SortField_SORT_FIELD_UPDATED  SortField = 2
+	SortField_SORT_FIELD_ACCESSED SortField = 3
+)
+
+// Enum value maps for SortField.
+var (
+	SortField_name = map[int32]string{
+		0: "SORT_FIELD_TITLE",
+		1: "SORT_FIELD_DATE",
+		2: "SORT_FIELD_UPDATED",
+		3: "SORT_FIELD_ACCESSED",
+	}
+	SortField_value = map[string]int32{
+		"SORT_FIELD_TITLE":    0,
+		"SORT_FIELD_DATE":     1,
+		"SORT_FIELD_UPDATED":  2,
+		"SORT_FIELD_ACCESSED": 3,
+	}
+)
+
+func (x SortField) Enum() *SortField {
+	p := new(SortField)
+	*p = x
+	return p
+}
+
+func (x SortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_gophkeeper_proto_enumTypes[0].Descriptor()
+}
+
+func (SortField) Type() protoreflect.EnumType {
+	return &file_proto_gophkeeper_proto_enumTypes[0]
+}
+
+func (x SortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortField.Descriptor instead.
+func (SortField) EnumDescriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{0}
+}
+
+type ItemKind int32
+
+const (
+	ItemKind_ITEM_KIND_UNSPECIFIED ItemKind = 0
+	ItemKind_ITEM_KIND_PASSWORD    ItemKind = 1
+	ItemKind_ITEM_KIND_CARD        ItemKind = 2
+	ItemKind_ITEM_KIND_BINARY      ItemKind = 3
+	ItemKind_ITEM_KIND_NOTE        ItemKind = 4
+	ItemKind_ITEM_KIND_OTP         ItemKind = 5
+	ItemKind_ITEM_KIND_SSH_KEY     ItemKind = 6
+)
+
+// Enum value maps for ItemKind.
+var (
+	ItemKind_name = map[int32]string{
+		0: "ITEM_KIND_UNSPECIFIED",
+		1: "ITEM_KIND_PASSWORD",
+		2: "ITEM_KIND_CARD",
+		3: "ITEM_KIND_BINARY",
+		4: "ITEM_KIND_NOTE",
+		5: "ITEM_KIND_OTP",
+		6: "ITEM_KIND_SSH_KEY",
+	}
+	ItemKind_value = map[string]int32{
+		"ITEM_KIND_UNSPECIFIED": 0,
+		"ITEM_KIND_PASSWORD":    1,
+		"ITEM_KIND_CARD":        2,
+		"ITEM_KIND_BINARY":      3,
+		"ITEM_KIND_NOTE":        4,
+		"ITEM_KIND_OTP":         5,
+		"ITEM_KIND_SSH_KEY":     6,
+	}
+)
+
+func (x ItemKind) Enum() *ItemKind {
+	p := new(ItemKind)
+	*p = x
+	return p
+}
+
+func (x ItemKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ItemKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_gophkeeper_proto_enumTypes[1].Descriptor()
+}
+
+func (ItemKind) Type() protoreflect.EnumType {
+	return &file_proto_gophkeeper_proto_enumTypes[1]
+}
+
+func (x ItemKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ItemKind.Descriptor instead.
+func (ItemKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{1}
+}
+
+// Argon2id parameters of a zero-knowledge vault. Records of vault accounts are
+// encrypted by the client: every string field of a record carries base64-encoded
+// ciphertext and binary data carries raw ciphertext, which the server stores as is.
+// The ciphertexts are bound to the user ID and the ID of their record, which the client
+// asks RecordID for before it encrypts a new record.
+type VaultParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Salt          []byte                 `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt,omitempty"`
+	Time          uint32                 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Memory        uint32                 `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	Threads       uint32                 `protobuf:"varint,4,opt,name=threads,proto3" json:"threads,omitempty"`
+	Check         []byte                 `protobuf:"bytes,5,opt,name=check,proto3" json:"check,omitempty"`
+	UserId        int64                  `protobuf:"varint,6,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VaultParams) Reset() {
+	*x = VaultParams{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultParams) ProtoMessage() {}
+
+func (x *VaultParams) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultParams.ProtoReflect.Descriptor instead.
+func (*VaultParams) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VaultParams) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *VaultParams) GetTime() uint32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *VaultParams) GetMemory() uint32 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *VaultParams) GetThreads() uint32 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+func (x *VaultParams) GetCheck() []byte {
+	if x != nil {
+		return x.Check
+	}
+	return nil
+}
+
+func (x *VaultParams) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Login         string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Vault         *VaultParams           `protobuf:"bytes,3,opt,name=vault,proto3" json:"vault,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RegisterRequest) Reset() {
 	*x = RegisterRequest{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[0]
+	mi := &file_proto_gophkeeper_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -44,7 +248,7 @@ func (x *RegisterRequest) String() string {
 func (*RegisterRequest) ProtoMessage() {}
 
 func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[0]
+	mi := &file_proto_gophkeeper_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,7 +261,7 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
 func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{0}
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *RegisterRequest) GetLogin() string {
@@ -74,16 +278,25 @@ func (x *RegisterRequest) GetPassword() string {
 	return ""
 }
 
+func (x *RegisterRequest) GetVault() *VaultParams {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
 type RegisterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RegisterResponse) Reset() {
 	*x = RegisterResponse{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[1]
+	mi := &file_proto_gophkeeper_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -95,7 +308,7 @@ func (x *RegisterResponse) String() string {
 func (*RegisterResponse) ProtoMessage() {}
 
 func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[1]
+	mi := &file_proto_gophkeeper_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -108,7 +321,7 @@ func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
 func (*RegisterResponse) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{1}
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *RegisterResponse) GetToken() string {
@@ -118,6 +331,20 @@ func (x *RegisterResponse) GetToken() string {
 	return ""
 }
 
+func (x *RegisterResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RegisterResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Login         string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
@@ -128,7 +355,7 @@ type LoginRequest struct {
 
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[2]
+	mi := &file_proto_gophkeeper_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -140,7 +367,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[2]
+	mi := &file_proto_gophkeeper_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -153,7 +380,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{2}
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *LoginRequest) GetLogin() string {
@@ -170,16 +397,22 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+// A login of a user with two-factor authentication carries only the challenge,
+// which VerifySecondFactor exchanges for the tokens.
 type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Vault         *VaultParams           `protobuf:"bytes,2,opt,name=vault,proto3" json:"vault,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Challenge     string                 `protobuf:"bytes,5,opt,name=challenge,proto3" json:"challenge,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[3]
+	mi := &file_proto_gophkeeper_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -191,7 +424,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[3]
+	mi := &file_proto_gophkeeper_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +437,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{3}
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *LoginResponse) GetToken() string {
@@ -214,28 +447,56 @@ func (x *LoginResponse) GetToken() string {
 	return ""
 }
 
-type PasswordRequest struct {
+func (x *LoginResponse) GetVault() *VaultParams {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
+func (x *LoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *LoginResponse) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+type VaultResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Vault         *VaultParams           `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PasswordRequest) Reset() {
-	*x = PasswordRequest{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[4]
+func (x *VaultResponse) Reset() {
+	*x = VaultResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PasswordRequest) String() string {
+func (x *VaultResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordRequest) ProtoMessage() {}
+func (*VaultResponse) ProtoMessage() {}
 
-func (x *PasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[4]
+func (x *VaultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,43 +507,43 @@ func (x *PasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordRequest.ProtoReflect.Descriptor instead.
-func (*PasswordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use VaultResponse.ProtoReflect.Descriptor instead.
+func (*VaultResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *PasswordRequest) GetTitle() string {
+func (x *VaultResponse) GetVault() *VaultParams {
 	if x != nil {
-		return x.Title
+		return x.Vault
 	}
-	return ""
+	return nil
 }
 
-type PasswordResponse struct {
+// RecordID returns the ID of the live record of the kind with the title, or reserves an ID
+// for a new one; the reserved ID is then sent along with the record when it is created.
+type RecordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          ItemKind               `protobuf:"varint,1,opt,name=kind,proto3,enum=gophkeeper.ItemKind" json:"kind,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Login         string                 `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PasswordResponse) Reset() {
-	*x = PasswordResponse{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[5]
+func (x *RecordRequest) Reset() {
+	*x = RecordRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PasswordResponse) String() string {
+func (x *RecordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordResponse) ProtoMessage() {}
+func (*RecordRequest) ProtoMessage() {}
 
-func (x *PasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[5]
+func (x *RecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -293,61 +554,48 @@ func (x *PasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordResponse.ProtoReflect.Descriptor instead.
-func (*PasswordResponse) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use RecordRequest.ProtoReflect.Descriptor instead.
+func (*RecordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PasswordResponse) GetId() int64 {
+func (x *RecordRequest) GetKind() ItemKind {
 	if x != nil {
-		return x.Id
+		return x.Kind
 	}
-	return 0
+	return ItemKind_ITEM_KIND_UNSPECIFIED
 }
 
-func (x *PasswordResponse) GetTitle() string {
+func (x *RecordRequest) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *PasswordResponse) GetLogin() string {
-	if x != nil {
-		return x.Login
-	}
-	return ""
-}
-
-func (x *PasswordResponse) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
-}
-
-type PasswordShortResponse struct {
+type RecordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reserved      bool                   `protobuf:"varint,2,opt,name=reserved,proto3" json:"reserved,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PasswordShortResponse) Reset() {
-	*x = PasswordShortResponse{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[6]
+func (x *RecordResponse) Reset() {
+	*x = RecordResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PasswordShortResponse) String() string {
+func (x *RecordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordShortResponse) ProtoMessage() {}
+func (*RecordResponse) ProtoMessage() {}
 
-func (x *PasswordShortResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[6]
+func (x *RecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,42 +606,48 @@ func (x *PasswordShortResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordShortResponse.ProtoReflect.Descriptor instead.
-func (*PasswordShortResponse) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RecordResponse.ProtoReflect.Descriptor instead.
+func (*RecordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PasswordShortResponse) GetTitle() string {
+func (x *RecordResponse) GetId() int64 {
 	if x != nil {
-		return x.Title
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type PasswordCreateRequest struct {
+func (x *RecordResponse) GetReserved() bool {
+	if x != nil {
+		return x.Reserved
+	}
+	return false
+}
+
+type SecondFactorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Login         string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Challenge     string                 `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PasswordCreateRequest) Reset() {
-	*x = PasswordCreateRequest{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[7]
+func (x *SecondFactorRequest) Reset() {
+	*x = SecondFactorRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PasswordCreateRequest) String() string {
+func (x *SecondFactorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordCreateRequest) ProtoMessage() {}
+func (*SecondFactorRequest) ProtoMessage() {}
 
-func (x *PasswordCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[7]
+func (x *SecondFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,56 +658,5112 @@ func (x *PasswordCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordCreateRequest.ProtoReflect.Descriptor instead.
-func (*PasswordCreateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SecondFactorRequest.ProtoReflect.Descriptor instead.
+func (*SecondFactorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PasswordCreateRequest) GetTitle() string {
+func (x *SecondFactorRequest) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *SecondFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type TOTPEnrollResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Uri           string                 `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TOTPEnrollResponse) Reset() {
+	*x = TOTPEnrollResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TOTPEnrollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TOTPEnrollResponse) ProtoMessage() {}
+
+func (x *TOTPEnrollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TOTPEnrollResponse.ProtoReflect.Descriptor instead.
+func (*TOTPEnrollResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TOTPEnrollResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *TOTPEnrollResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type TOTPCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TOTPCodeRequest) Reset() {
+	*x = TOTPCodeRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TOTPCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TOTPCodeRequest) ProtoMessage() {}
+
+func (x *TOTPCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TOTPCodeRequest.ProtoReflect.Descriptor instead.
+func (*TOTPCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TOTPCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type TOTPConfirmResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recoveryCodes,proto3" json:"recoveryCodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TOTPConfirmResponse) Reset() {
+	*x = TOTPConfirmResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TOTPConfirmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TOTPConfirmResponse) ProtoMessage() {}
+
+func (x *TOTPConfirmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TOTPConfirmResponse.ProtoReflect.Descriptor instead.
+func (*TOTPConfirmResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TOTPConfirmResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type CertificateBindRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertificateBindRequest) Reset() {
+	*x = CertificateBindRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateBindRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateBindRequest) ProtoMessage() {}
+
+func (x *CertificateBindRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateBindRequest.ProtoReflect.Descriptor instead.
+func (*CertificateBindRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CertificateBindRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type CertificateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertificateRequest) Reset() {
+	*x = CertificateRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateRequest) ProtoMessage() {}
+
+func (x *CertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateRequest.ProtoReflect.Descriptor instead.
+func (*CertificateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CertificateRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type CertificateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	SessionId     int64                  `protobuf:"varint,2,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertificateResponse) Reset() {
+	*x = CertificateResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateResponse) ProtoMessage() {}
+
+func (x *CertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateResponse.ProtoReflect.Descriptor instead.
+func (*CertificateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CertificateResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *CertificateResponse) GetSessionId() int64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type Certificate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	SessionId     int64                  `protobuf:"varint,2,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Certificate) Reset() {
+	*x = Certificate{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Certificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Certificate) ProtoMessage() {}
+
+func (x *Certificate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Certificate.ProtoReflect.Descriptor instead.
+func (*Certificate) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Certificate) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Certificate) GetSessionId() int64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *Certificate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Certificate) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CertificateListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Certificate         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertificateListResponse) Reset() {
+	*x = CertificateListResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateListResponse) ProtoMessage() {}
+
+func (x *CertificateListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateListResponse.ProtoReflect.Descriptor instead.
+func (*CertificateListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CertificateListResponse) GetItems() []*Certificate {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RefreshRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshRequest) Reset() {
+	*x = RefreshRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshRequest) ProtoMessage() {}
+
+func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
+func (*RefreshRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RefreshRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshResponse) Reset() {
+	*x = RefreshResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshResponse) ProtoMessage() {}
+
+func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshResponse.ProtoReflect.Descriptor instead.
+func (*RefreshResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RefreshResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RefreshResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,2,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastUsedAt,proto3" json:"lastUsedAt,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Current       bool                   `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Session) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type SessionListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Session             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionListResponse) Reset() {
+	*x = SessionListResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionListResponse) ProtoMessage() {}
+
+func (x *SessionListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionListResponse.ProtoReflect.Descriptor instead.
+func (*SessionListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SessionListResponse) GetItems() []*Session {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RevokeSessionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Folder restricts the listing to a folder and its subfolders; only items carrying all tags are listed.
+// updatedBefore and accessedBefore list only items not changed, or not read, since then; never read items count as not read.
+type ListRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Cursor         string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	TitlePrefix    string                 `protobuf:"bytes,3,opt,name=titlePrefix,proto3" json:"titlePrefix,omitempty"`
+	SortBy         SortField              `protobuf:"varint,4,opt,name=sortBy,proto3,enum=gophkeeper.SortField" json:"sortBy,omitempty"`
+	Descending     bool                   `protobuf:"varint,5,opt,name=descending,proto3" json:"descending,omitempty"`
+	Folder         string                 `protobuf:"bytes,6,opt,name=folder,proto3" json:"folder,omitempty"`
+	Tags           []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	UpdatedBefore  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedBefore,proto3" json:"updatedBefore,omitempty"`
+	AccessedBefore *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=accessedBefore,proto3" json:"accessedBefore,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListRequest) GetTitlePrefix() string {
+	if x != nil {
+		return x.TitlePrefix
+	}
+	return ""
+}
+
+func (x *ListRequest) GetSortBy() SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortField_SORT_FIELD_TITLE
+}
+
+func (x *ListRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListRequest) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *ListRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListRequest) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
+
+func (x *ListRequest) GetAccessedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AccessedBefore
+	}
+	return nil
+}
+
+// Placement of an item; updates only change the parts their replaceFolder and replaceTags flags ask for.
+type Placement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        string                 `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Placement) Reset() {
+	*x = Placement{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Placement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Placement) ProtoMessage() {}
+
+func (x *Placement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Placement.ProtoReflect.Descriptor instead.
+func (*Placement) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Placement) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *Placement) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type FolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FolderRequest) Reset() {
+	*x = FolderRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderRequest) ProtoMessage() {}
+
+func (x *FolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolderRequest.ProtoReflect.Descriptor instead.
+func (*FolderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FolderRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// Move places the folder under parent, the root if it is empty, renaming it unless name is empty.
+type FolderMoveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Parent        string                 `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FolderMoveRequest) Reset() {
+	*x = FolderMoveRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FolderMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderMoveRequest) ProtoMessage() {}
+
+func (x *FolderMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolderMoveRequest.ProtoReflect.Descriptor instead.
+func (*FolderMoveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FolderMoveRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FolderMoveRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *FolderMoveRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FolderListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Paths         []string               `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FolderListResponse) Reset() {
+	*x = FolderListResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FolderListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderListResponse) ProtoMessage() {}
+
+func (x *FolderListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolderListResponse.ProtoReflect.Descriptor instead.
+func (*FolderListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FolderListResponse) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+// Place moves a record to a folder and retags it without touching its content;
+// only the parts flagged for replacement change.
+type PlaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          ItemKind               `protobuf:"varint,1,opt,name=kind,proto3,enum=gophkeeper.ItemKind" json:"kind,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Placement     *Placement             `protobuf:"bytes,3,opt,name=placement,proto3" json:"placement,omitempty"`
+	ReplaceFolder bool                   `protobuf:"varint,4,opt,name=replaceFolder,proto3" json:"replaceFolder,omitempty"`
+	ReplaceTags   bool                   `protobuf:"varint,5,opt,name=replaceTags,proto3" json:"replaceTags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceRequest) Reset() {
+	*x = PlaceRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceRequest) ProtoMessage() {}
+
+func (x *PlaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceRequest.ProtoReflect.Descriptor instead.
+func (*PlaceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PlaceRequest) GetKind() ItemKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ItemKind_ITEM_KIND_UNSPECIFIED
+}
+
+func (x *PlaceRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PlaceRequest) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *PlaceRequest) GetReplaceFolder() bool {
+	if x != nil {
+		return x.ReplaceFolder
+	}
+	return false
+}
+
+func (x *PlaceRequest) GetReplaceTags() bool {
+	if x != nil {
+		return x.ReplaceTags
+	}
+	return false
+}
+
+type HistoryRetentionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keep          int32                  `protobuf:"varint,1,opt,name=keep,proto3" json:"keep,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryRetentionRequest) Reset() {
+	*x = HistoryRetentionRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryRetentionRequest) ProtoMessage() {}
+
+func (x *HistoryRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryRetentionRequest.ProtoReflect.Descriptor instead.
+func (*HistoryRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HistoryRetentionRequest) GetKeep() int32 {
+	if x != nil {
+		return x.Keep
+	}
+	return 0
+}
+
+type HistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryRequest) Reset() {
+	*x = HistoryRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryRequest) ProtoMessage() {}
+
+func (x *HistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryRequest.ProtoReflect.Descriptor instead.
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HistoryRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type Revision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int32                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Deleted       bool                   `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=archivedAt,proto3" json:"archivedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Revision) Reset() {
+	*x = Revision{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Revision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Revision) ProtoMessage() {}
+
+func (x *Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Revision.ProtoReflect.Descriptor instead.
+func (*Revision) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Revision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Revision) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Revision) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+type HistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Revision            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryResponse) Reset() {
+	*x = HistoryResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryResponse) ProtoMessage() {}
+
+func (x *HistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryResponse.ProtoReflect.Descriptor instead.
+func (*HistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *HistoryResponse) GetItems() []*Revision {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RestoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RestoreRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RestoreRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// Rename changes the title of a live record, found by its stable ID or, if the ID is zero,
+// by its title. The archived revisions of the record follow it to the new title.
+type RenameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	NewTitle      string                 `protobuf:"bytes,3,opt,name=newTitle,proto3" json:"newTitle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameRequest) Reset() {
+	*x = RenameRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameRequest) ProtoMessage() {}
+
+func (x *RenameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameRequest.ProtoReflect.Descriptor instead.
+func (*RenameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RenameRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RenameRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RenameRequest) GetNewTitle() string {
+	if x != nil {
+		return x.NewTitle
+	}
+	return ""
+}
+
+type TrashItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          ItemKind               `protobuf:"varint,1,opt,name=kind,proto3,enum=gophkeeper.ItemKind" json:"kind,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	PurgeAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=purgeAt,proto3" json:"purgeAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashItem) Reset() {
+	*x = TrashItem{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashItem) ProtoMessage() {}
+
+func (x *TrashItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashItem.ProtoReflect.Descriptor instead.
+func (*TrashItem) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TrashItem) GetKind() ItemKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ItemKind_ITEM_KIND_UNSPECIFIED
+}
+
+func (x *TrashItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TrashItem) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TrashItem) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+type TrashListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          ItemKind               `protobuf:"varint,1,opt,name=kind,proto3,enum=gophkeeper.ItemKind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashListRequest) Reset() {
+	*x = TrashListRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashListRequest) ProtoMessage() {}
+
+func (x *TrashListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashListRequest.ProtoReflect.Descriptor instead.
+func (*TrashListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TrashListRequest) GetKind() ItemKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ItemKind_ITEM_KIND_UNSPECIFIED
+}
+
+type TrashListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TrashItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashListResponse) Reset() {
+	*x = TrashListResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashListResponse) ProtoMessage() {}
+
+func (x *TrashListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashListResponse.ProtoReflect.Descriptor instead.
+func (*TrashListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TrashListResponse) GetItems() []*TrashItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Purge with an empty title empties the trash, of the given kind or entirely.
+type TrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          ItemKind               `protobuf:"varint,1,opt,name=kind,proto3,enum=gophkeeper.ItemKind" json:"kind,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashRequest) Reset() {
+	*x = TrashRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashRequest) ProtoMessage() {}
+
+func (x *TrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashRequest.ProtoReflect.Descriptor instead.
+func (*TrashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TrashRequest) GetKind() ItemKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ItemKind_ITEM_KIND_UNSPECIFIED
+}
+
+func (x *TrashRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type TrashRestoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashRestoreResponse) Reset() {
+	*x = TrashRestoreResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashRestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashRestoreResponse) ProtoMessage() {}
+
+func (x *TrashRestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashRestoreResponse.ProtoReflect.Descriptor instead.
+func (*TrashRestoreResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TrashRestoreResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type TrashPurgeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purged        int32                  `protobuf:"varint,1,opt,name=purged,proto3" json:"purged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashPurgeResponse) Reset() {
+	*x = TrashPurgeResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashPurgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashPurgeResponse) ProtoMessage() {}
+
+func (x *TrashPurgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashPurgeResponse.ProtoReflect.Descriptor instead.
+func (*TrashPurgeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TrashPurgeResponse) GetPurged() int32 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+type PasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordRequest) Reset() {
+	*x = PasswordRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordRequest) ProtoMessage() {}
+
+func (x *PasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordRequest.ProtoReflect.Descriptor instead.
+func (*PasswordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PasswordRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// Custom fields of a password entry; type is one of text, hidden, url or email.
+type CustomField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomField) Reset() {
+	*x = CustomField{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomField) ProtoMessage() {}
+
+func (x *CustomField) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomField.ProtoReflect.Descriptor instead.
+func (*CustomField) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CustomField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomField) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CustomField) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type PasswordResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Login          string                 `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`
+	Password       string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Fields         []*CustomField         `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	Placement      *Placement             `protobuf:"bytes,6,opt,name=placement,proto3" json:"placement,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=lastAccessedAt,proto3" json:"lastAccessedAt,omitempty"`
+	AccessCount    int64                  `protobuf:"varint,10,opt,name=accessCount,proto3" json:"accessCount,omitempty"`
+	// Set if the password was found in the breach corpus of the server when it was last written or audited.
+	Compromised   bool `protobuf:"varint,11,opt,name=compromised,proto3" json:"compromised,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordResponse) Reset() {
+	*x = PasswordResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordResponse) ProtoMessage() {}
+
+func (x *PasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordResponse.ProtoReflect.Descriptor instead.
+func (*PasswordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PasswordResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PasswordResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PasswordResponse) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *PasswordResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *PasswordResponse) GetFields() []*CustomField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *PasswordResponse) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *PasswordResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PasswordResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PasswordResponse) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *PasswordResponse) GetAccessCount() int64 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+func (x *PasswordResponse) GetCompromised() bool {
+	if x != nil {
+		return x.Compromised
+	}
+	return false
+}
+
+type PasswordShortResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastAccessedAt,proto3" json:"lastAccessedAt,omitempty"`
+	AccessCount    int64                  `protobuf:"varint,6,opt,name=accessCount,proto3" json:"accessCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PasswordShortResponse) Reset() {
+	*x = PasswordShortResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordShortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordShortResponse) ProtoMessage() {}
+
+func (x *PasswordShortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordShortResponse.ProtoReflect.Descriptor instead.
+func (*PasswordShortResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PasswordShortResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PasswordShortResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PasswordShortResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PasswordShortResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PasswordShortResponse) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *PasswordShortResponse) GetAccessCount() int64 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+type PasswordListResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Items         []*PasswordShortResponse `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                   `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordListResponse) Reset() {
+	*x = PasswordListResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordListResponse) ProtoMessage() {}
+
+func (x *PasswordListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordListResponse.ProtoReflect.Descriptor instead.
+func (*PasswordListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PasswordListResponse) GetItems() []*PasswordShortResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PasswordListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Zero values take the settings of the preset, "default" if none is named: 20 characters of all classes.
+// Classes are "lower", "upper", "digits" and "symbols"; asking for words generates a diceware passphrase.
+type GenerateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Preset           string                 `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
+	Length           int32                  `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	Classes          []string               `protobuf:"bytes,3,rep,name=classes,proto3" json:"classes,omitempty"`
+	ExcludeAmbiguous bool                   `protobuf:"varint,4,opt,name=excludeAmbiguous,proto3" json:"excludeAmbiguous,omitempty"`
+	Words            int32                  `protobuf:"varint,5,opt,name=words,proto3" json:"words,omitempty"`
+	Separator        string                 `protobuf:"bytes,6,opt,name=separator,proto3" json:"separator,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GenerateRequest) Reset() {
+	*x = GenerateRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRequest) ProtoMessage() {}
+
+func (x *GenerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GenerateRequest) GetPreset() string {
+	if x != nil {
+		return x.Preset
+	}
+	return ""
+}
+
+func (x *GenerateRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *GenerateRequest) GetClasses() []string {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+func (x *GenerateRequest) GetExcludeAmbiguous() bool {
+	if x != nil {
+		return x.ExcludeAmbiguous
+	}
+	return false
+}
+
+func (x *GenerateRequest) GetWords() int32 {
+	if x != nil {
+		return x.Words
+	}
+	return 0
+}
+
+func (x *GenerateRequest) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+type GenerateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	EntropyBits   float64                `protobuf:"fixed64,2,opt,name=entropyBits,proto3" json:"entropyBits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateResponse) Reset() {
+	*x = GenerateResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateResponse) ProtoMessage() {}
+
+func (x *GenerateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateResponse.ProtoReflect.Descriptor instead.
+func (*GenerateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GenerateResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GenerateResponse) GetEntropyBits() float64 {
+	if x != nil {
+		return x.EntropyBits
+	}
+	return 0
+}
+
+// Zero values select the default policy: passwords scoring below 3 of 4 are weak, and those not changed
+// for 180 days are stale. A negative maxAgeDays skips the check for stale passwords.
+type AuditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinScore      int32                  `protobuf:"varint,1,opt,name=minScore,proto3" json:"minScore,omitempty"`
+	MaxAgeDays    int32                  `protobuf:"varint,2,opt,name=maxAgeDays,proto3" json:"maxAgeDays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditRequest) Reset() {
+	*x = AuditRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRequest) ProtoMessage() {}
+
+func (x *AuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRequest.ProtoReflect.Descriptor instead.
+func (*AuditRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AuditRequest) GetMinScore() int32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *AuditRequest) GetMaxAgeDays() int32 {
+	if x != nil {
+		return x.MaxAgeDays
+	}
+	return 0
+}
+
+// Score runs from 0, guessed in a moment, to 4, very unguessable; guessesLog10 is the estimated number of guesses as a power of ten.
+type PasswordStrength struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Score         int32                  `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	GuessesLog10  float64                `protobuf:"fixed64,2,opt,name=guessesLog10,proto3" json:"guessesLog10,omitempty"`
+	Warning       string                 `protobuf:"bytes,3,opt,name=warning,proto3" json:"warning,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordStrength) Reset() {
+	*x = PasswordStrength{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordStrength) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordStrength) ProtoMessage() {}
+
+func (x *PasswordStrength) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordStrength.ProtoReflect.Descriptor instead.
+func (*PasswordStrength) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PasswordStrength) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PasswordStrength) GetGuessesLog10() float64 {
+	if x != nil {
+		return x.GuessesLog10
+	}
+	return 0
+}
+
+func (x *PasswordStrength) GetWarning() string {
+	if x != nil {
+		return x.Warning
+	}
+	return ""
+}
+
+type WeakPassword struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Strength      *PasswordStrength      `protobuf:"bytes,2,opt,name=strength,proto3" json:"strength,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeakPassword) Reset() {
+	*x = WeakPassword{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeakPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeakPassword) ProtoMessage() {}
+
+func (x *WeakPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeakPassword.ProtoReflect.Descriptor instead.
+func (*WeakPassword) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WeakPassword) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WeakPassword) GetStrength() *PasswordStrength {
+	if x != nil {
+		return x.Strength
+	}
+	return nil
+}
+
+type ReusedPassword struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Titles        []string               `protobuf:"bytes,1,rep,name=titles,proto3" json:"titles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReusedPassword) Reset() {
+	*x = ReusedPassword{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReusedPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReusedPassword) ProtoMessage() {}
+
+func (x *ReusedPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReusedPassword.ProtoReflect.Descriptor instead.
+func (*ReusedPassword) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ReusedPassword) GetTitles() []string {
+	if x != nil {
+		return x.Titles
+	}
+	return nil
+}
+
+type StalePassword struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	AgeDays       int32                  `protobuf:"varint,3,opt,name=ageDays,proto3" json:"ageDays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StalePassword) Reset() {
+	*x = StalePassword{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StalePassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StalePassword) ProtoMessage() {}
+
+func (x *StalePassword) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StalePassword.ProtoReflect.Descriptor instead.
+func (*StalePassword) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *StalePassword) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *StalePassword) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *StalePassword) GetAgeDays() int32 {
+	if x != nil {
+		return x.AgeDays
+	}
+	return 0
+}
+
+type BreachedPassword struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BreachedPassword) Reset() {
+	*x = BreachedPassword{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreachedPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreachedPassword) ProtoMessage() {}
+
+func (x *BreachedPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreachedPassword.ProtoReflect.Descriptor instead.
+func (*BreachedPassword) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BreachedPassword) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BreachedPassword) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Passwords are never part of the report; reused ones are only grouped by the titles sharing them.
+type AuditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinScore      int32                  `protobuf:"varint,1,opt,name=minScore,proto3" json:"minScore,omitempty"`
+	MaxAgeDays    int32                  `protobuf:"varint,2,opt,name=maxAgeDays,proto3" json:"maxAgeDays,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Weak          []*WeakPassword        `protobuf:"bytes,4,rep,name=weak,proto3" json:"weak,omitempty"`
+	Reused        []*ReusedPassword      `protobuf:"bytes,5,rep,name=reused,proto3" json:"reused,omitempty"`
+	Stale         []*StalePassword       `protobuf:"bytes,6,rep,name=stale,proto3" json:"stale,omitempty"`
+	Breached      []*BreachedPassword    `protobuf:"bytes,7,rep,name=breached,proto3" json:"breached,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditResponse) Reset() {
+	*x = AuditResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditResponse) ProtoMessage() {}
+
+func (x *AuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditResponse.ProtoReflect.Descriptor instead.
+func (*AuditResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AuditResponse) GetMinScore() int32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *AuditResponse) GetMaxAgeDays() int32 {
+	if x != nil {
+		return x.MaxAgeDays
+	}
+	return 0
+}
+
+func (x *AuditResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AuditResponse) GetWeak() []*WeakPassword {
+	if x != nil {
+		return x.Weak
+	}
+	return nil
+}
+
+func (x *AuditResponse) GetReused() []*ReusedPassword {
+	if x != nil {
+		return x.Reused
+	}
+	return nil
+}
+
+func (x *AuditResponse) GetStale() []*StalePassword {
+	if x != nil {
+		return x.Stale
+	}
+	return nil
+}
+
+func (x *AuditResponse) GetBreached() []*BreachedPassword {
+	if x != nil {
+		return x.Breached
+	}
+	return nil
+}
+
+// The sealed passwords of a vault account, fetched at once for a client-side audit.
+type AuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AuditEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type AuditEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AuditEntry          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntriesResponse) Reset() {
+	*x = AuditEntriesResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntriesResponse) ProtoMessage() {}
+
+func (x *AuditEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntriesResponse.ProtoReflect.Descriptor instead.
+func (*AuditEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AuditEntriesResponse) GetItems() []*AuditEntry {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// With generate set, the server generates the password and stores it in place of password without returning it.
+type PasswordCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Login         string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Fields        []*CustomField         `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Placement     *Placement             `protobuf:"bytes,5,opt,name=placement,proto3" json:"placement,omitempty"`
+	Generate      *GenerateRequest       `protobuf:"bytes,6,opt,name=generate,proto3" json:"generate,omitempty"`
+	Id            int64                  `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordCreateRequest) Reset() {
+	*x = PasswordCreateRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordCreateRequest) ProtoMessage() {}
+
+func (x *PasswordCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordCreateRequest.ProtoReflect.Descriptor instead.
+func (*PasswordCreateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PasswordCreateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PasswordCreateRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *PasswordCreateRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *PasswordCreateRequest) GetFields() []*CustomField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *PasswordCreateRequest) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *PasswordCreateRequest) GetGenerate() *GenerateRequest {
+	if x != nil {
+		return x.Generate
+	}
+	return nil
+}
+
+func (x *PasswordCreateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// The stored custom fields are kept unless replaceFields is set, in which case they are replaced with fields.
+// With generate set, the server generates the new password and stores it in place of password without returning it.
+type PasswordUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Login         string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Fields        []*CustomField         `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	ReplaceFields bool                   `protobuf:"varint,5,opt,name=replaceFields,proto3" json:"replaceFields,omitempty"`
+	Placement     *Placement             `protobuf:"bytes,6,opt,name=placement,proto3" json:"placement,omitempty"`
+	ReplaceFolder bool                   `protobuf:"varint,7,opt,name=replaceFolder,proto3" json:"replaceFolder,omitempty"`
+	ReplaceTags   bool                   `protobuf:"varint,8,opt,name=replaceTags,proto3" json:"replaceTags,omitempty"`
+	Generate      *GenerateRequest       `protobuf:"bytes,9,opt,name=generate,proto3" json:"generate,omitempty"`
+	Id            int64                  `protobuf:"varint,10,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordUpdateRequest) Reset() {
+	*x = PasswordUpdateRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordUpdateRequest) ProtoMessage() {}
+
+func (x *PasswordUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordUpdateRequest.ProtoReflect.Descriptor instead.
+func (*PasswordUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PasswordUpdateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PasswordUpdateRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *PasswordUpdateRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *PasswordUpdateRequest) GetFields() []*CustomField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *PasswordUpdateRequest) GetReplaceFields() bool {
+	if x != nil {
+		return x.ReplaceFields
+	}
+	return false
+}
+
+func (x *PasswordUpdateRequest) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *PasswordUpdateRequest) GetReplaceFolder() bool {
+	if x != nil {
+		return x.ReplaceFolder
+	}
+	return false
+}
+
+func (x *PasswordUpdateRequest) GetReplaceTags() bool {
+	if x != nil {
+		return x.ReplaceTags
+	}
+	return false
+}
+
+func (x *PasswordUpdateRequest) GetGenerate() *GenerateRequest {
+	if x != nil {
+		return x.Generate
+	}
+	return nil
+}
+
+func (x *PasswordUpdateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardRequest) Reset() {
+	*x = CardRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardRequest) ProtoMessage() {}
+
+func (x *CardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardRequest.ProtoReflect.Descriptor instead.
+func (*CardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CardRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// The number is masked down to its last four digits and secretCode left empty, unless the card is revealed.
+// The numbers of vault accounts are sealed by the client, which masks them itself.
+type CardResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Bank           string                 `protobuf:"bytes,3,opt,name=bank,proto3" json:"bank,omitempty"`
+	Number         string                 `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"`
+	DataEnd        string                 `protobuf:"bytes,5,opt,name=dataEnd,proto3" json:"dataEnd,omitempty"`
+	SecretCode     string                 `protobuf:"bytes,6,opt,name=secretCode,proto3" json:"secretCode,omitempty"`
+	Placement      *Placement             `protobuf:"bytes,7,opt,name=placement,proto3" json:"placement,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=lastAccessedAt,proto3" json:"lastAccessedAt,omitempty"`
+	AccessCount    int64                  `protobuf:"varint,11,opt,name=accessCount,proto3" json:"accessCount,omitempty"`
+	Brand          string                 `protobuf:"bytes,12,opt,name=brand,proto3" json:"brand,omitempty"`
+	RevealCount    int64                  `protobuf:"varint,13,opt,name=revealCount,proto3" json:"revealCount,omitempty"`
+	LastRevealedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=lastRevealedAt,proto3" json:"lastRevealedAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CardResponse) Reset() {
+	*x = CardResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardResponse) ProtoMessage() {}
+
+func (x *CardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardResponse.ProtoReflect.Descriptor instead.
+func (*CardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CardResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CardResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CardResponse) GetBank() string {
+	if x != nil {
+		return x.Bank
+	}
+	return ""
+}
+
+func (x *CardResponse) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *CardResponse) GetDataEnd() string {
+	if x != nil {
+		return x.DataEnd
+	}
+	return ""
+}
+
+func (x *CardResponse) GetSecretCode() string {
+	if x != nil {
+		return x.SecretCode
+	}
+	return ""
+}
+
+func (x *CardResponse) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *CardResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CardResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CardResponse) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *CardResponse) GetAccessCount() int64 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+func (x *CardResponse) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *CardResponse) GetRevealCount() int64 {
+	if x != nil {
+		return x.RevealCount
+	}
+	return 0
+}
+
+func (x *CardResponse) GetLastRevealedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRevealedAt
+	}
+	return nil
+}
+
+type CardShortResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastAccessedAt,proto3" json:"lastAccessedAt,omitempty"`
+	AccessCount    int64                  `protobuf:"varint,6,opt,name=accessCount,proto3" json:"accessCount,omitempty"`
+	Brand          string                 `protobuf:"bytes,7,opt,name=brand,proto3" json:"brand,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CardShortResponse) Reset() {
+	*x = CardShortResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardShortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardShortResponse) ProtoMessage() {}
+
+func (x *CardShortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardShortResponse.ProtoReflect.Descriptor instead.
+func (*CardShortResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CardShortResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CardShortResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CardShortResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CardShortResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CardShortResponse) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *CardShortResponse) GetAccessCount() int64 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+func (x *CardShortResponse) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+type CardListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CardShortResponse   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardListResponse) Reset() {
+	*x = CardListResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardListResponse) ProtoMessage() {}
+
+func (x *CardListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardListResponse.ProtoReflect.Descriptor instead.
+func (*CardListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CardListResponse) GetItems() []*CardShortResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CardListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// The server validates the card and detects its brand; vault accounts validate it themselves and send the brand.
+type CardCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Bank          string                 `protobuf:"bytes,3,opt,name=bank,proto3" json:"bank,omitempty"`
+	Number        string                 `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"`
+	DataEnd       string                 `protobuf:"bytes,5,opt,name=dataEnd,proto3" json:"dataEnd,omitempty"`
+	SecretCode    string                 `protobuf:"bytes,6,opt,name=secretCode,proto3" json:"secretCode,omitempty"`
+	Placement     *Placement             `protobuf:"bytes,7,opt,name=placement,proto3" json:"placement,omitempty"`
+	Brand         string                 `protobuf:"bytes,8,opt,name=brand,proto3" json:"brand,omitempty"`
+	Id            int64                  `protobuf:"varint,9,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardCreateRequest) Reset() {
+	*x = CardCreateRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardCreateRequest) ProtoMessage() {}
+
+func (x *CardCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardCreateRequest.ProtoReflect.Descriptor instead.
+func (*CardCreateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CardCreateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CardCreateRequest) GetBank() string {
+	if x != nil {
+		return x.Bank
+	}
+	return ""
+}
+
+func (x *CardCreateRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *CardCreateRequest) GetDataEnd() string {
+	if x != nil {
+		return x.DataEnd
+	}
+	return ""
+}
+
+func (x *CardCreateRequest) GetSecretCode() string {
+	if x != nil {
+		return x.SecretCode
+	}
+	return ""
+}
+
+func (x *CardCreateRequest) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *CardCreateRequest) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *CardCreateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CardUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Bank          string                 `protobuf:"bytes,3,opt,name=bank,proto3" json:"bank,omitempty"`
+	Number        string                 `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"`
+	DataEnd       string                 `protobuf:"bytes,5,opt,name=dataEnd,proto3" json:"dataEnd,omitempty"`
+	SecretCode    string                 `protobuf:"bytes,6,opt,name=secretCode,proto3" json:"secretCode,omitempty"`
+	Placement     *Placement             `protobuf:"bytes,7,opt,name=placement,proto3" json:"placement,omitempty"`
+	ReplaceFolder bool                   `protobuf:"varint,8,opt,name=replaceFolder,proto3" json:"replaceFolder,omitempty"`
+	ReplaceTags   bool                   `protobuf:"varint,9,opt,name=replaceTags,proto3" json:"replaceTags,omitempty"`
+	Brand         string                 `protobuf:"bytes,10,opt,name=brand,proto3" json:"brand,omitempty"`
+	Id            int64                  `protobuf:"varint,11,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardUpdateRequest) Reset() {
+	*x = CardUpdateRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardUpdateRequest) ProtoMessage() {}
+
+func (x *CardUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardUpdateRequest.ProtoReflect.Descriptor instead.
+func (*CardUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CardUpdateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CardUpdateRequest) GetBank() string {
+	if x != nil {
+		return x.Bank
+	}
+	return ""
+}
+
+func (x *CardUpdateRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *CardUpdateRequest) GetDataEnd() string {
+	if x != nil {
+		return x.DataEnd
+	}
+	return ""
+}
+
+func (x *CardUpdateRequest) GetSecretCode() string {
+	if x != nil {
+		return x.SecretCode
+	}
+	return ""
+}
+
+func (x *CardUpdateRequest) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *CardUpdateRequest) GetReplaceFolder() bool {
+	if x != nil {
+		return x.ReplaceFolder
+	}
+	return false
+}
+
+func (x *CardUpdateRequest) GetReplaceTags() bool {
+	if x != nil {
+		return x.ReplaceTags
+	}
+	return false
+}
+
+func (x *CardUpdateRequest) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *CardUpdateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type NoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteRequest) Reset() {
+	*x = NoteRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteRequest) ProtoMessage() {}
+
+func (x *NoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteRequest.ProtoReflect.Descriptor instead.
+func (*NoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *NoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type NoteResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body           string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Placement      *Placement             `protobuf:"bytes,4,opt,name=placement,proto3" json:"placement,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=lastAccessedAt,proto3" json:"lastAccessedAt,omitempty"`
+	AccessCount    int64                  `protobuf:"varint,8,opt,name=accessCount,proto3" json:"accessCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NoteResponse) Reset() {
+	*x = NoteResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteResponse) ProtoMessage() {}
+
+func (x *NoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteResponse.ProtoReflect.Descriptor instead.
+func (*NoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *NoteResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NoteResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetAccessCount() int64 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+type NoteShortResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastAccessedAt,proto3" json:"lastAccessedAt,omitempty"`
+	AccessCount    int64                  `protobuf:"varint,6,opt,name=accessCount,proto3" json:"accessCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NoteShortResponse) Reset() {
+	*x = NoteShortResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteShortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteShortResponse) ProtoMessage() {}
+
+func (x *NoteShortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteShortResponse.ProtoReflect.Descriptor instead.
+func (*NoteShortResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *NoteShortResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NoteShortResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NoteShortResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NoteShortResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *NoteShortResponse) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *NoteShortResponse) GetAccessCount() int64 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+type NoteListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*NoteShortResponse   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteListResponse) Reset() {
+	*x = NoteListResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteListResponse) ProtoMessage() {}
+
+func (x *NoteListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteListResponse.ProtoReflect.Descriptor instead.
+func (*NoteListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *NoteListResponse) GetItems() []*NoteShortResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *NoteListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type NoteCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Placement     *Placement             `protobuf:"bytes,3,opt,name=placement,proto3" json:"placement,omitempty"`
+	Id            int64                  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteCreateRequest) Reset() {
+	*x = NoteCreateRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteCreateRequest) ProtoMessage() {}
+
+func (x *NoteCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteCreateRequest.ProtoReflect.Descriptor instead.
+func (*NoteCreateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *NoteCreateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NoteCreateRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *NoteCreateRequest) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *NoteCreateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type NoteUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Placement     *Placement             `protobuf:"bytes,3,opt,name=placement,proto3" json:"placement,omitempty"`
+	ReplaceFolder bool                   `protobuf:"varint,4,opt,name=replaceFolder,proto3" json:"replaceFolder,omitempty"`
+	ReplaceTags   bool                   `protobuf:"varint,5,opt,name=replaceTags,proto3" json:"replaceTags,omitempty"`
+	Id            int64                  `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteUpdateRequest) Reset() {
+	*x = NoteUpdateRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteUpdateRequest) ProtoMessage() {}
+
+func (x *NoteUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteUpdateRequest.ProtoReflect.Descriptor instead.
+func (*NoteUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *NoteUpdateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NoteUpdateRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *NoteUpdateRequest) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *NoteUpdateRequest) GetReplaceFolder() bool {
+	if x != nil {
+		return x.ReplaceFolder
+	}
+	return false
+}
+
+func (x *NoteUpdateRequest) GetReplaceTags() bool {
+	if x != nil {
+		return x.ReplaceTags
+	}
+	return false
+}
+
+func (x *NoteUpdateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type OTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OTPRequest) Reset() {
+	*x = OTPRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTPRequest) ProtoMessage() {}
+
+func (x *OTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTPRequest.ProtoReflect.Descriptor instead.
+func (*OTPRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *OTPRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type OTPResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Issuer         string                 `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Account        string                 `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Secret         string                 `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	Algorithm      string                 `protobuf:"bytes,6,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Digits         int32                  `protobuf:"varint,7,opt,name=digits,proto3" json:"digits,omitempty"`
+	Period         int32                  `protobuf:"varint,8,opt,name=period,proto3" json:"period,omitempty"`
+	Password       string                 `protobuf:"bytes,9,opt,name=password,proto3" json:"password,omitempty"`
+	Placement      *Placement             `protobuf:"bytes,10,opt,name=placement,proto3" json:"placement,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=lastAccessedAt,proto3" json:"lastAccessedAt,omitempty"`
+	AccessCount    int64                  `protobuf:"varint,14,opt,name=accessCount,proto3" json:"accessCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OTPResponse) Reset() {
+	*x = OTPResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTPResponse) ProtoMessage() {}
+
+func (x *OTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTPResponse.ProtoReflect.Descriptor instead.
+func (*OTPResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *OTPResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OTPResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OTPResponse) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OTPResponse) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *OTPResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *OTPResponse) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *OTPResponse) GetDigits() int32 {
+	if x != nil {
+		return x.Digits
+	}
+	return 0
+}
+
+func (x *OTPResponse) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *OTPResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *OTPResponse) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *OTPResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OTPResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *OTPResponse) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *OTPResponse) GetAccessCount() int64 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+type OTPShortResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastAccessedAt,proto3" json:"lastAccessedAt,omitempty"`
+	AccessCount    int64                  `protobuf:"varint,6,opt,name=accessCount,proto3" json:"accessCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OTPShortResponse) Reset() {
+	*x = OTPShortResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTPShortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTPShortResponse) ProtoMessage() {}
+
+func (x *OTPShortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTPShortResponse.ProtoReflect.Descriptor instead.
+func (*OTPShortResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *OTPShortResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OTPShortResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OTPShortResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OTPShortResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *OTPShortResponse) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *OTPShortResponse) GetAccessCount() int64 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+type OTPListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*OTPShortResponse    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OTPListResponse) Reset() {
+	*x = OTPListResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTPListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTPListResponse) ProtoMessage() {}
+
+func (x *OTPListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTPListResponse.ProtoReflect.Descriptor instead.
+func (*OTPListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *OTPListResponse) GetItems() []*OTPShortResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OTPListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// The client parses the otpauth:// URI, so the issuer, account and secret can be encrypted
+// before they are sent by vault accounts. Password is the title of a password to link to.
+type OTPCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Issuer        string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Account       string                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Algorithm     string                 `protobuf:"bytes,5,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Digits        int32                  `protobuf:"varint,6,opt,name=digits,proto3" json:"digits,omitempty"`
+	Period        int32                  `protobuf:"varint,7,opt,name=period,proto3" json:"period,omitempty"`
+	Password      string                 `protobuf:"bytes,8,opt,name=password,proto3" json:"password,omitempty"`
+	Placement     *Placement             `protobuf:"bytes,9,opt,name=placement,proto3" json:"placement,omitempty"`
+	Id            int64                  `protobuf:"varint,10,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OTPCreateRequest) Reset() {
+	*x = OTPCreateRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTPCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTPCreateRequest) ProtoMessage() {}
+
+func (x *OTPCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTPCreateRequest.ProtoReflect.Descriptor instead.
+func (*OTPCreateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *OTPCreateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OTPCreateRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OTPCreateRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *OTPCreateRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *OTPCreateRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *OTPCreateRequest) GetDigits() int32 {
+	if x != nil {
+		return x.Digits
+	}
+	return 0
+}
+
+func (x *OTPCreateRequest) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *OTPCreateRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *OTPCreateRequest) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *OTPCreateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type OTPUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Issuer        string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Account       string                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Algorithm     string                 `protobuf:"bytes,5,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Digits        int32                  `protobuf:"varint,6,opt,name=digits,proto3" json:"digits,omitempty"`
+	Period        int32                  `protobuf:"varint,7,opt,name=period,proto3" json:"period,omitempty"`
+	Password      string                 `protobuf:"bytes,8,opt,name=password,proto3" json:"password,omitempty"`
+	Placement     *Placement             `protobuf:"bytes,9,opt,name=placement,proto3" json:"placement,omitempty"`
+	ReplaceFolder bool                   `protobuf:"varint,10,opt,name=replaceFolder,proto3" json:"replaceFolder,omitempty"`
+	ReplaceTags   bool                   `protobuf:"varint,11,opt,name=replaceTags,proto3" json:"replaceTags,omitempty"`
+	Id            int64                  `protobuf:"varint,12,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OTPUpdateRequest) Reset() {
+	*x = OTPUpdateRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTPUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTPUpdateRequest) ProtoMessage() {}
+
+func (x *OTPUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTPUpdateRequest.ProtoReflect.Descriptor instead.
+func (*OTPUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *OTPUpdateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OTPUpdateRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OTPUpdateRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *OTPUpdateRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *OTPUpdateRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *OTPUpdateRequest) GetDigits() int32 {
+	if x != nil {
+		return x.Digits
+	}
+	return 0
+}
+
+func (x *OTPUpdateRequest) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *OTPUpdateRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *OTPUpdateRequest) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *OTPUpdateRequest) GetReplaceFolder() bool {
+	if x != nil {
+		return x.ReplaceFolder
+	}
+	return false
+}
+
+func (x *OTPUpdateRequest) GetReplaceTags() bool {
+	if x != nil {
+		return x.ReplaceTags
+	}
+	return false
+}
+
+func (x *OTPUpdateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Remaining and period are in seconds.
+type OTPCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Remaining     int32                  `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Period        int32                  `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OTPCodeResponse) Reset() {
+	*x = OTPCodeResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTPCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTPCodeResponse) ProtoMessage() {}
+
+func (x *OTPCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTPCodeResponse.ProtoReflect.Descriptor instead.
+func (*OTPCodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *OTPCodeResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OTPCodeResponse) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *OTPCodeResponse) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+type SSHKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSHKeyRequest) Reset() {
+	*x = SSHKeyRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSHKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHKeyRequest) ProtoMessage() {}
+
+func (x *SSHKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHKeyRequest.ProtoReflect.Descriptor instead.
+func (*SSHKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SSHKeyRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type SSHKeyResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	PublicKey      string                 `protobuf:"bytes,3,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Fingerprint    string                 `protobuf:"bytes,4,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	PrivateKey     string                 `protobuf:"bytes,5,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	Placement      *Placement             `protobuf:"bytes,6,opt,name=placement,proto3" json:"placement,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=lastAccessedAt,proto3" json:"lastAccessedAt,omitempty"`
+	AccessCount    int64                  `protobuf:"varint,10,opt,name=accessCount,proto3" json:"accessCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SSHKeyResponse) Reset() {
+	*x = SSHKeyResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSHKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHKeyResponse) ProtoMessage() {}
+
+func (x *SSHKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHKeyResponse.ProtoReflect.Descriptor instead.
+func (*SSHKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SSHKeyResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SSHKeyResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SSHKeyResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SSHKeyResponse) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *SSHKeyResponse) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *SSHKeyResponse) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *SSHKeyResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SSHKeyResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SSHKeyResponse) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *SSHKeyResponse) GetAccessCount() int64 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+type SSHKeyShortResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastAccessedAt,proto3" json:"lastAccessedAt,omitempty"`
+	AccessCount    int64                  `protobuf:"varint,6,opt,name=accessCount,proto3" json:"accessCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SSHKeyShortResponse) Reset() {
+	*x = SSHKeyShortResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSHKeyShortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHKeyShortResponse) ProtoMessage() {}
+
+func (x *SSHKeyShortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHKeyShortResponse.ProtoReflect.Descriptor instead.
+func (*SSHKeyShortResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SSHKeyShortResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SSHKeyShortResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SSHKeyShortResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SSHKeyShortResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SSHKeyShortResponse) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *SSHKeyShortResponse) GetAccessCount() int64 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+type SSHKeyListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*SSHKeyShortResponse `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSHKeyListResponse) Reset() {
+	*x = SSHKeyListResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSHKeyListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHKeyListResponse) ProtoMessage() {}
+
+func (x *SSHKeyListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHKeyListResponse.ProtoReflect.Descriptor instead.
+func (*SSHKeyListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SSHKeyListResponse) GetItems() []*SSHKeyShortResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SSHKeyListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// The client parses the key file, so passphrase-protected keys are decrypted and the private key
+// can be encrypted before it is sent by vault accounts. The public key is an authorized_keys line.
+type SSHKeyCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	PrivateKey    string                 `protobuf:"bytes,3,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	Placement     *Placement             `protobuf:"bytes,4,opt,name=placement,proto3" json:"placement,omitempty"`
+	Id            int64                  `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSHKeyCreateRequest) Reset() {
+	*x = SSHKeyCreateRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSHKeyCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHKeyCreateRequest) ProtoMessage() {}
+
+func (x *SSHKeyCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHKeyCreateRequest.ProtoReflect.Descriptor instead.
+func (*SSHKeyCreateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SSHKeyCreateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SSHKeyCreateRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SSHKeyCreateRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *SSHKeyCreateRequest) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *SSHKeyCreateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SSHKeyUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	PrivateKey    string                 `protobuf:"bytes,3,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	Placement     *Placement             `protobuf:"bytes,4,opt,name=placement,proto3" json:"placement,omitempty"`
+	ReplaceFolder bool                   `protobuf:"varint,5,opt,name=replaceFolder,proto3" json:"replaceFolder,omitempty"`
+	ReplaceTags   bool                   `protobuf:"varint,6,opt,name=replaceTags,proto3" json:"replaceTags,omitempty"`
+	Id            int64                  `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSHKeyUpdateRequest) Reset() {
+	*x = SSHKeyUpdateRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSHKeyUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHKeyUpdateRequest) ProtoMessage() {}
+
+func (x *SSHKeyUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHKeyUpdateRequest.ProtoReflect.Descriptor instead.
+func (*SSHKeyUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SSHKeyUpdateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SSHKeyUpdateRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SSHKeyUpdateRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *SSHKeyUpdateRequest) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *SSHKeyUpdateRequest) GetReplaceFolder() bool {
+	if x != nil {
+		return x.ReplaceFolder
+	}
+	return false
+}
+
+func (x *SSHKeyUpdateRequest) GetReplaceTags() bool {
+	if x != nil {
+		return x.ReplaceTags
+	}
+	return false
+}
+
+func (x *SSHKeyUpdateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SSHPublicKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Fingerprint   string                 `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSHPublicKey) Reset() {
+	*x = SSHPublicKey{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSHPublicKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHPublicKey) ProtoMessage() {}
+
+func (x *SSHPublicKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHPublicKey.ProtoReflect.Descriptor instead.
+func (*SSHPublicKey) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SSHPublicKey) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *PasswordCreateRequest) GetLogin() string {
+func (x *SSHPublicKey) GetPublicKey() string {
 	if x != nil {
-		return x.Login
+		return x.PublicKey
 	}
 	return ""
 }
 
-func (x *PasswordCreateRequest) GetPassword() string {
+func (x *SSHPublicKey) GetFingerprint() string {
 	if x != nil {
-		return x.Password
+		return x.Fingerprint
 	}
 	return ""
 }
 
-type PasswordUpdateRequest struct {
+type SSHPublicKeysResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Login         string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Keys          []*SSHPublicKey        `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PasswordUpdateRequest) Reset() {
-	*x = PasswordUpdateRequest{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[8]
+func (x *SSHPublicKeysResponse) Reset() {
+	*x = SSHPublicKeysResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PasswordUpdateRequest) String() string {
+func (x *SSHPublicKeysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordUpdateRequest) ProtoMessage() {}
+func (*SSHPublicKeysResponse) ProtoMessage() {}
 
-func (x *PasswordUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[8]
+func (x *SSHPublicKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -464,54 +5774,91 @@ func (x *PasswordUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordUpdateRequest.ProtoReflect.Descriptor instead.
-func (*PasswordUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SSHPublicKeysResponse.ProtoReflect.Descriptor instead.
+func (*SSHPublicKeysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *PasswordUpdateRequest) GetTitle() string {
+func (x *SSHPublicKeysResponse) GetKeys() []*SSHPublicKey {
 	if x != nil {
-		return x.Title
+		return x.Keys
 	}
-	return ""
+	return nil
 }
 
-func (x *PasswordUpdateRequest) GetLogin() string {
+type BinariesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BinariesRequest) Reset() {
+	*x = BinariesRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BinariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinariesRequest) ProtoMessage() {}
+
+func (x *BinariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[85]
 	if x != nil {
-		return x.Login
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *PasswordUpdateRequest) GetPassword() string {
+// Deprecated: Use BinariesRequest.ProtoReflect.Descriptor instead.
+func (*BinariesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *BinariesRequest) GetTitle() string {
 	if x != nil {
-		return x.Password
+		return x.Title
 	}
 	return ""
 }
 
-type CardRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BinariesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Data           []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Placement      *Placement             `protobuf:"bytes,4,opt,name=placement,proto3" json:"placement,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=lastAccessedAt,proto3" json:"lastAccessedAt,omitempty"`
+	AccessCount    int64                  `protobuf:"varint,8,opt,name=accessCount,proto3" json:"accessCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CardRequest) Reset() {
-	*x = CardRequest{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[9]
+func (x *BinariesResponse) Reset() {
+	*x = BinariesResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CardRequest) String() string {
+func (x *BinariesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CardRequest) ProtoMessage() {}
+func (*BinariesResponse) ProtoMessage() {}
 
-func (x *CardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[9]
+func (x *BinariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -522,45 +5869,94 @@ func (x *CardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CardRequest.ProtoReflect.Descriptor instead.
-func (*CardRequest) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use BinariesResponse.ProtoReflect.Descriptor instead.
+func (*BinariesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *CardRequest) GetTitle() string {
+func (x *BinariesResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BinariesResponse) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-type CardResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Bank          string                 `protobuf:"bytes,3,opt,name=bank,proto3" json:"bank,omitempty"`
-	Number        string                 `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"`
-	DataEnd       string                 `protobuf:"bytes,5,opt,name=dataEnd,proto3" json:"dataEnd,omitempty"`
-	SecretCode    string                 `protobuf:"bytes,6,opt,name=secretCode,proto3" json:"secretCode,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BinariesResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
 
-func (x *CardResponse) Reset() {
-	*x = CardResponse{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[10]
+func (x *BinariesResponse) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *BinariesResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BinariesResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *BinariesResponse) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *BinariesResponse) GetAccessCount() int64 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+type BinariesShortResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastAccessedAt,proto3" json:"lastAccessedAt,omitempty"`
+	AccessCount    int64                  `protobuf:"varint,6,opt,name=accessCount,proto3" json:"accessCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BinariesShortResponse) Reset() {
+	*x = BinariesShortResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CardResponse) String() string {
+func (x *BinariesShortResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CardResponse) ProtoMessage() {}
+func (*BinariesShortResponse) ProtoMessage() {}
 
-func (x *CardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[10]
+func (x *BinariesShortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -571,75 +5967,76 @@ func (x *CardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CardResponse.ProtoReflect.Descriptor instead.
-func (*CardResponse) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use BinariesShortResponse.ProtoReflect.Descriptor instead.
+func (*BinariesShortResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *CardResponse) GetId() int64 {
+func (x *BinariesShortResponse) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *CardResponse) GetTitle() string {
+func (x *BinariesShortResponse) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *CardResponse) GetBank() string {
+func (x *BinariesShortResponse) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Bank
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CardResponse) GetNumber() string {
+func (x *BinariesShortResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Number
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CardResponse) GetDataEnd() string {
+func (x *BinariesShortResponse) GetLastAccessedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DataEnd
+		return x.LastAccessedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CardResponse) GetSecretCode() string {
+func (x *BinariesShortResponse) GetAccessCount() int64 {
 	if x != nil {
-		return x.SecretCode
+		return x.AccessCount
 	}
-	return ""
+	return 0
 }
 
-type CardShortResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+type BinariesListResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Items         []*BinariesShortResponse `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                   `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CardShortResponse) Reset() {
-	*x = CardShortResponse{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[11]
+func (x *BinariesListResponse) Reset() {
+	*x = BinariesListResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CardShortResponse) String() string {
+func (x *BinariesListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CardShortResponse) ProtoMessage() {}
+func (*BinariesListResponse) ProtoMessage() {}
 
-func (x *CardShortResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[11]
+func (x *BinariesListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,44 +6047,50 @@ func (x *CardShortResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CardShortResponse.ProtoReflect.Descriptor instead.
-func (*CardShortResponse) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use BinariesListResponse.ProtoReflect.Descriptor instead.
+func (*BinariesListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *CardShortResponse) GetTitle() string {
+func (x *BinariesListResponse) GetItems() []*BinariesShortResponse {
 	if x != nil {
-		return x.Title
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BinariesListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
 	}
 	return ""
 }
 
-type CardCreateRequest struct {
+type BinariesCreateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Bank          string                 `protobuf:"bytes,3,opt,name=bank,proto3" json:"bank,omitempty"`
-	Number        string                 `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"`
-	DataEnd       string                 `protobuf:"bytes,5,opt,name=dataEnd,proto3" json:"dataEnd,omitempty"`
-	SecretCode    string                 `protobuf:"bytes,6,opt,name=secretCode,proto3" json:"secretCode,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Placement     *Placement             `protobuf:"bytes,3,opt,name=placement,proto3" json:"placement,omitempty"`
+	Id            int64                  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CardCreateRequest) Reset() {
-	*x = CardCreateRequest{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[12]
+func (x *BinariesCreateRequest) Reset() {
+	*x = BinariesCreateRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CardCreateRequest) String() string {
+func (x *BinariesCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CardCreateRequest) ProtoMessage() {}
+func (*BinariesCreateRequest) ProtoMessage() {}
 
-func (x *CardCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[12]
+func (x *BinariesCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,72 +6101,66 @@ func (x *CardCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CardCreateRequest.ProtoReflect.Descriptor instead.
-func (*CardCreateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use BinariesCreateRequest.ProtoReflect.Descriptor instead.
+func (*BinariesCreateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *CardCreateRequest) GetTitle() string {
+func (x *BinariesCreateRequest) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *CardCreateRequest) GetBank() string {
-	if x != nil {
-		return x.Bank
-	}
-	return ""
-}
-
-func (x *CardCreateRequest) GetNumber() string {
+func (x *BinariesCreateRequest) GetData() []byte {
 	if x != nil {
-		return x.Number
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *CardCreateRequest) GetDataEnd() string {
+func (x *BinariesCreateRequest) GetPlacement() *Placement {
 	if x != nil {
-		return x.DataEnd
+		return x.Placement
 	}
-	return ""
+	return nil
 }
 
-func (x *CardCreateRequest) GetSecretCode() string {
+func (x *BinariesCreateRequest) GetId() int64 {
 	if x != nil {
-		return x.SecretCode
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type CardUpdateRequest struct {
+type BinariesUpdateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Bank          string                 `protobuf:"bytes,3,opt,name=bank,proto3" json:"bank,omitempty"`
-	Number        string                 `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"`
-	DataEnd       string                 `protobuf:"bytes,5,opt,name=dataEnd,proto3" json:"dataEnd,omitempty"`
-	SecretCode    string                 `protobuf:"bytes,6,opt,name=secretCode,proto3" json:"secretCode,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Placement     *Placement             `protobuf:"bytes,3,opt,name=placement,proto3" json:"placement,omitempty"`
+	ReplaceFolder bool                   `protobuf:"varint,4,opt,name=replaceFolder,proto3" json:"replaceFolder,omitempty"`
+	ReplaceTags   bool                   `protobuf:"varint,5,opt,name=replaceTags,proto3" json:"replaceTags,omitempty"`
+	Id            int64                  `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CardUpdateRequest) Reset() {
-	*x = CardUpdateRequest{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[13]
+func (x *BinariesUpdateRequest) Reset() {
+	*x = BinariesUpdateRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CardUpdateRequest) String() string {
+func (x *BinariesUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CardUpdateRequest) ProtoMessage() {}
+func (*BinariesUpdateRequest) ProtoMessage() {}
 
-func (x *CardUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[13]
+func (x *BinariesUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -774,68 +6171,77 @@ func (x *CardUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CardUpdateRequest.ProtoReflect.Descriptor instead.
-func (*CardUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use BinariesUpdateRequest.ProtoReflect.Descriptor instead.
+func (*BinariesUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *CardUpdateRequest) GetTitle() string {
+func (x *BinariesUpdateRequest) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *CardUpdateRequest) GetBank() string {
+func (x *BinariesUpdateRequest) GetData() []byte {
 	if x != nil {
-		return x.Bank
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *CardUpdateRequest) GetNumber() string {
+func (x *BinariesUpdateRequest) GetPlacement() *Placement {
 	if x != nil {
-		return x.Number
+		return x.Placement
 	}
-	return ""
+	return nil
 }
 
-func (x *CardUpdateRequest) GetDataEnd() string {
+func (x *BinariesUpdateRequest) GetReplaceFolder() bool {
 	if x != nil {
-		return x.DataEnd
+		return x.ReplaceFolder
 	}
-	return ""
+	return false
 }
 
-func (x *CardUpdateRequest) GetSecretCode() string {
+func (x *BinariesUpdateRequest) GetReplaceTags() bool {
 	if x != nil {
-		return x.SecretCode
+		return x.ReplaceTags
 	}
-	return ""
+	return false
 }
 
-type BinariesRequest struct {
+func (x *BinariesUpdateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type BinaryUploadInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Placement     *Placement             `protobuf:"bytes,2,opt,name=placement,proto3" json:"placement,omitempty"`
+	Id            int64                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BinariesRequest) Reset() {
-	*x = BinariesRequest{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[14]
+func (x *BinaryUploadInfo) Reset() {
+	*x = BinaryUploadInfo{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BinariesRequest) String() string {
+func (x *BinaryUploadInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinariesRequest) ProtoMessage() {}
+func (*BinaryUploadInfo) ProtoMessage() {}
 
-func (x *BinariesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[14]
+func (x *BinaryUploadInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,42 +6252,60 @@ func (x *BinariesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinariesRequest.ProtoReflect.Descriptor instead.
-func (*BinariesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use BinaryUploadInfo.ProtoReflect.Descriptor instead.
+func (*BinaryUploadInfo) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *BinariesRequest) GetTitle() string {
+func (x *BinaryUploadInfo) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-type BinariesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+func (x *BinaryUploadInfo) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *BinaryUploadInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// An upload sends the info first, then the content in chunks, and finally its SHA-256 checksum.
+type BinaryUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*BinaryUploadRequest_Info
+	//	*BinaryUploadRequest_Chunk
+	//	*BinaryUploadRequest_Sha256
+	Payload       isBinaryUploadRequest_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BinariesResponse) Reset() {
-	*x = BinariesResponse{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[15]
+func (x *BinaryUploadRequest) Reset() {
+	*x = BinaryUploadRequest{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BinariesResponse) String() string {
+func (x *BinaryUploadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinariesResponse) ProtoMessage() {}
+func (*BinaryUploadRequest) ProtoMessage() {}
 
-func (x *BinariesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[15]
+func (x *BinaryUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -892,54 +6316,98 @@ func (x *BinariesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinariesResponse.ProtoReflect.Descriptor instead.
-func (*BinariesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use BinaryUploadRequest.ProtoReflect.Descriptor instead.
+func (*BinaryUploadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *BinariesResponse) GetId() int64 {
+func (x *BinaryUploadRequest) GetPayload() isBinaryUploadRequest_Payload {
 	if x != nil {
-		return x.Id
+		return x.Payload
 	}
-	return 0
+	return nil
 }
 
-func (x *BinariesResponse) GetTitle() string {
+func (x *BinaryUploadRequest) GetInfo() *BinaryUploadInfo {
 	if x != nil {
-		return x.Title
+		if x, ok := x.Payload.(*BinaryUploadRequest_Info); ok {
+			return x.Info
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *BinariesResponse) GetData() []byte {
+func (x *BinaryUploadRequest) GetChunk() []byte {
 	if x != nil {
-		return x.Data
+		if x, ok := x.Payload.(*BinaryUploadRequest_Chunk); ok {
+			return x.Chunk
+		}
 	}
 	return nil
 }
 
-type BinariesShortResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BinaryUploadRequest) GetSha256() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*BinaryUploadRequest_Sha256); ok {
+			return x.Sha256
+		}
+	}
+	return nil
 }
 
-func (x *BinariesShortResponse) Reset() {
-	*x = BinariesShortResponse{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[16]
+type isBinaryUploadRequest_Payload interface {
+	isBinaryUploadRequest_Payload()
+}
+
+type BinaryUploadRequest_Info struct {
+	Info *BinaryUploadInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type BinaryUploadRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+type BinaryUploadRequest_Sha256 struct {
+	Sha256 []byte `protobuf:"bytes,3,opt,name=sha256,proto3,oneof"`
+}
+
+func (*BinaryUploadRequest_Info) isBinaryUploadRequest_Payload() {}
+
+func (*BinaryUploadRequest_Chunk) isBinaryUploadRequest_Payload() {}
+
+func (*BinaryUploadRequest_Sha256) isBinaryUploadRequest_Payload() {}
+
+type BinaryDownloadInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Size           int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256         []byte                 `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Streamed       bool                   `protobuf:"varint,4,opt,name=streamed,proto3" json:"streamed,omitempty"`
+	Placement      *Placement             `protobuf:"bytes,5,opt,name=placement,proto3" json:"placement,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=lastAccessedAt,proto3" json:"lastAccessedAt,omitempty"`
+	AccessCount    int64                  `protobuf:"varint,9,opt,name=accessCount,proto3" json:"accessCount,omitempty"`
+	Id             int64                  `protobuf:"varint,10,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BinaryDownloadInfo) Reset() {
+	*x = BinaryDownloadInfo{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BinariesShortResponse) String() string {
+func (x *BinaryDownloadInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinariesShortResponse) ProtoMessage() {}
+func (*BinaryDownloadInfo) ProtoMessage() {}
 
-func (x *BinariesShortResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[16]
+func (x *BinaryDownloadInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,93 +6418,108 @@ func (x *BinariesShortResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinariesShortResponse.ProtoReflect.Descriptor instead.
-func (*BinariesShortResponse) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use BinaryDownloadInfo.ProtoReflect.Descriptor instead.
+func (*BinaryDownloadInfo) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *BinariesShortResponse) GetTitle() string {
+func (x *BinaryDownloadInfo) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-type BinariesCreateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BinaryDownloadInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
 }
 
-func (x *BinariesCreateRequest) Reset() {
-	*x = BinariesCreateRequest{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BinaryDownloadInfo) GetSha256() []byte {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
 }
 
-func (x *BinariesCreateRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BinaryDownloadInfo) GetStreamed() bool {
+	if x != nil {
+		return x.Streamed
+	}
+	return false
 }
 
-func (*BinariesCreateRequest) ProtoMessage() {}
-
-func (x *BinariesCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[17]
+func (x *BinaryDownloadInfo) GetPlacement() *Placement {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Placement
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use BinariesCreateRequest.ProtoReflect.Descriptor instead.
-func (*BinariesCreateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{17}
+func (x *BinaryDownloadInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *BinariesCreateRequest) GetTitle() string {
+func (x *BinaryDownloadInfo) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Title
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *BinariesCreateRequest) GetData() []byte {
+func (x *BinaryDownloadInfo) GetLastAccessedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Data
+		return x.LastAccessedAt
 	}
 	return nil
 }
 
-type BinariesUpdateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+func (x *BinaryDownloadInfo) GetAccessCount() int64 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+func (x *BinaryDownloadInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// A download sends the info first, then the content in chunks.
+type BinaryDownloadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*BinaryDownloadResponse_Info
+	//	*BinaryDownloadResponse_Chunk
+	Payload       isBinaryDownloadResponse_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BinariesUpdateRequest) Reset() {
-	*x = BinariesUpdateRequest{}
-	mi := &file_proto_gophkeeper_proto_msgTypes[18]
+func (x *BinaryDownloadResponse) Reset() {
+	*x = BinaryDownloadResponse{}
+	mi := &file_proto_gophkeeper_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BinariesUpdateRequest) String() string {
+func (x *BinaryDownloadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinariesUpdateRequest) ProtoMessage() {}
+func (*BinaryDownloadResponse) ProtoMessage() {}
 
-func (x *BinariesUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_gophkeeper_proto_msgTypes[18]
+func (x *BinaryDownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gophkeeper_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,60 +6530,300 @@ func (x *BinariesUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinariesUpdateRequest.ProtoReflect.Descriptor instead.
-func (*BinariesUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use BinaryDownloadResponse.ProtoReflect.Descriptor instead.
+func (*BinaryDownloadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gophkeeper_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *BinariesUpdateRequest) GetTitle() string {
+func (x *BinaryDownloadResponse) GetPayload() isBinaryDownloadResponse_Payload {
 	if x != nil {
-		return x.Title
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-func (x *BinariesUpdateRequest) GetData() []byte {
+func (x *BinaryDownloadResponse) GetInfo() *BinaryDownloadInfo {
 	if x != nil {
-		return x.Data
+		if x, ok := x.Payload.(*BinaryDownloadResponse_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *BinaryDownloadResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*BinaryDownloadResponse_Chunk); ok {
+			return x.Chunk
+		}
 	}
 	return nil
 }
 
+type isBinaryDownloadResponse_Payload interface {
+	isBinaryDownloadResponse_Payload()
+}
+
+type BinaryDownloadResponse_Info struct {
+	Info *BinaryDownloadInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type BinaryDownloadResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*BinaryDownloadResponse_Info) isBinaryDownloadResponse_Payload() {}
+
+func (*BinaryDownloadResponse_Chunk) isBinaryDownloadResponse_Payload() {}
+
 var File_proto_gophkeeper_proto protoreflect.FileDescriptor
 
 const file_proto_gophkeeper_proto_rawDesc = "" +
 	"\n" +
 	"\x16proto/gophkeeper.proto\x12\n" +
-	"gophkeeper\x1a\x1bgoogle/protobuf/empty.proto\"C\n" +
+	"gophkeeper\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x95\x01\n" +
+	"\vVaultParams\x12\x12\n" +
+	"\x04salt\x18\x01 \x01(\fR\x04salt\x12\x12\n" +
+	"\x04time\x18\x02 \x01(\rR\x04time\x12\x16\n" +
+	"\x06memory\x18\x03 \x01(\rR\x06memory\x12\x18\n" +
+	"\athreads\x18\x04 \x01(\rR\athreads\x12\x14\n" +
+	"\x05check\x18\x05 \x01(\fR\x05check\x12\x16\n" +
+	"\x06userId\x18\x06 \x01(\x03R\x06userId\"r\n" +
 	"\x0fRegisterRequest\x12\x14\n" +
 	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"(\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12-\n" +
+	"\x05vault\x18\x03 \x01(\v2\x17.gophkeeper.VaultParamsR\x05vault\"\x86\x01\n" +
 	"\x10RegisterResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"@\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\x128\n" +
+	"\texpiresAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"@\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"%\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xd0\x01\n" +
 	"\rLoginResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"'\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12-\n" +
+	"\x05vault\x18\x02 \x01(\v2\x17.gophkeeper.VaultParamsR\x05vault\x12\"\n" +
+	"\frefreshToken\x18\x03 \x01(\tR\frefreshToken\x128\n" +
+	"\texpiresAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1c\n" +
+	"\tchallenge\x18\x05 \x01(\tR\tchallenge\">\n" +
+	"\rVaultResponse\x12-\n" +
+	"\x05vault\x18\x01 \x01(\v2\x17.gophkeeper.VaultParamsR\x05vault\"O\n" +
+	"\rRecordRequest\x12(\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x14.gophkeeper.ItemKindR\x04kind\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"<\n" +
+	"\x0eRecordResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\breserved\x18\x02 \x01(\bR\breserved\"G\n" +
+	"\x13SecondFactorRequest\x12\x1c\n" +
+	"\tchallenge\x18\x01 \x01(\tR\tchallenge\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\">\n" +
+	"\x12TOTPEnrollResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x10\n" +
+	"\x03uri\x18\x02 \x01(\tR\x03uri\"%\n" +
+	"\x0fTOTPCodeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\";\n" +
+	"\x13TOTPConfirmResponse\x12$\n" +
+	"\rrecoveryCodes\x18\x01 \x03(\tR\rrecoveryCodes\",\n" +
+	"\x16CertificateBindRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\".\n" +
+	"\x12CertificateRequest\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\"M\n" +
+	"\x13CertificateResponse\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\x12\x1c\n" +
+	"\tsessionId\x18\x02 \x01(\x03R\tsessionId\"\xb9\x01\n" +
+	"\vCertificate\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\x12\x1c\n" +
+	"\tsessionId\x18\x02 \x01(\x03R\tsessionId\x128\n" +
+	"\tcreatedAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\texpiresAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"H\n" +
+	"\x17CertificateListResponse\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.gophkeeper.CertificateR\x05items\"4\n" +
+	"\x0eRefreshRequest\x12\"\n" +
+	"\frefreshToken\x18\x01 \x01(\tR\frefreshToken\"\x85\x01\n" +
+	"\x0fRefreshResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\frefreshToken\x18\x02 \x01(\tR\frefreshToken\x128\n" +
+	"\texpiresAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x9b\x02\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
+	"\tuserAgent\x18\x02 \x01(\tR\tuserAgent\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x128\n" +
+	"\tcreatedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12:\n" +
+	"\n" +
+	"lastUsedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x128\n" +
+	"\texpiresAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\acurrent\x18\a \x01(\bR\acurrent\"@\n" +
+	"\x13SessionListResponse\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.gophkeeper.SessionR\x05items\"&\n" +
+	"\x14RevokeSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xe4\x02\n" +
+	"\vListRequest\x12\x1a\n" +
+	"\bpageSize\x18\x01 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12 \n" +
+	"\vtitlePrefix\x18\x03 \x01(\tR\vtitlePrefix\x12-\n" +
+	"\x06sortBy\x18\x04 \x01(\x0e2\x15.gophkeeper.SortFieldR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x05 \x01(\bR\n" +
+	"descending\x12\x16\n" +
+	"\x06folder\x18\x06 \x01(\tR\x06folder\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12@\n" +
+	"\rupdatedBefore\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rupdatedBefore\x12B\n" +
+	"\x0eaccessedBefore\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0eaccessedBefore\"7\n" +
+	"\tPlacement\x12\x16\n" +
+	"\x06folder\x18\x01 \x01(\tR\x06folder\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"#\n" +
+	"\rFolderRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\"S\n" +
+	"\x11FolderMoveRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
+	"\x06parent\x18\x02 \x01(\tR\x06parent\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"*\n" +
+	"\x12FolderListResponse\x12\x14\n" +
+	"\x05paths\x18\x01 \x03(\tR\x05paths\"\xcb\x01\n" +
+	"\fPlaceRequest\x12(\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x14.gophkeeper.ItemKindR\x04kind\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x123\n" +
+	"\tplacement\x18\x03 \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x12$\n" +
+	"\rreplaceFolder\x18\x04 \x01(\bR\rreplaceFolder\x12 \n" +
+	"\vreplaceTags\x18\x05 \x01(\bR\vreplaceTags\"-\n" +
+	"\x17HistoryRetentionRequest\x12\x12\n" +
+	"\x04keep\x18\x01 \x01(\x05R\x04keep\"&\n" +
+	"\x0eHistoryRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\"|\n" +
+	"\bRevision\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x05R\brevision\x12\x18\n" +
+	"\adeleted\x18\x02 \x01(\bR\adeleted\x12:\n" +
+	"\n" +
+	"archivedAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\"=\n" +
+	"\x0fHistoryResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.gophkeeper.RevisionR\x05items\"B\n" +
+	"\x0eRestoreRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\"Q\n" +
+	"\rRenameRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
+	"\bnewTitle\x18\x03 \x01(\tR\bnewTitle\"\xbb\x01\n" +
+	"\tTrashItem\x12(\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x14.gophkeeper.ItemKindR\x04kind\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x128\n" +
+	"\tdeletedAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x124\n" +
+	"\apurgeAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\apurgeAt\"<\n" +
+	"\x10TrashListRequest\x12(\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x14.gophkeeper.ItemKindR\x04kind\"@\n" +
+	"\x11TrashListResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.gophkeeper.TrashItemR\x05items\"N\n" +
+	"\fTrashRequest\x12(\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x14.gophkeeper.ItemKindR\x04kind\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\",\n" +
+	"\x14TrashRestoreResponse\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\",\n" +
+	"\x12TrashPurgeResponse\x12\x16\n" +
+	"\x06purged\x18\x01 \x01(\x05R\x06purged\"'\n" +
 	"\x0fPasswordRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\"j\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\"K\n" +
+	"\vCustomField\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\xcc\x03\n" +
 	"\x10PasswordResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
 	"\x05login\x18\x03 \x01(\tR\x05login\x12\x1a\n" +
-	"\bpassword\x18\x04 \x01(\tR\bpassword\"-\n" +
-	"\x15PasswordShortResponse\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\"_\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12/\n" +
+	"\x06fields\x18\x05 \x03(\v2\x17.gophkeeper.CustomFieldR\x06fields\x123\n" +
+	"\tplacement\x18\x06 \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x128\n" +
+	"\tcreatedAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0elastAccessedAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12 \n" +
+	"\vaccessCount\x18\n" +
+	" \x01(\x03R\vaccessCount\x12 \n" +
+	"\vcompromised\x18\v \x01(\bR\vcompromised\"\x97\x02\n" +
+	"\x15PasswordShortResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x128\n" +
+	"\tcreatedAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0elastAccessedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12 \n" +
+	"\vaccessCount\x18\x06 \x01(\x03R\vaccessCount\"o\n" +
+	"\x14PasswordListResponse\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.gophkeeper.PasswordShortResponseR\x05items\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xbb\x01\n" +
+	"\x0fGenerateRequest\x12\x16\n" +
+	"\x06preset\x18\x01 \x01(\tR\x06preset\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\x05R\x06length\x12\x18\n" +
+	"\aclasses\x18\x03 \x03(\tR\aclasses\x12*\n" +
+	"\x10excludeAmbiguous\x18\x04 \x01(\bR\x10excludeAmbiguous\x12\x14\n" +
+	"\x05words\x18\x05 \x01(\x05R\x05words\x12\x1c\n" +
+	"\tseparator\x18\x06 \x01(\tR\tseparator\"P\n" +
+	"\x10GenerateResponse\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12 \n" +
+	"\ventropyBits\x18\x02 \x01(\x01R\ventropyBits\"J\n" +
+	"\fAuditRequest\x12\x1a\n" +
+	"\bminScore\x18\x01 \x01(\x05R\bminScore\x12\x1e\n" +
+	"\n" +
+	"maxAgeDays\x18\x02 \x01(\x05R\n" +
+	"maxAgeDays\"f\n" +
+	"\x10PasswordStrength\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x05R\x05score\x12\"\n" +
+	"\fguessesLog10\x18\x02 \x01(\x01R\fguessesLog10\x12\x18\n" +
+	"\awarning\x18\x03 \x01(\tR\awarning\"^\n" +
+	"\fWeakPassword\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x128\n" +
+	"\bstrength\x18\x02 \x01(\v2\x1c.gophkeeper.PasswordStrengthR\bstrength\"(\n" +
+	"\x0eReusedPassword\x12\x16\n" +
+	"\x06titles\x18\x01 \x03(\tR\x06titles\"y\n" +
+	"\rStalePassword\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x128\n" +
+	"\tupdatedAt\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aageDays\x18\x03 \x01(\x05R\aageDays\">\n" +
+	"\x10BreachedPassword\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\xae\x02\n" +
+	"\rAuditResponse\x12\x1a\n" +
+	"\bminScore\x18\x01 \x01(\x05R\bminScore\x12\x1e\n" +
+	"\n" +
+	"maxAgeDays\x18\x02 \x01(\x05R\n" +
+	"maxAgeDays\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12,\n" +
+	"\x04weak\x18\x04 \x03(\v2\x18.gophkeeper.WeakPasswordR\x04weak\x122\n" +
+	"\x06reused\x18\x05 \x03(\v2\x1a.gophkeeper.ReusedPasswordR\x06reused\x12/\n" +
+	"\x05stale\x18\x06 \x03(\v2\x19.gophkeeper.StalePasswordR\x05stale\x128\n" +
+	"\bbreached\x18\a \x03(\v2\x1c.gophkeeper.BreachedPasswordR\bbreached\"\x88\x01\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x128\n" +
+	"\tupdatedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"D\n" +
+	"\x14AuditEntriesResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.gophkeeper.AuditEntryR\x05items\"\x8e\x02\n" +
 	"\x15PasswordCreateRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x14\n" +
 	"\x05login\x18\x02 \x01(\tR\x05login\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"_\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12/\n" +
+	"\x06fields\x18\x04 \x03(\v2\x17.gophkeeper.CustomFieldR\x06fields\x123\n" +
+	"\tplacement\x18\x05 \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x127\n" +
+	"\bgenerate\x18\x06 \x01(\v2\x1b.gophkeeper.GenerateRequestR\bgenerate\x12\x0e\n" +
+	"\x02id\x18\a \x01(\x03R\x02id\"\xfc\x02\n" +
 	"\x15PasswordUpdateRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x14\n" +
 	"\x05login\x18\x02 \x01(\tR\x05login\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"#\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12/\n" +
+	"\x06fields\x18\x04 \x03(\v2\x17.gophkeeper.CustomFieldR\x06fields\x12$\n" +
+	"\rreplaceFields\x18\x05 \x01(\bR\rreplaceFields\x123\n" +
+	"\tplacement\x18\x06 \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x12$\n" +
+	"\rreplaceFolder\x18\a \x01(\bR\rreplaceFolder\x12 \n" +
+	"\vreplaceTags\x18\b \x01(\bR\vreplaceTags\x127\n" +
+	"\bgenerate\x18\t \x01(\v2\x1b.gophkeeper.GenerateRequestR\bgenerate\x12\x0e\n" +
+	"\x02id\x18\n" +
+	" \x01(\x03R\x02id\"#\n" +
 	"\vCardRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\"\x9a\x01\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\"\xa5\x04\n" +
 	"\fCardResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -1109,9 +6832,29 @@ const file_proto_gophkeeper_proto_rawDesc = "" +
 	"\adataEnd\x18\x05 \x01(\tR\adataEnd\x12\x1e\n" +
 	"\n" +
 	"secretCode\x18\x06 \x01(\tR\n" +
-	"secretCode\")\n" +
-	"\x11CardShortResponse\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\"\x8f\x01\n" +
+	"secretCode\x123\n" +
+	"\tplacement\x18\a \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x128\n" +
+	"\tcreatedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0elastAccessedAt\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12 \n" +
+	"\vaccessCount\x18\v \x01(\x03R\vaccessCount\x12\x14\n" +
+	"\x05brand\x18\f \x01(\tR\x05brand\x12 \n" +
+	"\vrevealCount\x18\r \x01(\x03R\vrevealCount\x12B\n" +
+	"\x0elastRevealedAt\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x0elastRevealedAt\"\xa9\x02\n" +
+	"\x11CardShortResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x128\n" +
+	"\tcreatedAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0elastAccessedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12 \n" +
+	"\vaccessCount\x18\x06 \x01(\x03R\vaccessCount\x12\x14\n" +
+	"\x05brand\x18\a \x01(\tR\x05brand\"g\n" +
+	"\x10CardListResponse\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.gophkeeper.CardShortResponseR\x05items\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xea\x01\n" +
 	"\x11CardCreateRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
 	"\x04bank\x18\x03 \x01(\tR\x04bank\x12\x16\n" +
@@ -1119,7 +6862,10 @@ const file_proto_gophkeeper_proto_rawDesc = "" +
 	"\adataEnd\x18\x05 \x01(\tR\adataEnd\x12\x1e\n" +
 	"\n" +
 	"secretCode\x18\x06 \x01(\tR\n" +
-	"secretCode\"\x8f\x01\n" +
+	"secretCode\x123\n" +
+	"\tplacement\x18\a \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x12\x14\n" +
+	"\x05brand\x18\b \x01(\tR\x05brand\x12\x0e\n" +
+	"\x02id\x18\t \x01(\x03R\x02id\"\xb2\x02\n" +
 	"\x11CardUpdateRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
 	"\x04bank\x18\x03 \x01(\tR\x04bank\x12\x16\n" +
@@ -1127,39 +6873,325 @@ const file_proto_gophkeeper_proto_rawDesc = "" +
 	"\adataEnd\x18\x05 \x01(\tR\adataEnd\x12\x1e\n" +
 	"\n" +
 	"secretCode\x18\x06 \x01(\tR\n" +
-	"secretCode\"'\n" +
+	"secretCode\x123\n" +
+	"\tplacement\x18\a \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x12$\n" +
+	"\rreplaceFolder\x18\b \x01(\bR\rreplaceFolder\x12 \n" +
+	"\vreplaceTags\x18\t \x01(\bR\vreplaceTags\x12\x14\n" +
+	"\x05brand\x18\n" +
+	" \x01(\tR\x05brand\x12\x0e\n" +
+	"\x02id\x18\v \x01(\x03R\x02id\"#\n" +
+	"\vNoteRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\"\xd7\x02\n" +
+	"\fNoteResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x123\n" +
+	"\tplacement\x18\x04 \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x128\n" +
+	"\tcreatedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0elastAccessedAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12 \n" +
+	"\vaccessCount\x18\b \x01(\x03R\vaccessCount\"\x93\x02\n" +
+	"\x11NoteShortResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x128\n" +
+	"\tcreatedAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0elastAccessedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12 \n" +
+	"\vaccessCount\x18\x06 \x01(\x03R\vaccessCount\"g\n" +
+	"\x10NoteListResponse\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.gophkeeper.NoteShortResponseR\x05items\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\x82\x01\n" +
+	"\x11NoteCreateRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x123\n" +
+	"\tplacement\x18\x03 \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\x03R\x02id\"\xca\x01\n" +
+	"\x11NoteUpdateRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x123\n" +
+	"\tplacement\x18\x03 \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x12$\n" +
+	"\rreplaceFolder\x18\x04 \x01(\bR\rreplaceFolder\x12 \n" +
+	"\vreplaceTags\x18\x05 \x01(\bR\vreplaceTags\x12\x0e\n" +
+	"\x02id\x18\x06 \x01(\x03R\x02id\"\"\n" +
+	"\n" +
+	"OTPRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\"\xf6\x03\n" +
+	"\vOTPResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06issuer\x18\x03 \x01(\tR\x06issuer\x12\x18\n" +
+	"\aaccount\x18\x04 \x01(\tR\aaccount\x12\x16\n" +
+	"\x06secret\x18\x05 \x01(\tR\x06secret\x12\x1c\n" +
+	"\talgorithm\x18\x06 \x01(\tR\talgorithm\x12\x16\n" +
+	"\x06digits\x18\a \x01(\x05R\x06digits\x12\x16\n" +
+	"\x06period\x18\b \x01(\x05R\x06period\x12\x1a\n" +
+	"\bpassword\x18\t \x01(\tR\bpassword\x123\n" +
+	"\tplacement\x18\n" +
+	" \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x128\n" +
+	"\tcreatedAt\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0elastAccessedAt\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12 \n" +
+	"\vaccessCount\x18\x0e \x01(\x03R\vaccessCount\"\x92\x02\n" +
+	"\x10OTPShortResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x128\n" +
+	"\tcreatedAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0elastAccessedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12 \n" +
+	"\vaccessCount\x18\x06 \x01(\x03R\vaccessCount\"e\n" +
+	"\x0fOTPListResponse\x122\n" +
+	"\x05items\x18\x01 \x03(\v2\x1c.gophkeeper.OTPShortResponseR\x05items\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xa1\x02\n" +
+	"\x10OTPCreateRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x16\n" +
+	"\x06issuer\x18\x02 \x01(\tR\x06issuer\x12\x18\n" +
+	"\aaccount\x18\x03 \x01(\tR\aaccount\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x1c\n" +
+	"\talgorithm\x18\x05 \x01(\tR\talgorithm\x12\x16\n" +
+	"\x06digits\x18\x06 \x01(\x05R\x06digits\x12\x16\n" +
+	"\x06period\x18\a \x01(\x05R\x06period\x12\x1a\n" +
+	"\bpassword\x18\b \x01(\tR\bpassword\x123\n" +
+	"\tplacement\x18\t \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x12\x0e\n" +
+	"\x02id\x18\n" +
+	" \x01(\x03R\x02id\"\xe9\x02\n" +
+	"\x10OTPUpdateRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x16\n" +
+	"\x06issuer\x18\x02 \x01(\tR\x06issuer\x12\x18\n" +
+	"\aaccount\x18\x03 \x01(\tR\aaccount\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x1c\n" +
+	"\talgorithm\x18\x05 \x01(\tR\talgorithm\x12\x16\n" +
+	"\x06digits\x18\x06 \x01(\x05R\x06digits\x12\x16\n" +
+	"\x06period\x18\a \x01(\x05R\x06period\x12\x1a\n" +
+	"\bpassword\x18\b \x01(\tR\bpassword\x123\n" +
+	"\tplacement\x18\t \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x12$\n" +
+	"\rreplaceFolder\x18\n" +
+	" \x01(\bR\rreplaceFolder\x12 \n" +
+	"\vreplaceTags\x18\v \x01(\bR\vreplaceTags\x12\x0e\n" +
+	"\x02id\x18\f \x01(\x03R\x02id\"[\n" +
+	"\x0fOTPCodeResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1c\n" +
+	"\tremaining\x18\x02 \x01(\x05R\tremaining\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\x05R\x06period\"%\n" +
+	"\rSSHKeyRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\"\xa5\x03\n" +
+	"\x0eSSHKeyResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1c\n" +
+	"\tpublicKey\x18\x03 \x01(\tR\tpublicKey\x12 \n" +
+	"\vfingerprint\x18\x04 \x01(\tR\vfingerprint\x12\x1e\n" +
+	"\n" +
+	"privateKey\x18\x05 \x01(\tR\n" +
+	"privateKey\x123\n" +
+	"\tplacement\x18\x06 \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x128\n" +
+	"\tcreatedAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0elastAccessedAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12 \n" +
+	"\vaccessCount\x18\n" +
+	" \x01(\x03R\vaccessCount\"\x95\x02\n" +
+	"\x13SSHKeyShortResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x128\n" +
+	"\tcreatedAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0elastAccessedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12 \n" +
+	"\vaccessCount\x18\x06 \x01(\x03R\vaccessCount\"k\n" +
+	"\x12SSHKeyListResponse\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.gophkeeper.SSHKeyShortResponseR\x05items\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xae\x01\n" +
+	"\x13SSHKeyCreateRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1c\n" +
+	"\tpublicKey\x18\x02 \x01(\tR\tpublicKey\x12\x1e\n" +
+	"\n" +
+	"privateKey\x18\x03 \x01(\tR\n" +
+	"privateKey\x123\n" +
+	"\tplacement\x18\x04 \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\x03R\x02id\"\xf6\x01\n" +
+	"\x13SSHKeyUpdateRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1c\n" +
+	"\tpublicKey\x18\x02 \x01(\tR\tpublicKey\x12\x1e\n" +
+	"\n" +
+	"privateKey\x18\x03 \x01(\tR\n" +
+	"privateKey\x123\n" +
+	"\tplacement\x18\x04 \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x12$\n" +
+	"\rreplaceFolder\x18\x05 \x01(\bR\rreplaceFolder\x12 \n" +
+	"\vreplaceTags\x18\x06 \x01(\bR\vreplaceTags\x12\x0e\n" +
+	"\x02id\x18\a \x01(\x03R\x02id\"d\n" +
+	"\fSSHPublicKey\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1c\n" +
+	"\tpublicKey\x18\x02 \x01(\tR\tpublicKey\x12 \n" +
+	"\vfingerprint\x18\x03 \x01(\tR\vfingerprint\"E\n" +
+	"\x15SSHPublicKeysResponse\x12,\n" +
+	"\x04keys\x18\x01 \x03(\v2\x18.gophkeeper.SSHPublicKeyR\x04keys\"'\n" +
 	"\x0fBinariesRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\"L\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\"\xdb\x02\n" +
 	"\x10BinariesResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
-	"\x04data\x18\x03 \x01(\fR\x04data\"-\n" +
-	"\x15BinariesShortResponse\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\"A\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x123\n" +
+	"\tplacement\x18\x04 \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x128\n" +
+	"\tcreatedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0elastAccessedAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12 \n" +
+	"\vaccessCount\x18\b \x01(\x03R\vaccessCount\"\x97\x02\n" +
+	"\x15BinariesShortResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x128\n" +
+	"\tcreatedAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0elastAccessedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12 \n" +
+	"\vaccessCount\x18\x06 \x01(\x03R\vaccessCount\"o\n" +
+	"\x14BinariesListResponse\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.gophkeeper.BinariesShortResponseR\x05items\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\x86\x01\n" +
 	"\x15BinariesCreateRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
-	"\x04data\x18\x02 \x01(\fR\x04data\"A\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x123\n" +
+	"\tplacement\x18\x03 \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\x03R\x02id\"\xce\x01\n" +
 	"\x15BinariesUpdateRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
-	"\x04data\x18\x02 \x01(\fR\x04data2\x8c\x01\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x123\n" +
+	"\tplacement\x18\x03 \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x12$\n" +
+	"\rreplaceFolder\x18\x04 \x01(\bR\rreplaceFolder\x12 \n" +
+	"\vreplaceTags\x18\x05 \x01(\bR\vreplaceTags\x12\x0e\n" +
+	"\x02id\x18\x06 \x01(\x03R\x02id\"m\n" +
+	"\x10BinaryUploadInfo\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x123\n" +
+	"\tplacement\x18\x02 \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x03R\x02id\"\x86\x01\n" +
+	"\x13BinaryUploadRequest\x122\n" +
+	"\x04info\x18\x01 \x01(\v2\x1c.gophkeeper.BinaryUploadInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunk\x12\x18\n" +
+	"\x06sha256\x18\x03 \x01(\fH\x00R\x06sha256B\t\n" +
+	"\apayload\"\x91\x03\n" +
+	"\x12BinaryDownloadInfo\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\x03 \x01(\fR\x06sha256\x12\x1a\n" +
+	"\bstreamed\x18\x04 \x01(\bR\bstreamed\x123\n" +
+	"\tplacement\x18\x05 \x01(\v2\x15.gophkeeper.PlacementR\tplacement\x128\n" +
+	"\tcreatedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0elastAccessedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12 \n" +
+	"\vaccessCount\x18\t \x01(\x03R\vaccessCount\x12\x0e\n" +
+	"\x02id\x18\n" +
+	" \x01(\x03R\x02id\"q\n" +
+	"\x16BinaryDownloadResponse\x124\n" +
+	"\x04info\x18\x01 \x01(\v2\x1e.gophkeeper.BinaryDownloadInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload*g\n" +
+	"\tSortField\x12\x14\n" +
+	"\x10SORT_FIELD_TITLE\x10\x00\x12\x13\n" +
+	"\x0fSORT_FIELD_DATE\x10\x01\x12\x16\n" +
+	"\x12SORT_FIELD_UPDATED\x10\x02\x12\x17\n" +
+	"\x13SORT_FIELD_ACCESSED\x10\x03*\xa5\x01\n" +
+	"\bItemKind\x12\x19\n" +
+	"\x15ITEM_KIND_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ITEM_KIND_PASSWORD\x10\x01\x12\x12\n" +
+	"\x0eITEM_KIND_CARD\x10\x02\x12\x14\n" +
+	"\x10ITEM_KIND_BINARY\x10\x03\x12\x12\n" +
+	"\x0eITEM_KIND_NOTE\x10\x04\x12\x11\n" +
+	"\rITEM_KIND_OTP\x10\x05\x12\x15\n" +
+	"\x11ITEM_KIND_SSH_KEY\x10\x062\x99\t\n" +
 	"\x05Users\x12E\n" +
 	"\bRegister\x12\x1b.gophkeeper.RegisterRequest\x1a\x1c.gophkeeper.RegisterResponse\x12<\n" +
-	"\x05Login\x12\x18.gophkeeper.LoginRequest\x1a\x19.gophkeeper.LoginResponse2\xa9\x02\n" +
+	"\x05Login\x12\x18.gophkeeper.LoginRequest\x1a\x19.gophkeeper.LoginResponse\x12:\n" +
+	"\x05Vault\x12\x16.google.protobuf.Empty\x1a\x19.gophkeeper.VaultResponse\x12A\n" +
+	"\bRecordID\x12\x19.gophkeeper.RecordRequest\x1a\x1a.gophkeeper.RecordResponse\x12B\n" +
+	"\aRefresh\x12\x1a.gophkeeper.RefreshRequest\x1a\x1b.gophkeeper.RefreshResponse\x128\n" +
+	"\x06Logout\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\fListSessions\x12\x16.google.protobuf.Empty\x1a\x1f.gophkeeper.SessionListResponse\x12I\n" +
+	"\rRevokeSession\x12 .gophkeeper.RevokeSessionRequest\x1a\x16.google.protobuf.Empty\x12P\n" +
+	"\x12VerifySecondFactor\x12\x1f.gophkeeper.SecondFactorRequest\x1a\x19.gophkeeper.LoginResponse\x12D\n" +
+	"\n" +
+	"EnrollTOTP\x12\x16.google.protobuf.Empty\x1a\x1e.gophkeeper.TOTPEnrollResponse\x12K\n" +
+	"\vConfirmTOTP\x12\x1b.gophkeeper.TOTPCodeRequest\x1a\x1f.gophkeeper.TOTPConfirmResponse\x12B\n" +
+	"\vDisableTOTP\x12\x1b.gophkeeper.TOTPCodeRequest\x1a\x16.google.protobuf.Empty\x12V\n" +
+	"\x0fBindCertificate\x12\".gophkeeper.CertificateBindRequest\x1a\x1f.gophkeeper.CertificateResponse\x12T\n" +
+	"\x11UnbindCertificate\x12\x1e.gophkeeper.CertificateRequest\x1a\x1f.gophkeeper.CertificateResponse\x12O\n" +
+	"\x10ListCertificates\x12\x16.google.protobuf.Empty\x1a#.gophkeeper.CertificateListResponse\x12R\n" +
+	"\x13SetHistoryRetention\x12#.gophkeeper.HistoryRetentionRequest\x1a\x16.google.protobuf.Empty2\x91\x06\n" +
 	"\tPasswords\x12@\n" +
 	"\x03Get\x12\x1b.gophkeeper.PasswordRequest\x1a\x1c.gophkeeper.PasswordResponse\x12K\n" +
 	"\x03Add\x12!.gophkeeper.PasswordCreateRequest\x1a!.gophkeeper.PasswordShortResponse\x12N\n" +
 	"\x06Update\x12!.gophkeeper.PasswordUpdateRequest\x1a!.gophkeeper.PasswordShortResponse\x12=\n" +
-	"\x06Delete\x12\x1b.gophkeeper.PasswordRequest\x1a\x16.google.protobuf.Empty2\x89\x02\n" +
+	"\x06Delete\x12\x1b.gophkeeper.PasswordRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
+	"\x04List\x12\x17.gophkeeper.ListRequest\x1a .gophkeeper.PasswordListResponse\x12B\n" +
+	"\aHistory\x12\x1a.gophkeeper.HistoryRequest\x1a\x1b.gophkeeper.HistoryResponse\x12H\n" +
+	"\aRestore\x12\x1a.gophkeeper.RestoreRequest\x1a!.gophkeeper.PasswordShortResponse\x12F\n" +
+	"\x06Rename\x12\x19.gophkeeper.RenameRequest\x1a!.gophkeeper.PasswordShortResponse\x12<\n" +
+	"\x05Audit\x12\x18.gophkeeper.AuditRequest\x1a\x19.gophkeeper.AuditResponse\x12H\n" +
+	"\fAuditEntries\x12\x16.google.protobuf.Empty\x1a .gophkeeper.AuditEntriesResponse\x12E\n" +
+	"\bGenerate\x12\x1b.gophkeeper.GenerateRequest\x1a\x1c.gophkeeper.GenerateResponse2\xd3\x04\n" +
 	"\x05Cards\x128\n" +
 	"\x03Get\x12\x17.gophkeeper.CardRequest\x1a\x18.gophkeeper.CardResponse\x12C\n" +
 	"\x03Add\x12\x1d.gophkeeper.CardCreateRequest\x1a\x1d.gophkeeper.CardShortResponse\x12F\n" +
 	"\x06Update\x12\x1d.gophkeeper.CardUpdateRequest\x1a\x1d.gophkeeper.CardShortResponse\x129\n" +
-	"\x06Delete\x12\x17.gophkeeper.CardRequest\x1a\x16.google.protobuf.Empty2\xa8\x02\n" +
+	"\x06Delete\x12\x17.gophkeeper.CardRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\x04List\x12\x17.gophkeeper.ListRequest\x1a\x1c.gophkeeper.CardListResponse\x12B\n" +
+	"\aHistory\x12\x1a.gophkeeper.HistoryRequest\x1a\x1b.gophkeeper.HistoryResponse\x12D\n" +
+	"\aRestore\x12\x1a.gophkeeper.RestoreRequest\x1a\x1d.gophkeeper.CardShortResponse\x12B\n" +
+	"\x06Rename\x12\x19.gophkeeper.RenameRequest\x1a\x1d.gophkeeper.CardShortResponse\x12;\n" +
+	"\x06Reveal\x12\x17.gophkeeper.CardRequest\x1a\x18.gophkeeper.CardResponse2\xe0\x05\n" +
 	"\bBinaries\x12@\n" +
 	"\x03Get\x12\x1b.gophkeeper.BinariesRequest\x1a\x1c.gophkeeper.BinariesResponse\x12K\n" +
 	"\x03Add\x12!.gophkeeper.BinariesCreateRequest\x1a!.gophkeeper.BinariesShortResponse\x12N\n" +
 	"\x06Update\x12!.gophkeeper.BinariesUpdateRequest\x1a!.gophkeeper.BinariesShortResponse\x12=\n" +
-	"\x06Delete\x12\x1b.gophkeeper.BinariesRequest\x1a\x16.google.protobuf.EmptyB)Z'github.com/MultikPatin/gophkeeper/protob\x06proto3"
+	"\x06Delete\x12\x1b.gophkeeper.BinariesRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
+	"\x04List\x12\x17.gophkeeper.ListRequest\x1a .gophkeeper.BinariesListResponse\x12N\n" +
+	"\x06Upload\x12\x1f.gophkeeper.BinaryUploadRequest\x1a!.gophkeeper.BinariesShortResponse(\x01\x12M\n" +
+	"\bDownload\x12\x1b.gophkeeper.BinariesRequest\x1a\".gophkeeper.BinaryDownloadResponse0\x01\x12B\n" +
+	"\aHistory\x12\x1a.gophkeeper.HistoryRequest\x1a\x1b.gophkeeper.HistoryResponse\x12H\n" +
+	"\aRestore\x12\x1a.gophkeeper.RestoreRequest\x1a!.gophkeeper.BinariesShortResponse\x12F\n" +
+	"\x06Rename\x12\x19.gophkeeper.RenameRequest\x1a!.gophkeeper.BinariesShortResponse2\x96\x04\n" +
+	"\x05Notes\x128\n" +
+	"\x03Get\x12\x17.gophkeeper.NoteRequest\x1a\x18.gophkeeper.NoteResponse\x12C\n" +
+	"\x03Add\x12\x1d.gophkeeper.NoteCreateRequest\x1a\x1d.gophkeeper.NoteShortResponse\x12F\n" +
+	"\x06Update\x12\x1d.gophkeeper.NoteUpdateRequest\x1a\x1d.gophkeeper.NoteShortResponse\x129\n" +
+	"\x06Delete\x12\x17.gophkeeper.NoteRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\x04List\x12\x17.gophkeeper.ListRequest\x1a\x1c.gophkeeper.NoteListResponse\x12B\n" +
+	"\aHistory\x12\x1a.gophkeeper.HistoryRequest\x1a\x1b.gophkeeper.HistoryResponse\x12D\n" +
+	"\aRestore\x12\x1a.gophkeeper.RestoreRequest\x1a\x1d.gophkeeper.NoteShortResponse\x12B\n" +
+	"\x06Rename\x12\x19.gophkeeper.RenameRequest\x1a\x1d.gophkeeper.NoteShortResponse2\xcf\x04\n" +
+	"\x03OTP\x126\n" +
+	"\x03Get\x12\x16.gophkeeper.OTPRequest\x1a\x17.gophkeeper.OTPResponse\x12A\n" +
+	"\x03Add\x12\x1c.gophkeeper.OTPCreateRequest\x1a\x1c.gophkeeper.OTPShortResponse\x12D\n" +
+	"\x06Update\x12\x1c.gophkeeper.OTPUpdateRequest\x1a\x1c.gophkeeper.OTPShortResponse\x128\n" +
+	"\x06Delete\x12\x16.gophkeeper.OTPRequest\x1a\x16.google.protobuf.Empty\x12<\n" +
+	"\x04List\x12\x17.gophkeeper.ListRequest\x1a\x1b.gophkeeper.OTPListResponse\x12B\n" +
+	"\aHistory\x12\x1a.gophkeeper.HistoryRequest\x1a\x1b.gophkeeper.HistoryResponse\x12C\n" +
+	"\aRestore\x12\x1a.gophkeeper.RestoreRequest\x1a\x1c.gophkeeper.OTPShortResponse\x12A\n" +
+	"\x06Rename\x12\x19.gophkeeper.RenameRequest\x1a\x1c.gophkeeper.OTPShortResponse\x12C\n" +
+	"\fGenerateCode\x12\x16.gophkeeper.OTPRequest\x1a\x1b.gophkeeper.OTPCodeResponse2\xf5\x04\n" +
+	"\aSSHKeys\x12<\n" +
+	"\x03Get\x12\x19.gophkeeper.SSHKeyRequest\x1a\x1a.gophkeeper.SSHKeyResponse\x12G\n" +
+	"\x03Add\x12\x1f.gophkeeper.SSHKeyCreateRequest\x1a\x1f.gophkeeper.SSHKeyShortResponse\x12J\n" +
+	"\x06Update\x12\x1f.gophkeeper.SSHKeyUpdateRequest\x1a\x1f.gophkeeper.SSHKeyShortResponse\x12;\n" +
+	"\x06Delete\x12\x19.gophkeeper.SSHKeyRequest\x1a\x16.google.protobuf.Empty\x12?\n" +
+	"\x04List\x12\x17.gophkeeper.ListRequest\x1a\x1e.gophkeeper.SSHKeyListResponse\x12G\n" +
+	"\n" +
+	"PublicKeys\x12\x16.google.protobuf.Empty\x1a!.gophkeeper.SSHPublicKeysResponse\x12B\n" +
+	"\aHistory\x12\x1a.gophkeeper.HistoryRequest\x1a\x1b.gophkeeper.HistoryResponse\x12F\n" +
+	"\aRestore\x12\x1a.gophkeeper.RestoreRequest\x1a\x1f.gophkeeper.SSHKeyShortResponse\x12D\n" +
+	"\x06Rename\x12\x19.gophkeeper.RenameRequest\x1a\x1f.gophkeeper.SSHKeyShortResponse2\xbd\x02\n" +
+	"\aFolders\x12;\n" +
+	"\x06Create\x12\x19.gophkeeper.FolderRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\x04Move\x12\x1d.gophkeeper.FolderMoveRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\x06Remove\x12\x19.gophkeeper.FolderRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\x04List\x12\x16.google.protobuf.Empty\x1a\x1e.gophkeeper.FolderListResponse\x129\n" +
+	"\x05Place\x12\x18.gophkeeper.PlaceRequest\x1a\x16.google.protobuf.Empty2\xdb\x01\n" +
+	"\x05Trash\x12H\n" +
+	"\tListTrash\x12\x1c.gophkeeper.TrashListRequest\x1a\x1d.gophkeeper.TrashListResponse\x12E\n" +
+	"\aRestore\x12\x18.gophkeeper.TrashRequest\x1a .gophkeeper.TrashRestoreResponse\x12A\n" +
+	"\x05Purge\x12\x18.gophkeeper.TrashRequest\x1a\x1e.gophkeeper.TrashPurgeResponseB)Z'github.com/MultikPatin/gophkeeper/protob\x06proto3"
 
 var (
 	file_proto_gophkeeper_proto_rawDescOnce sync.Once
@@ -1173,63 +7205,384 @@ func file_proto_gophkeeper_proto_rawDescGZIP() []byte {
 	return file_proto_gophkeeper_proto_rawDescData
 }
 
-var file_proto_gophkeeper_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_proto_gophkeeper_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_gophkeeper_proto_msgTypes = make([]protoimpl.MessageInfo, 95)
 var file_proto_gophkeeper_proto_goTypes = []any{
-	(*RegisterRequest)(nil),       // 0: gophkeeper.RegisterRequest
-	(*RegisterResponse)(nil),      // 1: gophkeeper.RegisterResponse
-	(*LoginRequest)(nil),          // 2: gophkeeper.LoginRequest
-	(*LoginResponse)(nil),         // 3: gophkeeper.LoginResponse
-	(*PasswordRequest)(nil),       // 4: gophkeeper.PasswordRequest
-	(*PasswordResponse)(nil),      // 5: gophkeeper.PasswordResponse
-	(*PasswordShortResponse)(nil), // 6: gophkeeper.PasswordShortResponse
-	(*PasswordCreateRequest)(nil), // 7: gophkeeper.PasswordCreateRequest
-	(*PasswordUpdateRequest)(nil), // 8: gophkeeper.PasswordUpdateRequest
-	(*CardRequest)(nil),           // 9: gophkeeper.CardRequest
-	(*CardResponse)(nil),          // 10: gophkeeper.CardResponse
-	(*CardShortResponse)(nil),     // 11: gophkeeper.CardShortResponse
-	(*CardCreateRequest)(nil),     // 12: gophkeeper.CardCreateRequest
-	(*CardUpdateRequest)(nil),     // 13: gophkeeper.CardUpdateRequest
-	(*BinariesRequest)(nil),       // 14: gophkeeper.BinariesRequest
-	(*BinariesResponse)(nil),      // 15: gophkeeper.BinariesResponse
-	(*BinariesShortResponse)(nil), // 16: gophkeeper.BinariesShortResponse
-	(*BinariesCreateRequest)(nil), // 17: gophkeeper.BinariesCreateRequest
-	(*BinariesUpdateRequest)(nil), // 18: gophkeeper.BinariesUpdateRequest
-	(*emptypb.Empty)(nil),         // 19: google.protobuf.Empty
+	(SortField)(0),                  // 0: gophkeeper.SortField
+	(ItemKind)(0),                   // 1: gophkeeper.ItemKind
+	(*VaultParams)(nil),             // 2: gophkeeper.VaultParams
+	(*RegisterRequest)(nil),         // 3: gophkeeper.RegisterRequest
+	(*RegisterResponse)(nil),        // 4: gophkeeper.RegisterResponse
+	(*LoginRequest)(nil),            // 5: gophkeeper.LoginRequest
+	(*LoginResponse)(nil),           // 6: gophkeeper.LoginResponse
+	(*VaultResponse)(nil),           // 7: gophkeeper.VaultResponse
+	(*RecordRequest)(nil),           // 8: gophkeeper.RecordRequest
+	(*RecordResponse)(nil),          // 9: gophkeeper.RecordResponse
+	(*SecondFactorRequest)(nil),     // 10: gophkeeper.SecondFactorRequest
+	(*TOTPEnrollResponse)(nil),      // 11: gophkeeper.TOTPEnrollResponse
+	(*TOTPCodeRequest)(nil),         // 12: gophkeeper.TOTPCodeRequest
+	(*TOTPConfirmResponse)(nil),     // 13: gophkeeper.TOTPConfirmResponse
+	(*CertificateBindRequest)(nil),  // 14: gophkeeper.CertificateBindRequest
+	(*CertificateRequest)(nil),      // 15: gophkeeper.CertificateRequest
+	(*CertificateResponse)(nil),     // 16: gophkeeper.CertificateResponse
+	(*Certificate)(nil),             // 17: gophkeeper.Certificate
+	(*CertificateListResponse)(nil), // 18: gophkeeper.CertificateListResponse
+	(*RefreshRequest)(nil),          // 19: gophkeeper.RefreshRequest
+	(*RefreshResponse)(nil),         // 20: gophkeeper.RefreshResponse
+	(*Session)(nil),                 // 21: gophkeeper.Session
+	(*SessionListResponse)(nil),     // 22: gophkeeper.SessionListResponse
+	(*RevokeSessionRequest)(nil),    // 23: gophkeeper.RevokeSessionRequest
+	(*ListRequest)(nil),             // 24: gophkeeper.ListRequest
+	(*Placement)(nil),               // 25: gophkeeper.Placement
+	(*FolderRequest)(nil),           // 26: gophkeeper.FolderRequest
+	(*FolderMoveRequest)(nil),       // 27: gophkeeper.FolderMoveRequest
+	(*FolderListResponse)(nil),      // 28: gophkeeper.FolderListResponse
+	(*PlaceRequest)(nil),            // 29: gophkeeper.PlaceRequest
+	(*HistoryRetentionRequest)(nil), // 30: gophkeeper.HistoryRetentionRequest
+	(*HistoryRequest)(nil),          // 31: gophkeeper.HistoryRequest
+	(*Revision)(nil),                // 32: gophkeeper.Revision
+	(*HistoryResponse)(nil),         // 33: gophkeeper.HistoryResponse
+	(*RestoreRequest)(nil),          // 34: gophkeeper.RestoreRequest
+	(*RenameRequest)(nil),           // 35: gophkeeper.RenameRequest
+	(*TrashItem)(nil),               // 36: gophkeeper.TrashItem
+	(*TrashListRequest)(nil),        // 37: gophkeeper.TrashListRequest
+	(*TrashListResponse)(nil),       // 38: gophkeeper.TrashListResponse
+	(*TrashRequest)(nil),            // 39: gophkeeper.TrashRequest
+	(*TrashRestoreResponse)(nil),    // 40: gophkeeper.TrashRestoreResponse
+	(*TrashPurgeResponse)(nil),      // 41: gophkeeper.TrashPurgeResponse
+	(*PasswordRequest)(nil),         // 42: gophkeeper.PasswordRequest
+	(*CustomField)(nil),             // 43: gophkeeper.CustomField
+	(*PasswordResponse)(nil),        // 44: gophkeeper.PasswordResponse
+	(*PasswordShortResponse)(nil),   // 45: gophkeeper.PasswordShortResponse
+	(*PasswordListResponse)(nil),    // 46: gophkeeper.PasswordListResponse
+	(*GenerateRequest)(nil),         // 47: gophkeeper.GenerateRequest
+	(*GenerateResponse)(nil),        // 48: gophkeeper.GenerateResponse
+	(*AuditRequest)(nil),            // 49: gophkeeper.AuditRequest
+	(*PasswordStrength)(nil),        // 50: gophkeeper.PasswordStrength
+	(*WeakPassword)(nil),            // 51: gophkeeper.WeakPassword
+	(*ReusedPassword)(nil),          // 52: gophkeeper.ReusedPassword
+	(*StalePassword)(nil),           // 53: gophkeeper.StalePassword
+	(*BreachedPassword)(nil),        // 54: gophkeeper.BreachedPassword
+	(*AuditResponse)(nil),           // 55: gophkeeper.AuditResponse
+	(*AuditEntry)(nil),              // 56: gophkeeper.AuditEntry
+	(*AuditEntriesResponse)(nil),    // 57: gophkeeper.AuditEntriesResponse
+	(*PasswordCreateRequest)(nil),   // 58: gophkeeper.PasswordCreateRequest
+	(*PasswordUpdateRequest)(nil),   // 59: gophkeeper.PasswordUpdateRequest
+	(*CardRequest)(nil),             // 60: gophkeeper.CardRequest
+	(*CardResponse)(nil),            // 61: gophkeeper.CardResponse
+	(*CardShortResponse)(nil),       // 62: gophkeeper.CardShortResponse
+	(*CardListResponse)(nil),        // 63: gophkeeper.CardListResponse
+	(*CardCreateRequest)(nil),       // 64: gophkeeper.CardCreateRequest
+	(*CardUpdateRequest)(nil),       // 65: gophkeeper.CardUpdateRequest
+	(*NoteRequest)(nil),             // 66: gophkeeper.NoteRequest
+	(*NoteResponse)(nil),            // 67: gophkeeper.NoteResponse
+	(*NoteShortResponse)(nil),       // 68: gophkeeper.NoteShortResponse
+	(*NoteListResponse)(nil),        // 69: gophkeeper.NoteListResponse
+	(*NoteCreateRequest)(nil),       // 70: gophkeeper.NoteCreateRequest
+	(*NoteUpdateRequest)(nil),       // 71: gophkeeper.NoteUpdateRequest
+	(*OTPRequest)(nil),              // 72: gophkeeper.OTPRequest
+	(*OTPResponse)(nil),             // 73: gophkeeper.OTPResponse
+	(*OTPShortResponse)(nil),        // 74: gophkeeper.OTPShortResponse
+	(*OTPListResponse)(nil),         // 75: gophkeeper.OTPListResponse
+	(*OTPCreateRequest)(nil),        // 76: gophkeeper.OTPCreateRequest
+	(*OTPUpdateRequest)(nil),        // 77: gophkeeper.OTPUpdateRequest
+	(*OTPCodeResponse)(nil),         // 78: gophkeeper.OTPCodeResponse
+	(*SSHKeyRequest)(nil),           // 79: gophkeeper.SSHKeyRequest
+	(*SSHKeyResponse)(nil),          // 80: gophkeeper.SSHKeyResponse
+	(*SSHKeyShortResponse)(nil),     // 81: gophkeeper.SSHKeyShortResponse
+	(*SSHKeyListResponse)(nil),      // 82: gophkeeper.SSHKeyListResponse
+	(*SSHKeyCreateRequest)(nil),     // 83: gophkeeper.SSHKeyCreateRequest
+	(*SSHKeyUpdateRequest)(nil),     // 84: gophkeeper.SSHKeyUpdateRequest
+	(*SSHPublicKey)(nil),            // 85: gophkeeper.SSHPublicKey
+	(*SSHPublicKeysResponse)(nil),   // 86: gophkeeper.SSHPublicKeysResponse
+	(*BinariesRequest)(nil),         // 87: gophkeeper.BinariesRequest
+	(*BinariesResponse)(nil),        // 88: gophkeeper.BinariesResponse
+	(*BinariesShortResponse)(nil),   // 89: gophkeeper.BinariesShortResponse
+	(*BinariesListResponse)(nil),    // 90: gophkeeper.BinariesListResponse
+	(*BinariesCreateRequest)(nil),   // 91: gophkeeper.BinariesCreateRequest
+	(*BinariesUpdateRequest)(nil),   // 92: gophkeeper.BinariesUpdateRequest
+	(*BinaryUploadInfo)(nil),        // 93: gophkeeper.BinaryUploadInfo
+	(*BinaryUploadRequest)(nil),     // 94: gophkeeper.BinaryUploadRequest
+	(*BinaryDownloadInfo)(nil),      // 95: gophkeeper.BinaryDownloadInfo
+	(*BinaryDownloadResponse)(nil),  // 96: gophkeeper.BinaryDownloadResponse
+	(*timestamppb.Timestamp)(nil),   // 97: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),           // 98: google.protobuf.Empty
 }
 var file_proto_gophkeeper_proto_depIdxs = []int32{
-	0,  // 0: gophkeeper.Users.Register:input_type -> gophkeeper.RegisterRequest
-	2,  // 1: gophkeeper.Users.Login:input_type -> gophkeeper.LoginRequest
-	4,  // 2: gophkeeper.Passwords.Get:input_type -> gophkeeper.PasswordRequest
-	7,  // 3: gophkeeper.Passwords.Add:input_type -> gophkeeper.PasswordCreateRequest
-	8,  // 4: gophkeeper.Passwords.Update:input_type -> gophkeeper.PasswordUpdateRequest
-	4,  // 5: gophkeeper.Passwords.Delete:input_type -> gophkeeper.PasswordRequest
-	9,  // 6: gophkeeper.Cards.Get:input_type -> gophkeeper.CardRequest
-	12, // 7: gophkeeper.Cards.Add:input_type -> gophkeeper.CardCreateRequest
-	13, // 8: gophkeeper.Cards.Update:input_type -> gophkeeper.CardUpdateRequest
-	9,  // 9: gophkeeper.Cards.Delete:input_type -> gophkeeper.CardRequest
-	14, // 10: gophkeeper.Binaries.Get:input_type -> gophkeeper.BinariesRequest
-	17, // 11: gophkeeper.Binaries.Add:input_type -> gophkeeper.BinariesCreateRequest
-	18, // 12: gophkeeper.Binaries.Update:input_type -> gophkeeper.BinariesUpdateRequest
-	14, // 13: gophkeeper.Binaries.Delete:input_type -> gophkeeper.BinariesRequest
-	1,  // 14: gophkeeper.Users.Register:output_type -> gophkeeper.RegisterResponse
-	3,  // 15: gophkeeper.Users.Login:output_type -> gophkeeper.LoginResponse
-	5,  // 16: gophkeeper.Passwords.Get:output_type -> gophkeeper.PasswordResponse
-	6,  // 17: gophkeeper.Passwords.Add:output_type -> gophkeeper.PasswordShortResponse
-	6,  // 18: gophkeeper.Passwords.Update:output_type -> gophkeeper.PasswordShortResponse
-	19, // 19: gophkeeper.Passwords.Delete:output_type -> google.protobuf.Empty
-	10, // 20: gophkeeper.Cards.Get:output_type -> gophkeeper.CardResponse
-	11, // 21: gophkeeper.Cards.Add:output_type -> gophkeeper.CardShortResponse
-	11, // 22: gophkeeper.Cards.Update:output_type -> gophkeeper.CardShortResponse
-	19, // 23: gophkeeper.Cards.Delete:output_type -> google.protobuf.Empty
-	15, // 24: gophkeeper.Binaries.Get:output_type -> gophkeeper.BinariesResponse
-	16, // 25: gophkeeper.Binaries.Add:output_type -> gophkeeper.BinariesShortResponse
-	16, // 26: gophkeeper.Binaries.Update:output_type -> gophkeeper.BinariesShortResponse
-	19, // 27: gophkeeper.Binaries.Delete:output_type -> google.protobuf.Empty
-	14, // [14:28] is the sub-list for method output_type
-	0,  // [0:14] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	2,   // 0: gophkeeper.RegisterRequest.vault:type_name -> gophkeeper.VaultParams
+	97,  // 1: gophkeeper.RegisterResponse.expiresAt:type_name -> google.protobuf.Timestamp
+	2,   // 2: gophkeeper.LoginResponse.vault:type_name -> gophkeeper.VaultParams
+	97,  // 3: gophkeeper.LoginResponse.expiresAt:type_name -> google.protobuf.Timestamp
+	2,   // 4: gophkeeper.VaultResponse.vault:type_name -> gophkeeper.VaultParams
+	1,   // 5: gophkeeper.RecordRequest.kind:type_name -> gophkeeper.ItemKind
+	97,  // 6: gophkeeper.Certificate.createdAt:type_name -> google.protobuf.Timestamp
+	97,  // 7: gophkeeper.Certificate.expiresAt:type_name -> google.protobuf.Timestamp
+	17,  // 8: gophkeeper.CertificateListResponse.items:type_name -> gophkeeper.Certificate
+	97,  // 9: gophkeeper.RefreshResponse.expiresAt:type_name -> google.protobuf.Timestamp
+	97,  // 10: gophkeeper.Session.createdAt:type_name -> google.protobuf.Timestamp
+	97,  // 11: gophkeeper.Session.lastUsedAt:type_name -> google.protobuf.Timestamp
+	97,  // 12: gophkeeper.Session.expiresAt:type_name -> google.protobuf.Timestamp
+	21,  // 13: gophkeeper.SessionListResponse.items:type_name -> gophkeeper.Session
+	0,   // 14: gophkeeper.ListRequest.sortBy:type_name -> gophkeeper.SortField
+	97,  // 15: gophkeeper.ListRequest.updatedBefore:type_name -> google.protobuf.Timestamp
+	97,  // 16: gophkeeper.ListRequest.accessedBefore:type_name -> google.protobuf.Timestamp
+	1,   // 17: gophkeeper.PlaceRequest.kind:type_name -> gophkeeper.ItemKind
+	25,  // 18: gophkeeper.PlaceRequest.placement:type_name -> gophkeeper.Placement
+	97,  // 19: gophkeeper.Revision.archivedAt:type_name -> google.protobuf.Timestamp
+	32,  // 20: gophkeeper.HistoryResponse.items:type_name -> gophkeeper.Revision
+	1,   // 21: gophkeeper.TrashItem.kind:type_name -> gophkeeper.ItemKind
+	97,  // 22: gophkeeper.TrashItem.deletedAt:type_name -> google.protobuf.Timestamp
+	97,  // 23: gophkeeper.TrashItem.purgeAt:type_name -> google.protobuf.Timestamp
+	1,   // 24: gophkeeper.TrashListRequest.kind:type_name -> gophkeeper.ItemKind
+	36,  // 25: gophkeeper.TrashListResponse.items:type_name -> gophkeeper.TrashItem
+	1,   // 26: gophkeeper.TrashRequest.kind:type_name -> gophkeeper.ItemKind
+	43,  // 27: gophkeeper.PasswordResponse.fields:type_name -> gophkeeper.CustomField
+	25,  // 28: gophkeeper.PasswordResponse.placement:type_name -> gophkeeper.Placement
+	97,  // 29: gophkeeper.PasswordResponse.createdAt:type_name -> google.protobuf.Timestamp
+	97,  // 30: gophkeeper.PasswordResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	97,  // 31: gophkeeper.PasswordResponse.lastAccessedAt:type_name -> google.protobuf.Timestamp
+	97,  // 32: gophkeeper.PasswordShortResponse.createdAt:type_name -> google.protobuf.Timestamp
+	97,  // 33: gophkeeper.PasswordShortResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	97,  // 34: gophkeeper.PasswordShortResponse.lastAccessedAt:type_name -> google.protobuf.Timestamp
+	45,  // 35: gophkeeper.PasswordListResponse.items:type_name -> gophkeeper.PasswordShortResponse
+	50,  // 36: gophkeeper.WeakPassword.strength:type_name -> gophkeeper.PasswordStrength
+	97,  // 37: gophkeeper.StalePassword.updatedAt:type_name -> google.protobuf.Timestamp
+	51,  // 38: gophkeeper.AuditResponse.weak:type_name -> gophkeeper.WeakPassword
+	52,  // 39: gophkeeper.AuditResponse.reused:type_name -> gophkeeper.ReusedPassword
+	53,  // 40: gophkeeper.AuditResponse.stale:type_name -> gophkeeper.StalePassword
+	54,  // 41: gophkeeper.AuditResponse.breached:type_name -> gophkeeper.BreachedPassword
+	97,  // 42: gophkeeper.AuditEntry.updatedAt:type_name -> google.protobuf.Timestamp
+	56,  // 43: gophkeeper.AuditEntriesResponse.items:type_name -> gophkeeper.AuditEntry
+	43,  // 44: gophkeeper.PasswordCreateRequest.fields:type_name -> gophkeeper.CustomField
+	25,  // 45: gophkeeper.PasswordCreateRequest.placement:type_name -> gophkeeper.Placement
+	47,  // 46: gophkeeper.PasswordCreateRequest.generate:type_name -> gophkeeper.GenerateRequest
+	43,  // 47: gophkeeper.PasswordUpdateRequest.fields:type_name -> gophkeeper.CustomField
+	25,  // 48: gophkeeper.PasswordUpdateRequest.placement:type_name -> gophkeeper.Placement
+	47,  // 49: gophkeeper.PasswordUpdateRequest.generate:type_name -> gophkeeper.GenerateRequest
+	25,  // 50: gophkeeper.CardResponse.placement:type_name -> gophkeeper.Placement
+	97,  // 51: gophkeeper.CardResponse.createdAt:type_name -> google.protobuf.Timestamp
+	97,  // 52: gophkeeper.CardResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	97,  // 53: gophkeeper.CardResponse.lastAccessedAt:type_name -> google.protobuf.Timestamp
+	97,  // 54: gophkeeper.CardResponse.lastRevealedAt:type_name -> google.protobuf.Timestamp
+	97,  // 55: gophkeeper.CardShortResponse.createdAt:type_name -> google.protobuf.Timestamp
+	97,  // 56: gophkeeper.CardShortResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	97,  // 57: gophkeeper.CardShortResponse.lastAccessedAt:type_name -> google.protobuf.Timestamp
+	62,  // 58: gophkeeper.CardListResponse.items:type_name -> gophkeeper.CardShortResponse
+	25,  // 59: gophkeeper.CardCreateRequest.placement:type_name -> gophkeeper.Placement
+	25,  // 60: gophkeeper.CardUpdateRequest.placement:type_name -> gophkeeper.Placement
+	25,  // 61: gophkeeper.NoteResponse.placement:type_name -> gophkeeper.Placement
+	97,  // 62: gophkeeper.NoteResponse.createdAt:type_name -> google.protobuf.Timestamp
+	97,  // 63: gophkeeper.NoteResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	97,  // 64: gophkeeper.NoteResponse.lastAccessedAt:type_name -> google.protobuf.Timestamp
+	97,  // 65: gophkeeper.NoteShortResponse.createdAt:type_name -> google.protobuf.Timestamp
+	97,  // 66: gophkeeper.NoteShortResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	97,  // 67: gophkeeper.NoteShortResponse.lastAccessedAt:type_name -> google.protobuf.Timestamp
+	68,  // 68: gophkeeper.NoteListResponse.items:type_name -> gophkeeper.NoteShortResponse
+	25,  // 69: gophkeeper.NoteCreateRequest.placement:type_name -> gophkeeper.Placement
+	25,  // 70: gophkeeper.NoteUpdateRequest.placement:type_name -> gophkeeper.Placement
+	25,  // 71: gophkeeper.OTPResponse.placement:type_name -> gophkeeper.Placement
+	97,  // 72: gophkeeper.OTPResponse.createdAt:type_name -> google.protobuf.Timestamp
+	97,  // 73: gophkeeper.OTPResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	97,  // 74: gophkeeper.OTPResponse.lastAccessedAt:type_name -> google.protobuf.Timestamp
+	97,  // 75: gophkeeper.OTPShortResponse.createdAt:type_name -> google.protobuf.Timestamp
+	97,  // 76: gophkeeper.OTPShortResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	97,  // 77: gophkeeper.OTPShortResponse.lastAccessedAt:type_name -> google.protobuf.Timestamp
+	74,  // 78: gophkeeper.OTPListResponse.items:type_name -> gophkeeper.OTPShortResponse
+	25,  // 79: gophkeeper.OTPCreateRequest.placement:type_name -> gophkeeper.Placement
+	25,  // 80: gophkeeper.OTPUpdateRequest.placement:type_name -> gophkeeper.Placement
+	25,  // 81: gophkeeper.SSHKeyResponse.placement:type_name -> gophkeeper.Placement
+	97,  // 82: gophkeeper.SSHKeyResponse.createdAt:type_name -> google.protobuf.Timestamp
+	97,  // 83: gophkeeper.SSHKeyResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	97,  // 84: gophkeeper.SSHKeyResponse.lastAccessedAt:type_name -> google.protobuf.Timestamp
+	97,  // 85: gophkeeper.SSHKeyShortResponse.createdAt:type_name -> google.protobuf.Timestamp
+	97,  // 86: gophkeeper.SSHKeyShortResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	97,  // 87: gophkeeper.SSHKeyShortResponse.lastAccessedAt:type_name -> google.protobuf.Timestamp
+	81,  // 88: gophkeeper.SSHKeyListResponse.items:type_name -> gophkeeper.SSHKeyShortResponse
+	25,  // 89: gophkeeper.SSHKeyCreateRequest.placement:type_name -> gophkeeper.Placement
+	25,  // 90: gophkeeper.SSHKeyUpdateRequest.placement:type_name -> gophkeeper.Placement
+	85,  // 91: gophkeeper.SSHPublicKeysResponse.keys:type_name -> gophkeeper.SSHPublicKey
+	25,  // 92: gophkeeper.BinariesResponse.placement:type_name -> gophkeeper.Placement
+	97,  // 93: gophkeeper.BinariesResponse.createdAt:type_name -> google.protobuf.Timestamp
+	97,  // 94: gophkeeper.BinariesResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	97,  // 95: gophkeeper.BinariesResponse.lastAccessedAt:type_name -> google.protobuf.Timestamp
+	97,  // 96: gophkeeper.BinariesShortResponse.createdAt:type_name -> google.protobuf.Timestamp
+	97,  // 97: gophkeeper.BinariesShortResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	97,  // 98: gophkeeper.BinariesShortResponse.lastAccessedAt:type_name -> google.protobuf.Timestamp
+	89,  // 99: gophkeeper.BinariesListResponse.items:type_name -> gophkeeper.BinariesShortResponse
+	25,  // 100: gophkeeper.BinariesCreateRequest.placement:type_name -> gophkeeper.Placement
+	25,  // 101: gophkeeper.BinariesUpdateRequest.placement:type_name -> gophkeeper.Placement
+	25,  // 102: gophkeeper.BinaryUploadInfo.placement:type_name -> gophkeeper.Placement
+	93,  // 103: gophkeeper.BinaryUploadRequest.info:type_name -> gophkeeper.BinaryUploadInfo
+	25,  // 104: gophkeeper.BinaryDownloadInfo.placement:type_name -> gophkeeper.Placement
+	97,  // 105: gophkeeper.BinaryDownloadInfo.createdAt:type_name -> google.protobuf.Timestamp
+	97,  // 106: gophkeeper.BinaryDownloadInfo.updatedAt:type_name -> google.protobuf.Timestamp
+	97,  // 107: gophkeeper.BinaryDownloadInfo.lastAccessedAt:type_name -> google.protobuf.Timestamp
+	95,  // 108: gophkeeper.BinaryDownloadResponse.info:type_name -> gophkeeper.BinaryDownloadInfo
+	3,   // 109: gophkeeper.Users.Register:input_type -> gophkeeper.RegisterRequest
+	5,   // 110: gophkeeper.Users.Login:input_type -> gophkeeper.LoginRequest
+	98,  // 111: gophkeeper.Users.Vault:input_type -> google.protobuf.Empty
+	8,   // 112: gophkeeper.Users.RecordID:input_type -> gophkeeper.RecordRequest
+	19,  // 113: gophkeeper.Users.Refresh:input_type -> gophkeeper.RefreshRequest
+	98,  // 114: gophkeeper.Users.Logout:input_type -> google.protobuf.Empty
+	98,  // 115: gophkeeper.Users.ListSessions:input_type -> google.protobuf.Empty
+	23,  // 116: gophkeeper.Users.RevokeSession:input_type -> gophkeeper.RevokeSessionRequest
+	10,  // 117: gophkeeper.Users.VerifySecondFactor:input_type -> gophkeeper.SecondFactorRequest
+	98,  // 118: gophkeeper.Users.EnrollTOTP:input_type -> google.protobuf.Empty
+	12,  // 119: gophkeeper.Users.ConfirmTOTP:input_type -> gophkeeper.TOTPCodeRequest
+	12,  // 120: gophkeeper.Users.DisableTOTP:input_type -> gophkeeper.TOTPCodeRequest
+	14,  // 121: gophkeeper.Users.BindCertificate:input_type -> gophkeeper.CertificateBindRequest
+	15,  // 122: gophkeeper.Users.UnbindCertificate:input_type -> gophkeeper.CertificateRequest
+	98,  // 123: gophkeeper.Users.ListCertificates:input_type -> google.protobuf.Empty
+	30,  // 124: gophkeeper.Users.SetHistoryRetention:input_type -> gophkeeper.HistoryRetentionRequest
+	42,  // 125: gophkeeper.Passwords.Get:input_type -> gophkeeper.PasswordRequest
+	58,  // 126: gophkeeper.Passwords.Add:input_type -> gophkeeper.PasswordCreateRequest
+	59,  // 127: gophkeeper.Passwords.Update:input_type -> gophkeeper.PasswordUpdateRequest
+	42,  // 128: gophkeeper.Passwords.Delete:input_type -> gophkeeper.PasswordRequest
+	24,  // 129: gophkeeper.Passwords.List:input_type -> gophkeeper.ListRequest
+	31,  // 130: gophkeeper.Passwords.History:input_type -> gophkeeper.HistoryRequest
+	34,  // 131: gophkeeper.Passwords.Restore:input_type -> gophkeeper.RestoreRequest
+	35,  // 132: gophkeeper.Passwords.Rename:input_type -> gophkeeper.RenameRequest
+	49,  // 133: gophkeeper.Passwords.Audit:input_type -> gophkeeper.AuditRequest
+	98,  // 134: gophkeeper.Passwords.AuditEntries:input_type -> google.protobuf.Empty
+	47,  // 135: gophkeeper.Passwords.Generate:input_type -> gophkeeper.GenerateRequest
+	60,  // 136: gophkeeper.Cards.Get:input_type -> gophkeeper.CardRequest
+	64,  // 137: gophkeeper.Cards.Add:input_type -> gophkeeper.CardCreateRequest
+	65,  // 138: gophkeeper.Cards.Update:input_type -> gophkeeper.CardUpdateRequest
+	60,  // 139: gophkeeper.Cards.Delete:input_type -> gophkeeper.CardRequest
+	24,  // 140: gophkeeper.Cards.List:input_type -> gophkeeper.ListRequest
+	31,  // 141: gophkeeper.Cards.History:input_type -> gophkeeper.HistoryRequest
+	34,  // 142: gophkeeper.Cards.Restore:input_type -> gophkeeper.RestoreRequest
+	35,  // 143: gophkeeper.Cards.Rename:input_type -> gophkeeper.RenameRequest
+	60,  // 144: gophkeeper.Cards.Reveal:input_type -> gophkeeper.CardRequest
+	87,  // 145: gophkeeper.Binaries.Get:input_type -> gophkeeper.BinariesRequest
+	91,  // 146: gophkeeper.Binaries.Add:input_type -> gophkeeper.BinariesCreateRequest
+	92,  // 147: gophkeeper.Binaries.Update:input_type -> gophkeeper.BinariesUpdateRequest
+	87,  // 148: gophkeeper.Binaries.Delete:input_type -> gophkeeper.BinariesRequest
+	24,  // 149: gophkeeper.Binaries.List:input_type -> gophkeeper.ListRequest
+	94,  // 150: gophkeeper.Binaries.Upload:input_type -> gophkeeper.BinaryUploadRequest
+	87,  // 151: gophkeeper.Binaries.Download:input_type -> gophkeeper.BinariesRequest
+	31,  // 152: gophkeeper.Binaries.History:input_type -> gophkeeper.HistoryRequest
+	34,  // 153: gophkeeper.Binaries.Restore:input_type -> gophkeeper.RestoreRequest
+	35,  // 154: gophkeeper.Binaries.Rename:input_type -> gophkeeper.RenameRequest
+	66,  // 155: gophkeeper.Notes.Get:input_type -> gophkeeper.NoteRequest
+	70,  // 156: gophkeeper.Notes.Add:input_type -> gophkeeper.NoteCreateRequest
+	71,  // 157: gophkeeper.Notes.Update:input_type -> gophkeeper.NoteUpdateRequest
+	66,  // 158: gophkeeper.Notes.Delete:input_type -> gophkeeper.NoteRequest
+	24,  // 159: gophkeeper.Notes.List:input_type -> gophkeeper.ListRequest
+	31,  // 160: gophkeeper.Notes.History:input_type -> gophkeeper.HistoryRequest
+	34,  // 161: gophkeeper.Notes.Restore:input_type -> gophkeeper.RestoreRequest
+	35,  // 162: gophkeeper.Notes.Rename:input_type -> gophkeeper.RenameRequest
+	72,  // 163: gophkeeper.OTP.Get:input_type -> gophkeeper.OTPRequest
+	76,  // 164: gophkeeper.OTP.Add:input_type -> gophkeeper.OTPCreateRequest
+	77,  // 165: gophkeeper.OTP.Update:input_type -> gophkeeper.OTPUpdateRequest
+	72,  // 166: gophkeeper.OTP.Delete:input_type -> gophkeeper.OTPRequest
+	24,  // 167: gophkeeper.OTP.List:input_type -> gophkeeper.ListRequest
+	31,  // 168: gophkeeper.OTP.History:input_type -> gophkeeper.HistoryRequest
+	34,  // 169: gophkeeper.OTP.Restore:input_type -> gophkeeper.RestoreRequest
+	35,  // 170: gophkeeper.OTP.Rename:input_type -> gophkeeper.RenameRequest
+	72,  // 171: gophkeeper.OTP.GenerateCode:input_type -> gophkeeper.OTPRequest
+	79,  // 172: gophkeeper.SSHKeys.Get:input_type -> gophkeeper.SSHKeyRequest
+	83,  // 173: gophkeeper.SSHKeys.Add:input_type -> gophkeeper.SSHKeyCreateRequest
+	84,  // 174: gophkeeper.SSHKeys.Update:input_type -> gophkeeper.SSHKeyUpdateRequest
+	79,  // 175: gophkeeper.SSHKeys.Delete:input_type -> gophkeeper.SSHKeyRequest
+	24,  // 176: gophkeeper.SSHKeys.List:input_type -> gophkeeper.ListRequest
+	98,  // 177: gophkeeper.SSHKeys.PublicKeys:input_type -> google.protobuf.Empty
+	31,  // 178: gophkeeper.SSHKeys.History:input_type -> gophkeeper.HistoryRequest
+	34,  // 179: gophkeeper.SSHKeys.Restore:input_type -> gophkeeper.RestoreRequest
+	35,  // 180: gophkeeper.SSHKeys.Rename:input_type -> gophkeeper.RenameRequest
+	26,  // 181: gophkeeper.Folders.Create:input_type -> gophkeeper.FolderRequest
+	27,  // 182: gophkeeper.Folders.Move:input_type -> gophkeeper.FolderMoveRequest
+	26,  // 183: gophkeeper.Folders.Remove:input_type -> gophkeeper.FolderRequest
+	98,  // 184: gophkeeper.Folders.List:input_type -> google.protobuf.Empty
+	29,  // 185: gophkeeper.Folders.Place:input_type -> gophkeeper.PlaceRequest
+	37,  // 186: gophkeeper.Trash.ListTrash:input_type -> gophkeeper.TrashListRequest
+	39,  // 187: gophkeeper.Trash.Restore:input_type -> gophkeeper.TrashRequest
+	39,  // 188: gophkeeper.Trash.Purge:input_type -> gophkeeper.TrashRequest
+	4,   // 189: gophkeeper.Users.Register:output_type -> gophkeeper.RegisterResponse
+	6,   // 190: gophkeeper.Users.Login:output_type -> gophkeeper.LoginResponse
+	7,   // 191: gophkeeper.Users.Vault:output_type -> gophkeeper.VaultResponse
+	9,   // 192: gophkeeper.Users.RecordID:output_type -> gophkeeper.RecordResponse
+	20,  // 193: gophkeeper.Users.Refresh:output_type -> gophkeeper.RefreshResponse
+	98,  // 194: gophkeeper.Users.Logout:output_type -> google.protobuf.Empty
+	22,  // 195: gophkeeper.Users.ListSessions:output_type -> gophkeeper.SessionListResponse
+	98,  // 196: gophkeeper.Users.RevokeSession:output_type -> google.protobuf.Empty
+	6,   // 197: gophkeeper.Users.VerifySecondFactor:output_type -> gophkeeper.LoginResponse
+	11,  // 198: gophkeeper.Users.EnrollTOTP:output_type -> gophkeeper.TOTPEnrollResponse
+	13,  // 199: gophkeeper.Users.ConfirmTOTP:output_type -> gophkeeper.TOTPConfirmResponse
+	98,  // 200: gophkeeper.Users.DisableTOTP:output_type -> google.protobuf.Empty
+	16,  // 201: gophkeeper.Users.BindCertificate:output_type -> gophkeeper.CertificateResponse
+	16,  // 202: gophkeeper.Users.UnbindCertificate:output_type -> gophkeeper.CertificateResponse
+	18,  // 203: gophkeeper.Users.ListCertificates:output_type -> gophkeeper.CertificateListResponse
+	98,  // 204: gophkeeper.Users.SetHistoryRetention:output_type -> google.protobuf.Empty
+	44,  // 205: gophkeeper.Passwords.Get:output_type -> gophkeeper.PasswordResponse
+	45,  // 206: gophkeeper.Passwords.Add:output_type -> gophkeeper.PasswordShortResponse
+	45,  // 207: gophkeeper.Passwords.Update:output_type -> gophkeeper.PasswordShortResponse
+	98,  // 208: gophkeeper.Passwords.Delete:output_type -> google.protobuf.Empty
+	46,  // 209: gophkeeper.Passwords.List:output_type -> gophkeeper.PasswordListResponse
+	33,  // 210: gophkeeper.Passwords.History:output_type -> gophkeeper.HistoryResponse
+	45,  // 211: gophkeeper.Passwords.Restore:output_type -> gophkeeper.PasswordShortResponse
+	45,  // 212: gophkeeper.Passwords.Rename:output_type -> gophkeeper.PasswordShortResponse
+	55,  // 213: gophkeeper.Passwords.Audit:output_type -> gophkeeper.AuditResponse
+	57,  // 214: gophkeeper.Passwords.AuditEntries:output_type -> gophkeeper.AuditEntriesResponse
+	48,  // 215: gophkeeper.Passwords.Generate:output_type -> gophkeeper.GenerateResponse
+	61,  // 216: gophkeeper.Cards.Get:output_type -> gophkeeper.CardResponse
+	62,  // 217: gophkeeper.Cards.Add:output_type -> gophkeeper.CardShortResponse
+	62,  // 218: gophkeeper.Cards.Update:output_type -> gophkeeper.CardShortResponse
+	98,  // 219: gophkeeper.Cards.Delete:output_type -> google.protobuf.Empty
+	63,  // 220: gophkeeper.Cards.List:output_type -> gophkeeper.CardListResponse
+	33,  // 221: gophkeeper.Cards.History:output_type -> gophkeeper.HistoryResponse
+	62,  // 222: gophkeeper.Cards.Restore:output_type -> gophkeeper.CardShortResponse
+	62,  // 223: gophkeeper.Cards.Rename:output_type -> gophkeeper.CardShortResponse
+	61,  // 224: gophkeeper.Cards.Reveal:output_type -> gophkeeper.CardResponse
+	88,  // 225: gophkeeper.Binaries.Get:output_type -> gophkeeper.BinariesResponse
+	89,  // 226: gophkeeper.Binaries.Add:output_type -> gophkeeper.BinariesShortResponse
+	89,  // 227: gophkeeper.Binaries.Update:output_type -> gophkeeper.BinariesShortResponse
+	98,  // 228: gophkeeper.Binaries.Delete:output_type -> google.protobuf.Empty
+	90,  // 229: gophkeeper.Binaries.List:output_type -> gophkeeper.BinariesListResponse
+	89,  // 230: gophkeeper.Binaries.Upload:output_type -> gophkeeper.BinariesShortResponse
+	96,  // 231: gophkeeper.Binaries.Download:output_type -> gophkeeper.BinaryDownloadResponse
+	33,  // 232: gophkeeper.Binaries.History:output_type -> gophkeeper.HistoryResponse
+	89,  // 233: gophkeeper.Binaries.Restore:output_type -> gophkeeper.BinariesShortResponse
+	89,  // 234: gophkeeper.Binaries.Rename:output_type -> gophkeeper.BinariesShortResponse
+	67,  // 235: gophkeeper.Notes.Get:output_type -> gophkeeper.NoteResponse
+	68,  // 236: gophkeeper.Notes.Add:output_type -> gophkeeper.NoteShortResponse
+	68,  // 237: gophkeeper.Notes.Update:output_type -> gophkeeper.NoteShortResponse
+	98,  // 238: gophkeeper.Notes.Delete:output_type -> google.protobuf.Empty
+	69,  // 239: gophkeeper.Notes.List:output_type -> gophkeeper.NoteListResponse
+	33,  // 240: gophkeeper.Notes.History:output_type -> gophkeeper.HistoryResponse
+	68,  // 241: gophkeeper.Notes.Restore:output_type -> gophkeeper.NoteShortResponse
+	68,  // 242: gophkeeper.Notes.Rename:output_type -> gophkeeper.NoteShortResponse
+	73,  // 243: gophkeeper.OTP.Get:output_type -> gophkeeper.OTPResponse
+	74,  // 244: gophkeeper.OTP.Add:output_type -> gophkeeper.OTPShortResponse
+	74,  // 245: gophkeeper.OTP.Update:output_type -> gophkeeper.OTPShortResponse
+	98,  // 246: gophkeeper.OTP.Delete:output_type -> google.protobuf.Empty
+	75,  // 247: gophkeeper.OTP.List:output_type -> gophkeeper.OTPListResponse
+	33,  // 248: gophkeeper.OTP.History:output_type -> gophkeeper.HistoryResponse
+	74,  // 249: gophkeeper.OTP.Restore:output_type -> gophkeeper.OTPShortResponse
+	74,  // 250: gophkeeper.OTP.Rename:output_type -> gophkeeper.OTPShortResponse
+	78,  // 251: gophkeeper.OTP.GenerateCode:output_type -> gophkeeper.OTPCodeResponse
+	80,  // 252: gophkeeper.SSHKeys.Get:output_type -> gophkeeper.SSHKeyResponse
+	81,  // 253: gophkeeper.SSHKeys.Add:output_type -> gophkeeper.SSHKeyShortResponse
+	81,  // 254: gophkeeper.SSHKeys.Update:output_type -> gophkeeper.SSHKeyShortResponse
+	98,  // 255: gophkeeper.SSHKeys.Delete:output_type -> google.protobuf.Empty
+	82,  // 256: gophkeeper.SSHKeys.List:output_type -> gophkeeper.SSHKeyListResponse
+	86,  // 257: gophkeeper.SSHKeys.PublicKeys:output_type -> gophkeeper.SSHPublicKeysResponse
+	33,  // 258: gophkeeper.SSHKeys.History:output_type -> gophkeeper.HistoryResponse
+	81,  // 259: gophkeeper.SSHKeys.Restore:output_type -> gophkeeper.SSHKeyShortResponse
+	81,  // 260: gophkeeper.SSHKeys.Rename:output_type -> gophkeeper.SSHKeyShortResponse
+	98,  // 261: gophkeeper.Folders.Create:output_type -> google.protobuf.Empty
+	98,  // 262: gophkeeper.Folders.Move:output_type -> google.protobuf.Empty
+	98,  // 263: gophkeeper.Folders.Remove:output_type -> google.protobuf.Empty
+	28,  // 264: gophkeeper.Folders.List:output_type -> gophkeeper.FolderListResponse
+	98,  // 265: gophkeeper.Folders.Place:output_type -> google.protobuf.Empty
+	38,  // 266: gophkeeper.Trash.ListTrash:output_type -> gophkeeper.TrashListResponse
+	40,  // 267: gophkeeper.Trash.Restore:output_type -> gophkeeper.TrashRestoreResponse
+	41,  // 268: gophkeeper.Trash.Purge:output_type -> gophkeeper.TrashPurgeResponse
+	189, // [189:269] is the sub-list for method output_type
+	109, // [109:189] is the sub-list for method input_type
+	109, // [109:109] is the sub-list for extension type_name
+	109, // [109:109] is the sub-list for extension extendee
+	0,   // [0:109] is the sub-list for field type_name
 }
 
 func init() { file_proto_gophkeeper_proto_init() }
@@ -1237,18 +7590,28 @@ func file_proto_gophkeeper_proto_init() {
 	if File_proto_gophkeeper_proto != nil {
 		return
 	}
+	file_proto_gophkeeper_proto_msgTypes[92].OneofWrappers = []any{
+		(*BinaryUploadRequest_Info)(nil),
+		(*BinaryUploadRequest_Chunk)(nil),
+		(*BinaryUploadRequest_Sha256)(nil),
+	}
+	file_proto_gophkeeper_proto_msgTypes[94].OneofWrappers = []any{
+		(*BinaryDownloadResponse_Info)(nil),
+		(*BinaryDownloadResponse_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_gophkeeper_proto_rawDesc), len(file_proto_gophkeeper_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   19,
+			NumEnums:      2,
+			NumMessages:   95,
 			NumExtensions: 0,
-			NumServices:   4,
+			NumServices:   9,
 		},
 		GoTypes:           file_proto_gophkeeper_proto_goTypes,
 		DependencyIndexes: file_proto_gophkeeper_proto_depIdxs,
+		EnumInfos:         file_proto_gophkeeper_proto_enumTypes,
 		MessageInfos:      file_proto_gophkeeper_proto_msgTypes,
 	}.Build()
 	File_proto_gophkeeper_proto = out.File
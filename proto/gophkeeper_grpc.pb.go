@@ -20,8 +20,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Users_Register_FullMethodName = "/gophkeeper.Users/Register"
-	Users_Login_FullMethodName    = "/gophkeeper.Users/Login"
+	Users_Register_FullMethodName            = "/gophkeeper.Users/Register"
+	Users_Login_FullMethodName               = "/gophkeeper.Users/Login"
+	Users_Vault_FullMethodName               = "/gophkeeper.Users/Vault"
+	Users_RecordID_FullMethodName            = "/gophkeeper.Users/RecordID"
+	Users_Refresh_FullMethodName             = "/gophkeeper.Users/Refresh"
+	Users_Logout_FullMethodName              = "/gophkeeper.Users/Logout"
+	Users_ListSessions_FullMethodName        = "/gophkeeper.Users/ListSessions"
+	Users_RevokeSession_FullMethodName       = "/gophkeeper.Users/RevokeSession"
+	Users_VerifySecondFactor_FullMethodName  = "/gophkeeper.Users/VerifySecondFactor"
+	Users_EnrollTOTP_FullMethodName          = "/gophkeeper.Users/EnrollTOTP"
+	Users_ConfirmTOTP_FullMethodName         = "/gophkeeper.Users/ConfirmTOTP"
+	Users_DisableTOTP_FullMethodName         = "/gophkeeper.Users/DisableTOTP"
+	Users_BindCertificate_FullMethodName     = "/gophkeeper.Users/BindCertificate"
+	Users_UnbindCertificate_FullMethodName   = "/gophkeeper.Users/UnbindCertificate"
+	Users_ListCertificates_FullMethodName    = "/gophkeeper.Users/ListCertificates"
+	Users_SetHistoryRetention_FullMethodName = "/gophkeeper.Users/SetHistoryRetention"
 )
 
 // UsersClient is the client API for Users service.
@@ -30,6 +44,20 @@ const (
 type UsersClient interface {
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	Vault(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VaultResponse, error)
+	RecordID(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*RecordResponse, error)
+	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error)
+	Logout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SessionListResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	VerifySecondFactor(ctx context.Context, in *SecondFactorRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	EnrollTOTP(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TOTPEnrollResponse, error)
+	ConfirmTOTP(ctx context.Context, in *TOTPCodeRequest, opts ...grpc.CallOption) (*TOTPConfirmResponse, error)
+	DisableTOTP(ctx context.Context, in *TOTPCodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BindCertificate(ctx context.Context, in *CertificateBindRequest, opts ...grpc.CallOption) (*CertificateResponse, error)
+	UnbindCertificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*CertificateResponse, error)
+	ListCertificates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CertificateListResponse, error)
+	SetHistoryRetention(ctx context.Context, in *HistoryRetentionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type usersClient struct {
@@ -60,99 +88,2706 @@ func (c *usersClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.
 	return out, nil
 }
 
+func (c *usersClient) Vault(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VaultResponse)
+	err := c.cc.Invoke(ctx, Users_Vault_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) RecordID(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*RecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordResponse)
+	err := c.cc.Invoke(ctx, Users_RecordID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshResponse)
+	err := c.cc.Invoke(ctx, Users_Refresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) Logout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Users_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ListSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SessionListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionListResponse)
+	err := c.cc.Invoke(ctx, Users_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Users_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) VerifySecondFactor(ctx context.Context, in *SecondFactorRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, Users_VerifySecondFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) EnrollTOTP(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TOTPEnrollResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TOTPEnrollResponse)
+	err := c.cc.Invoke(ctx, Users_EnrollTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ConfirmTOTP(ctx context.Context, in *TOTPCodeRequest, opts ...grpc.CallOption) (*TOTPConfirmResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TOTPConfirmResponse)
+	err := c.cc.Invoke(ctx, Users_ConfirmTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) DisableTOTP(ctx context.Context, in *TOTPCodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Users_DisableTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) BindCertificate(ctx context.Context, in *CertificateBindRequest, opts ...grpc.CallOption) (*CertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CertificateResponse)
+	err := c.cc.Invoke(ctx, Users_BindCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) UnbindCertificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*CertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CertificateResponse)
+	err := c.cc.Invoke(ctx, Users_UnbindCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ListCertificates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CertificateListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CertificateListResponse)
+	err := c.cc.Invoke(ctx, Users_ListCertificates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) SetHistoryRetention(ctx context.Context, in *HistoryRetentionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Users_SetHistoryRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsersServer is the server API for Users service.
 // All implementations must embed UnimplementedUsersServer
 // for forward compatibility.
 type UsersServer interface {
 	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	Vault(context.Context, *emptypb.Empty) (*VaultResponse, error)
+	RecordID(context.Context, *RecordRequest) (*RecordResponse, error)
+	Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error)
+	Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	ListSessions(context.Context, *emptypb.Empty) (*SessionListResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error)
+	VerifySecondFactor(context.Context, *SecondFactorRequest) (*LoginResponse, error)
+	EnrollTOTP(context.Context, *emptypb.Empty) (*TOTPEnrollResponse, error)
+	ConfirmTOTP(context.Context, *TOTPCodeRequest) (*TOTPConfirmResponse, error)
+	DisableTOTP(context.Context, *TOTPCodeRequest) (*emptypb.Empty, error)
+	BindCertificate(context.Context, *CertificateBindRequest) (*CertificateResponse, error)
+	UnbindCertificate(context.Context, *CertificateRequest) (*CertificateResponse, error)
+	ListCertificates(context.Context, *emptypb.Empty) (*CertificateListResponse, error)
+	SetHistoryRetention(context.Context, *HistoryRetentionRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedUsersServer()
+}
+
+// UnimplementedUsersServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUsersServer struct{}
+
+func (UnimplementedUsersServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedUsersServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedUsersServer) Vault(context.Context, *emptypb.Empty) (*VaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vault not implemented")
+}
+func (UnimplementedUsersServer) RecordID(context.Context, *RecordRequest) (*RecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordID not implemented")
+}
+func (UnimplementedUsersServer) Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedUsersServer) Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedUsersServer) ListSessions(context.Context, *emptypb.Empty) (*SessionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedUsersServer) RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedUsersServer) VerifySecondFactor(context.Context, *SecondFactorRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySecondFactor not implemented")
+}
+func (UnimplementedUsersServer) EnrollTOTP(context.Context, *emptypb.Empty) (*TOTPEnrollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedUsersServer) ConfirmTOTP(context.Context, *TOTPCodeRequest) (*TOTPConfirmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (UnimplementedUsersServer) DisableTOTP(context.Context, *TOTPCodeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (UnimplementedUsersServer) BindCertificate(context.Context, *CertificateBindRequest) (*CertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindCertificate not implemented")
+}
+func (UnimplementedUsersServer) UnbindCertificate(context.Context, *CertificateRequest) (*CertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbindCertificate not implemented")
+}
+func (UnimplementedUsersServer) ListCertificates(context.Context, *emptypb.Empty) (*CertificateListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCertificates not implemented")
+}
+func (UnimplementedUsersServer) SetHistoryRetention(context.Context, *HistoryRetentionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHistoryRetention not implemented")
+}
+func (UnimplementedUsersServer) mustEmbedUnimplementedUsersServer() {}
+func (UnimplementedUsersServer) testEmbeddedByValue()               {}
+
+// UnsafeUsersServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UsersServer will
+// result in compilation errors.
+type UnsafeUsersServer interface {
 	mustEmbedUnimplementedUsersServer()
 }
 
-// UnimplementedUsersServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedUsersServer struct{}
-
-func (UnimplementedUsersServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+func RegisterUsersServer(s grpc.ServiceRegistrar, srv UsersServer) {
+	// If the following call pancis, it indicates UnimplementedUsersServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Users_ServiceDesc, srv)
+}
+
+func _Users_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_Vault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Vault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_Vault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Vault(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_RecordID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).RecordID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_RecordID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).RecordID(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_Refresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Refresh(ctx, req.(*RefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Logout(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ListSessions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_VerifySecondFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecondFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).VerifySecondFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_VerifySecondFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).VerifySecondFactor(ctx, req.(*SecondFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_EnrollTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).EnrollTOTP(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_ConfirmTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ConfirmTOTP(ctx, req.(*TOTPCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_DisableTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).DisableTOTP(ctx, req.(*TOTPCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_BindCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertificateBindRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).BindCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_BindCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).BindCertificate(ctx, req.(*CertificateBindRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_UnbindCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).UnbindCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_UnbindCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).UnbindCertificate(ctx, req.(*CertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ListCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ListCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_ListCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ListCertificates(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_SetHistoryRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).SetHistoryRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_SetHistoryRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).SetHistoryRetention(ctx, req.(*HistoryRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Users_ServiceDesc is the grpc.ServiceDesc for Users service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Users_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gophkeeper.Users",
+	HandlerType: (*UsersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _Users_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _Users_Login_Handler,
+		},
+		{
+			MethodName: "Vault",
+			Handler:    _Users_Vault_Handler,
+		},
+		{
+			MethodName: "RecordID",
+			Handler:    _Users_RecordID_Handler,
+		},
+		{
+			MethodName: "Refresh",
+			Handler:    _Users_Refresh_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _Users_Logout_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _Users_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _Users_RevokeSession_Handler,
+		},
+		{
+			MethodName: "VerifySecondFactor",
+			Handler:    _Users_VerifySecondFactor_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _Users_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _Users_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _Users_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "BindCertificate",
+			Handler:    _Users_BindCertificate_Handler,
+		},
+		{
+			MethodName: "UnbindCertificate",
+			Handler:    _Users_UnbindCertificate_Handler,
+		},
+		{
+			MethodName: "ListCertificates",
+			Handler:    _Users_ListCertificates_Handler,
+		},
+		{
+			MethodName: "SetHistoryRetention",
+			Handler:    _Users_SetHistoryRetention_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/gophkeeper.proto",
+}
+
+const (
+	Passwords_Get_FullMethodName          = "/gophkeeper.Passwords/Get"
+	Passwords_Add_FullMethodName          = "/gophkeeper.Passwords/Add"
+	Passwords_Update_FullMethodName       = "/gophkeeper.Passwords/Update"
+	Passwords_Delete_FullMethodName       = "/gophkeeper.Passwords/Delete"
+	Passwords_List_FullMethodName         = "/gophkeeper.Passwords/List"
+	Passwords_History_FullMethodName      = "/gophkeeper.Passwords/History"
+	Passwords_Restore_FullMethodName      = "/gophkeeper.Passwords/Restore"
+	Passwords_Rename_FullMethodName       = "/gophkeeper.Passwords/Rename"
+	Passwords_Audit_FullMethodName        = "/gophkeeper.Passwords/Audit"
+	Passwords_AuditEntries_FullMethodName = "/gophkeeper.Passwords/AuditEntries"
+	Passwords_Generate_FullMethodName     = "/gophkeeper.Passwords/Generate"
+)
+
+// PasswordsClient is the client API for Passwords service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PasswordsClient interface {
+	Get(ctx context.Context, in *PasswordRequest, opts ...grpc.CallOption) (*PasswordResponse, error)
+	Add(ctx context.Context, in *PasswordCreateRequest, opts ...grpc.CallOption) (*PasswordShortResponse, error)
+	Update(ctx context.Context, in *PasswordUpdateRequest, opts ...grpc.CallOption) (*PasswordShortResponse, error)
+	Delete(ctx context.Context, in *PasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*PasswordListResponse, error)
+	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*PasswordShortResponse, error)
+	Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*PasswordShortResponse, error)
+	Audit(ctx context.Context, in *AuditRequest, opts ...grpc.CallOption) (*AuditResponse, error)
+	AuditEntries(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AuditEntriesResponse, error)
+	Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GenerateResponse, error)
+}
+
+type passwordsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPasswordsClient(cc grpc.ClientConnInterface) PasswordsClient {
+	return &passwordsClient{cc}
+}
+
+func (c *passwordsClient) Get(ctx context.Context, in *PasswordRequest, opts ...grpc.CallOption) (*PasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordResponse)
+	err := c.cc.Invoke(ctx, Passwords_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) Add(ctx context.Context, in *PasswordCreateRequest, opts ...grpc.CallOption) (*PasswordShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordShortResponse)
+	err := c.cc.Invoke(ctx, Passwords_Add_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) Update(ctx context.Context, in *PasswordUpdateRequest, opts ...grpc.CallOption) (*PasswordShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordShortResponse)
+	err := c.cc.Invoke(ctx, Passwords_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) Delete(ctx context.Context, in *PasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Passwords_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*PasswordListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordListResponse)
+	err := c.cc.Invoke(ctx, Passwords_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, Passwords_History_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*PasswordShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordShortResponse)
+	err := c.cc.Invoke(ctx, Passwords_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*PasswordShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordShortResponse)
+	err := c.cc.Invoke(ctx, Passwords_Rename_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) Audit(ctx context.Context, in *AuditRequest, opts ...grpc.CallOption) (*AuditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditResponse)
+	err := c.cc.Invoke(ctx, Passwords_Audit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) AuditEntries(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AuditEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditEntriesResponse)
+	err := c.cc.Invoke(ctx, Passwords_AuditEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GenerateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateResponse)
+	err := c.cc.Invoke(ctx, Passwords_Generate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PasswordsServer is the server API for Passwords service.
+// All implementations must embed UnimplementedPasswordsServer
+// for forward compatibility.
+type PasswordsServer interface {
+	Get(context.Context, *PasswordRequest) (*PasswordResponse, error)
+	Add(context.Context, *PasswordCreateRequest) (*PasswordShortResponse, error)
+	Update(context.Context, *PasswordUpdateRequest) (*PasswordShortResponse, error)
+	Delete(context.Context, *PasswordRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListRequest) (*PasswordListResponse, error)
+	History(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	Restore(context.Context, *RestoreRequest) (*PasswordShortResponse, error)
+	Rename(context.Context, *RenameRequest) (*PasswordShortResponse, error)
+	Audit(context.Context, *AuditRequest) (*AuditResponse, error)
+	AuditEntries(context.Context, *emptypb.Empty) (*AuditEntriesResponse, error)
+	Generate(context.Context, *GenerateRequest) (*GenerateResponse, error)
+	mustEmbedUnimplementedPasswordsServer()
+}
+
+// UnimplementedPasswordsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPasswordsServer struct{}
+
+func (UnimplementedPasswordsServer) Get(context.Context, *PasswordRequest) (*PasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedPasswordsServer) Add(context.Context, *PasswordCreateRequest) (*PasswordShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedPasswordsServer) Update(context.Context, *PasswordUpdateRequest) (*PasswordShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedPasswordsServer) Delete(context.Context, *PasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedPasswordsServer) List(context.Context, *ListRequest) (*PasswordListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedPasswordsServer) History(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (UnimplementedPasswordsServer) Restore(context.Context, *RestoreRequest) (*PasswordShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedPasswordsServer) Rename(context.Context, *RenameRequest) (*PasswordShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (UnimplementedPasswordsServer) Audit(context.Context, *AuditRequest) (*AuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Audit not implemented")
+}
+func (UnimplementedPasswordsServer) AuditEntries(context.Context, *emptypb.Empty) (*AuditEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditEntries not implemented")
+}
+func (UnimplementedPasswordsServer) Generate(context.Context, *GenerateRequest) (*GenerateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Generate not implemented")
+}
+func (UnimplementedPasswordsServer) mustEmbedUnimplementedPasswordsServer() {}
+func (UnimplementedPasswordsServer) testEmbeddedByValue()                   {}
+
+// UnsafePasswordsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PasswordsServer will
+// result in compilation errors.
+type UnsafePasswordsServer interface {
+	mustEmbedUnimplementedPasswordsServer()
+}
+
+func RegisterPasswordsServer(s grpc.ServiceRegistrar, srv PasswordsServer) {
+	// If the following call pancis, it indicates UnimplementedPasswordsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Passwords_ServiceDesc, srv)
+}
+
+func _Passwords_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passwords_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).Get(ctx, req.(*PasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passwords_Add_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).Add(ctx, req.(*PasswordCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passwords_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).Update(ctx, req.(*PasswordUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passwords_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).Delete(ctx, req.(*PasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passwords_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passwords_History_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).History(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passwords_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passwords_Rename_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).Rename(ctx, req.(*RenameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_Audit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).Audit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passwords_Audit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).Audit(ctx, req.(*AuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_AuditEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).AuditEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passwords_AuditEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).AuditEntries(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_Generate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).Generate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passwords_Generate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).Generate(ctx, req.(*GenerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Passwords_ServiceDesc is the grpc.ServiceDesc for Passwords service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Passwords_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gophkeeper.Passwords",
+	HandlerType: (*PasswordsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Passwords_Get_Handler,
+		},
+		{
+			MethodName: "Add",
+			Handler:    _Passwords_Add_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Passwords_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Passwords_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Passwords_List_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _Passwords_History_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _Passwords_Restore_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _Passwords_Rename_Handler,
+		},
+		{
+			MethodName: "Audit",
+			Handler:    _Passwords_Audit_Handler,
+		},
+		{
+			MethodName: "AuditEntries",
+			Handler:    _Passwords_AuditEntries_Handler,
+		},
+		{
+			MethodName: "Generate",
+			Handler:    _Passwords_Generate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/gophkeeper.proto",
+}
+
+const (
+	Cards_Get_FullMethodName     = "/gophkeeper.Cards/Get"
+	Cards_Add_FullMethodName     = "/gophkeeper.Cards/Add"
+	Cards_Update_FullMethodName  = "/gophkeeper.Cards/Update"
+	Cards_Delete_FullMethodName  = "/gophkeeper.Cards/Delete"
+	Cards_List_FullMethodName    = "/gophkeeper.Cards/List"
+	Cards_History_FullMethodName = "/gophkeeper.Cards/History"
+	Cards_Restore_FullMethodName = "/gophkeeper.Cards/Restore"
+	Cards_Rename_FullMethodName  = "/gophkeeper.Cards/Rename"
+	Cards_Reveal_FullMethodName  = "/gophkeeper.Cards/Reveal"
+)
+
+// CardsClient is the client API for Cards service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CardsClient interface {
+	Get(ctx context.Context, in *CardRequest, opts ...grpc.CallOption) (*CardResponse, error)
+	Add(ctx context.Context, in *CardCreateRequest, opts ...grpc.CallOption) (*CardShortResponse, error)
+	Update(ctx context.Context, in *CardUpdateRequest, opts ...grpc.CallOption) (*CardShortResponse, error)
+	Delete(ctx context.Context, in *CardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*CardListResponse, error)
+	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*CardShortResponse, error)
+	Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*CardShortResponse, error)
+	Reveal(ctx context.Context, in *CardRequest, opts ...grpc.CallOption) (*CardResponse, error)
+}
+
+type cardsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCardsClient(cc grpc.ClientConnInterface) CardsClient {
+	return &cardsClient{cc}
+}
+
+func (c *cardsClient) Get(ctx context.Context, in *CardRequest, opts ...grpc.CallOption) (*CardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardResponse)
+	err := c.cc.Invoke(ctx, Cards_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardsClient) Add(ctx context.Context, in *CardCreateRequest, opts ...grpc.CallOption) (*CardShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardShortResponse)
+	err := c.cc.Invoke(ctx, Cards_Add_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardsClient) Update(ctx context.Context, in *CardUpdateRequest, opts ...grpc.CallOption) (*CardShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardShortResponse)
+	err := c.cc.Invoke(ctx, Cards_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardsClient) Delete(ctx context.Context, in *CardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Cards_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardsClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*CardListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardListResponse)
+	err := c.cc.Invoke(ctx, Cards_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardsClient) History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, Cards_History_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardsClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*CardShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardShortResponse)
+	err := c.cc.Invoke(ctx, Cards_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardsClient) Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*CardShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardShortResponse)
+	err := c.cc.Invoke(ctx, Cards_Rename_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardsClient) Reveal(ctx context.Context, in *CardRequest, opts ...grpc.CallOption) (*CardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardResponse)
+	err := c.cc.Invoke(ctx, Cards_Reveal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CardsServer is the server API for Cards service.
+// All implementations must embed UnimplementedCardsServer
+// for forward compatibility.
+type CardsServer interface {
+	Get(context.Context, *CardRequest) (*CardResponse, error)
+	Add(context.Context, *CardCreateRequest) (*CardShortResponse, error)
+	Update(context.Context, *CardUpdateRequest) (*CardShortResponse, error)
+	Delete(context.Context, *CardRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListRequest) (*CardListResponse, error)
+	History(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	Restore(context.Context, *RestoreRequest) (*CardShortResponse, error)
+	Rename(context.Context, *RenameRequest) (*CardShortResponse, error)
+	Reveal(context.Context, *CardRequest) (*CardResponse, error)
+	mustEmbedUnimplementedCardsServer()
+}
+
+// UnimplementedCardsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCardsServer struct{}
+
+func (UnimplementedCardsServer) Get(context.Context, *CardRequest) (*CardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedCardsServer) Add(context.Context, *CardCreateRequest) (*CardShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedCardsServer) Update(context.Context, *CardUpdateRequest) (*CardShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedCardsServer) Delete(context.Context, *CardRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedCardsServer) List(context.Context, *ListRequest) (*CardListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedCardsServer) History(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (UnimplementedCardsServer) Restore(context.Context, *RestoreRequest) (*CardShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedCardsServer) Rename(context.Context, *RenameRequest) (*CardShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (UnimplementedCardsServer) Reveal(context.Context, *CardRequest) (*CardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reveal not implemented")
+}
+func (UnimplementedCardsServer) mustEmbedUnimplementedCardsServer() {}
+func (UnimplementedCardsServer) testEmbeddedByValue()               {}
+
+// UnsafeCardsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CardsServer will
+// result in compilation errors.
+type UnsafeCardsServer interface {
+	mustEmbedUnimplementedCardsServer()
+}
+
+func RegisterCardsServer(s grpc.ServiceRegistrar, srv CardsServer) {
+	// If the following call pancis, it indicates UnimplementedCardsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Cards_ServiceDesc, srv)
+}
+
+func _Cards_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardsServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cards_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardsServer).Get(ctx, req.(*CardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cards_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardsServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cards_Add_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardsServer).Add(ctx, req.(*CardCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cards_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardsServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cards_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardsServer).Update(ctx, req.(*CardUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cards_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardsServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cards_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardsServer).Delete(ctx, req.(*CardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cards_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cards_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardsServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cards_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardsServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cards_History_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardsServer).History(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cards_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardsServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cards_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardsServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cards_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardsServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cards_Rename_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardsServer).Rename(ctx, req.(*RenameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cards_Reveal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardsServer).Reveal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cards_Reveal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardsServer).Reveal(ctx, req.(*CardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Cards_ServiceDesc is the grpc.ServiceDesc for Cards service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Cards_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gophkeeper.Cards",
+	HandlerType: (*CardsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Cards_Get_Handler,
+		},
+		{
+			MethodName: "Add",
+			Handler:    _Cards_Add_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Cards_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Cards_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Cards_List_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _Cards_History_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _Cards_Restore_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _Cards_Rename_Handler,
+		},
+		{
+			MethodName: "Reveal",
+			Handler:    _Cards_Reveal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/gophkeeper.proto",
+}
+
+const (
+	Binaries_Get_FullMethodName      = "/gophkeeper.Binaries/Get"
+	Binaries_Add_FullMethodName      = "/gophkeeper.Binaries/Add"
+	Binaries_Update_FullMethodName   = "/gophkeeper.Binaries/Update"
+	Binaries_Delete_FullMethodName   = "/gophkeeper.Binaries/Delete"
+	Binaries_List_FullMethodName     = "/gophkeeper.Binaries/List"
+	Binaries_Upload_FullMethodName   = "/gophkeeper.Binaries/Upload"
+	Binaries_Download_FullMethodName = "/gophkeeper.Binaries/Download"
+	Binaries_History_FullMethodName  = "/gophkeeper.Binaries/History"
+	Binaries_Restore_FullMethodName  = "/gophkeeper.Binaries/Restore"
+	Binaries_Rename_FullMethodName   = "/gophkeeper.Binaries/Rename"
+)
+
+// BinariesClient is the client API for Binaries service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BinariesClient interface {
+	Get(ctx context.Context, in *BinariesRequest, opts ...grpc.CallOption) (*BinariesResponse, error)
+	Add(ctx context.Context, in *BinariesCreateRequest, opts ...grpc.CallOption) (*BinariesShortResponse, error)
+	Update(ctx context.Context, in *BinariesUpdateRequest, opts ...grpc.CallOption) (*BinariesShortResponse, error)
+	Delete(ctx context.Context, in *BinariesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*BinariesListResponse, error)
+	Upload(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[BinaryUploadRequest, BinariesShortResponse], error)
+	Download(ctx context.Context, in *BinariesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BinaryDownloadResponse], error)
+	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*BinariesShortResponse, error)
+	Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*BinariesShortResponse, error)
+}
+
+type binariesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBinariesClient(cc grpc.ClientConnInterface) BinariesClient {
+	return &binariesClient{cc}
+}
+
+func (c *binariesClient) Get(ctx context.Context, in *BinariesRequest, opts ...grpc.CallOption) (*BinariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BinariesResponse)
+	err := c.cc.Invoke(ctx, Binaries_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binariesClient) Add(ctx context.Context, in *BinariesCreateRequest, opts ...grpc.CallOption) (*BinariesShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BinariesShortResponse)
+	err := c.cc.Invoke(ctx, Binaries_Add_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binariesClient) Update(ctx context.Context, in *BinariesUpdateRequest, opts ...grpc.CallOption) (*BinariesShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BinariesShortResponse)
+	err := c.cc.Invoke(ctx, Binaries_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binariesClient) Delete(ctx context.Context, in *BinariesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Binaries_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binariesClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*BinariesListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BinariesListResponse)
+	err := c.cc.Invoke(ctx, Binaries_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binariesClient) Upload(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[BinaryUploadRequest, BinariesShortResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Binaries_ServiceDesc.Streams[0], Binaries_Upload_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BinaryUploadRequest, BinariesShortResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Binaries_UploadClient = grpc.ClientStreamingClient[BinaryUploadRequest, BinariesShortResponse]
+
+func (c *binariesClient) Download(ctx context.Context, in *BinariesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BinaryDownloadResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Binaries_ServiceDesc.Streams[1], Binaries_Download_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BinariesRequest, BinaryDownloadResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Binaries_DownloadClient = grpc.ServerStreamingClient[BinaryDownloadResponse]
+
+func (c *binariesClient) History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, Binaries_History_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binariesClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*BinariesShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BinariesShortResponse)
+	err := c.cc.Invoke(ctx, Binaries_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binariesClient) Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*BinariesShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BinariesShortResponse)
+	err := c.cc.Invoke(ctx, Binaries_Rename_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BinariesServer is the server API for Binaries service.
+// All implementations must embed UnimplementedBinariesServer
+// for forward compatibility.
+type BinariesServer interface {
+	Get(context.Context, *BinariesRequest) (*BinariesResponse, error)
+	Add(context.Context, *BinariesCreateRequest) (*BinariesShortResponse, error)
+	Update(context.Context, *BinariesUpdateRequest) (*BinariesShortResponse, error)
+	Delete(context.Context, *BinariesRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListRequest) (*BinariesListResponse, error)
+	Upload(grpc.ClientStreamingServer[BinaryUploadRequest, BinariesShortResponse]) error
+	Download(*BinariesRequest, grpc.ServerStreamingServer[BinaryDownloadResponse]) error
+	History(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	Restore(context.Context, *RestoreRequest) (*BinariesShortResponse, error)
+	Rename(context.Context, *RenameRequest) (*BinariesShortResponse, error)
+	mustEmbedUnimplementedBinariesServer()
+}
+
+// UnimplementedBinariesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBinariesServer struct{}
+
+func (UnimplementedBinariesServer) Get(context.Context, *BinariesRequest) (*BinariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedBinariesServer) Add(context.Context, *BinariesCreateRequest) (*BinariesShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedBinariesServer) Update(context.Context, *BinariesUpdateRequest) (*BinariesShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedBinariesServer) Delete(context.Context, *BinariesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedBinariesServer) List(context.Context, *ListRequest) (*BinariesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedBinariesServer) Upload(grpc.ClientStreamingServer[BinaryUploadRequest, BinariesShortResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
+}
+func (UnimplementedBinariesServer) Download(*BinariesRequest, grpc.ServerStreamingServer[BinaryDownloadResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Download not implemented")
+}
+func (UnimplementedBinariesServer) History(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (UnimplementedBinariesServer) Restore(context.Context, *RestoreRequest) (*BinariesShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedBinariesServer) Rename(context.Context, *RenameRequest) (*BinariesShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (UnimplementedBinariesServer) mustEmbedUnimplementedBinariesServer() {}
+func (UnimplementedBinariesServer) testEmbeddedByValue()                  {}
+
+// UnsafeBinariesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BinariesServer will
+// result in compilation errors.
+type UnsafeBinariesServer interface {
+	mustEmbedUnimplementedBinariesServer()
+}
+
+func RegisterBinariesServer(s grpc.ServiceRegistrar, srv BinariesServer) {
+	// If the following call pancis, it indicates UnimplementedBinariesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Binaries_ServiceDesc, srv)
+}
+
+func _Binaries_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BinariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinariesServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Binaries_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinariesServer).Get(ctx, req.(*BinariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Binaries_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BinariesCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinariesServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Binaries_Add_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinariesServer).Add(ctx, req.(*BinariesCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Binaries_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BinariesUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinariesServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Binaries_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinariesServer).Update(ctx, req.(*BinariesUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Binaries_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BinariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinariesServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Binaries_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinariesServer).Delete(ctx, req.(*BinariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Binaries_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinariesServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Binaries_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinariesServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Binaries_Upload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BinariesServer).Upload(&grpc.GenericServerStream[BinaryUploadRequest, BinariesShortResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Binaries_UploadServer = grpc.ClientStreamingServer[BinaryUploadRequest, BinariesShortResponse]
+
+func _Binaries_Download_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BinariesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BinariesServer).Download(m, &grpc.GenericServerStream[BinariesRequest, BinaryDownloadResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Binaries_DownloadServer = grpc.ServerStreamingServer[BinaryDownloadResponse]
+
+func _Binaries_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinariesServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Binaries_History_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinariesServer).History(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Binaries_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinariesServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Binaries_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinariesServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Binaries_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinariesServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Binaries_Rename_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinariesServer).Rename(ctx, req.(*RenameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Binaries_ServiceDesc is the grpc.ServiceDesc for Binaries service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Binaries_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gophkeeper.Binaries",
+	HandlerType: (*BinariesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Binaries_Get_Handler,
+		},
+		{
+			MethodName: "Add",
+			Handler:    _Binaries_Add_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Binaries_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Binaries_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Binaries_List_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _Binaries_History_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _Binaries_Restore_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _Binaries_Rename_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Upload",
+			Handler:       _Binaries_Upload_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Download",
+			Handler:       _Binaries_Download_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/gophkeeper.proto",
+}
+
+const (
+	Notes_Get_FullMethodName     = "/gophkeeper.Notes/Get"
+	Notes_Add_FullMethodName     = "/gophkeeper.Notes/Add"
+	Notes_Update_FullMethodName  = "/gophkeeper.Notes/Update"
+	Notes_Delete_FullMethodName  = "/gophkeeper.Notes/Delete"
+	Notes_List_FullMethodName    = "/gophkeeper.Notes/List"
+	Notes_History_FullMethodName = "/gophkeeper.Notes/History"
+	Notes_Restore_FullMethodName = "/gophkeeper.Notes/Restore"
+	Notes_Rename_FullMethodName  = "/gophkeeper.Notes/Rename"
+)
+
+// NotesClient is the client API for Notes service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NotesClient interface {
+	Get(ctx context.Context, in *NoteRequest, opts ...grpc.CallOption) (*NoteResponse, error)
+	Add(ctx context.Context, in *NoteCreateRequest, opts ...grpc.CallOption) (*NoteShortResponse, error)
+	Update(ctx context.Context, in *NoteUpdateRequest, opts ...grpc.CallOption) (*NoteShortResponse, error)
+	Delete(ctx context.Context, in *NoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*NoteListResponse, error)
+	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*NoteShortResponse, error)
+	Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*NoteShortResponse, error)
+}
+
+type notesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotesClient(cc grpc.ClientConnInterface) NotesClient {
+	return &notesClient{cc}
+}
+
+func (c *notesClient) Get(ctx context.Context, in *NoteRequest, opts ...grpc.CallOption) (*NoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NoteResponse)
+	err := c.cc.Invoke(ctx, Notes_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesClient) Add(ctx context.Context, in *NoteCreateRequest, opts ...grpc.CallOption) (*NoteShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NoteShortResponse)
+	err := c.cc.Invoke(ctx, Notes_Add_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesClient) Update(ctx context.Context, in *NoteUpdateRequest, opts ...grpc.CallOption) (*NoteShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NoteShortResponse)
+	err := c.cc.Invoke(ctx, Notes_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesClient) Delete(ctx context.Context, in *NoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Notes_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*NoteListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NoteListResponse)
+	err := c.cc.Invoke(ctx, Notes_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesClient) History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, Notes_History_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*NoteShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NoteShortResponse)
+	err := c.cc.Invoke(ctx, Notes_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesClient) Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*NoteShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NoteShortResponse)
+	err := c.cc.Invoke(ctx, Notes_Rename_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotesServer is the server API for Notes service.
+// All implementations must embed UnimplementedNotesServer
+// for forward compatibility.
+type NotesServer interface {
+	Get(context.Context, *NoteRequest) (*NoteResponse, error)
+	Add(context.Context, *NoteCreateRequest) (*NoteShortResponse, error)
+	Update(context.Context, *NoteUpdateRequest) (*NoteShortResponse, error)
+	Delete(context.Context, *NoteRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListRequest) (*NoteListResponse, error)
+	History(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	Restore(context.Context, *RestoreRequest) (*NoteShortResponse, error)
+	Rename(context.Context, *RenameRequest) (*NoteShortResponse, error)
+	mustEmbedUnimplementedNotesServer()
+}
+
+// UnimplementedNotesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotesServer struct{}
+
+func (UnimplementedNotesServer) Get(context.Context, *NoteRequest) (*NoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedNotesServer) Add(context.Context, *NoteCreateRequest) (*NoteShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedNotesServer) Update(context.Context, *NoteUpdateRequest) (*NoteShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedNotesServer) Delete(context.Context, *NoteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedNotesServer) List(context.Context, *ListRequest) (*NoteListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedNotesServer) History(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (UnimplementedNotesServer) Restore(context.Context, *RestoreRequest) (*NoteShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedNotesServer) Rename(context.Context, *RenameRequest) (*NoteShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (UnimplementedNotesServer) mustEmbedUnimplementedNotesServer() {}
+func (UnimplementedNotesServer) testEmbeddedByValue()               {}
+
+// UnsafeNotesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotesServer will
+// result in compilation errors.
+type UnsafeNotesServer interface {
+	mustEmbedUnimplementedNotesServer()
+}
+
+func RegisterNotesServer(s grpc.ServiceRegistrar, srv NotesServer) {
+	// If the following call pancis, it indicates UnimplementedNotesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Notes_ServiceDesc, srv)
+}
+
+func _Notes_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notes_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServer).Get(ctx, req.(*NoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notes_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notes_Add_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServer).Add(ctx, req.(*NoteCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notes_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notes_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServer).Update(ctx, req.(*NoteUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notes_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notes_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServer).Delete(ctx, req.(*NoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notes_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notes_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notes_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notes_History_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServer).History(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notes_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notes_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notes_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notes_Rename_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServer).Rename(ctx, req.(*RenameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Notes_ServiceDesc is the grpc.ServiceDesc for Notes service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Notes_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gophkeeper.Notes",
+	HandlerType: (*NotesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Notes_Get_Handler,
+		},
+		{
+			MethodName: "Add",
+			Handler:    _Notes_Add_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Notes_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Notes_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Notes_List_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _Notes_History_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _Notes_Restore_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _Notes_Rename_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/gophkeeper.proto",
+}
+
+const (
+	OTP_Get_FullMethodName          = "/gophkeeper.OTP/Get"
+	OTP_Add_FullMethodName          = "/gophkeeper.OTP/Add"
+	OTP_Update_FullMethodName       = "/gophkeeper.OTP/Update"
+	OTP_Delete_FullMethodName       = "/gophkeeper.OTP/Delete"
+	OTP_List_FullMethodName         = "/gophkeeper.OTP/List"
+	OTP_History_FullMethodName      = "/gophkeeper.OTP/History"
+	OTP_Restore_FullMethodName      = "/gophkeeper.OTP/Restore"
+	OTP_Rename_FullMethodName       = "/gophkeeper.OTP/Rename"
+	OTP_GenerateCode_FullMethodName = "/gophkeeper.OTP/GenerateCode"
+)
+
+// OTPClient is the client API for OTP service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OTPClient interface {
+	Get(ctx context.Context, in *OTPRequest, opts ...grpc.CallOption) (*OTPResponse, error)
+	Add(ctx context.Context, in *OTPCreateRequest, opts ...grpc.CallOption) (*OTPShortResponse, error)
+	Update(ctx context.Context, in *OTPUpdateRequest, opts ...grpc.CallOption) (*OTPShortResponse, error)
+	Delete(ctx context.Context, in *OTPRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*OTPListResponse, error)
+	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*OTPShortResponse, error)
+	Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*OTPShortResponse, error)
+	GenerateCode(ctx context.Context, in *OTPRequest, opts ...grpc.CallOption) (*OTPCodeResponse, error)
+}
+
+type oTPClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOTPClient(cc grpc.ClientConnInterface) OTPClient {
+	return &oTPClient{cc}
+}
+
+func (c *oTPClient) Get(ctx context.Context, in *OTPRequest, opts ...grpc.CallOption) (*OTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OTPResponse)
+	err := c.cc.Invoke(ctx, OTP_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oTPClient) Add(ctx context.Context, in *OTPCreateRequest, opts ...grpc.CallOption) (*OTPShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OTPShortResponse)
+	err := c.cc.Invoke(ctx, OTP_Add_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oTPClient) Update(ctx context.Context, in *OTPUpdateRequest, opts ...grpc.CallOption) (*OTPShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OTPShortResponse)
+	err := c.cc.Invoke(ctx, OTP_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oTPClient) Delete(ctx context.Context, in *OTPRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OTP_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oTPClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*OTPListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OTPListResponse)
+	err := c.cc.Invoke(ctx, OTP_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oTPClient) History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, OTP_History_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oTPClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*OTPShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OTPShortResponse)
+	err := c.cc.Invoke(ctx, OTP_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oTPClient) Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*OTPShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OTPShortResponse)
+	err := c.cc.Invoke(ctx, OTP_Rename_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oTPClient) GenerateCode(ctx context.Context, in *OTPRequest, opts ...grpc.CallOption) (*OTPCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OTPCodeResponse)
+	err := c.cc.Invoke(ctx, OTP_GenerateCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OTPServer is the server API for OTP service.
+// All implementations must embed UnimplementedOTPServer
+// for forward compatibility.
+type OTPServer interface {
+	Get(context.Context, *OTPRequest) (*OTPResponse, error)
+	Add(context.Context, *OTPCreateRequest) (*OTPShortResponse, error)
+	Update(context.Context, *OTPUpdateRequest) (*OTPShortResponse, error)
+	Delete(context.Context, *OTPRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListRequest) (*OTPListResponse, error)
+	History(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	Restore(context.Context, *RestoreRequest) (*OTPShortResponse, error)
+	Rename(context.Context, *RenameRequest) (*OTPShortResponse, error)
+	GenerateCode(context.Context, *OTPRequest) (*OTPCodeResponse, error)
+	mustEmbedUnimplementedOTPServer()
+}
+
+// UnimplementedOTPServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOTPServer struct{}
+
+func (UnimplementedOTPServer) Get(context.Context, *OTPRequest) (*OTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedOTPServer) Add(context.Context, *OTPCreateRequest) (*OTPShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedOTPServer) Update(context.Context, *OTPUpdateRequest) (*OTPShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedOTPServer) Delete(context.Context, *OTPRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedOTPServer) List(context.Context, *ListRequest) (*OTPListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedOTPServer) History(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (UnimplementedOTPServer) Restore(context.Context, *RestoreRequest) (*OTPShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedOTPServer) Rename(context.Context, *RenameRequest) (*OTPShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (UnimplementedOTPServer) GenerateCode(context.Context, *OTPRequest) (*OTPCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateCode not implemented")
+}
+func (UnimplementedOTPServer) mustEmbedUnimplementedOTPServer() {}
+func (UnimplementedOTPServer) testEmbeddedByValue()             {}
+
+// UnsafeOTPServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OTPServer will
+// result in compilation errors.
+type UnsafeOTPServer interface {
+	mustEmbedUnimplementedOTPServer()
+}
+
+func RegisterOTPServer(s grpc.ServiceRegistrar, srv OTPServer) {
+	// If the following call pancis, it indicates UnimplementedOTPServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OTP_ServiceDesc, srv)
+}
+
+func _OTP_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OTPServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OTP_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OTPServer).Get(ctx, req.(*OTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OTP_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OTPCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OTPServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OTP_Add_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OTPServer).Add(ctx, req.(*OTPCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OTP_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OTPUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OTPServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OTP_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OTPServer).Update(ctx, req.(*OTPUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OTP_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OTPServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OTP_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OTPServer).Delete(ctx, req.(*OTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUsersServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+
+func _OTP_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OTPServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OTP_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OTPServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUsersServer) mustEmbedUnimplementedUsersServer() {}
-func (UnimplementedUsersServer) testEmbeddedByValue()               {}
 
-// UnsafeUsersServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to UsersServer will
-// result in compilation errors.
-type UnsafeUsersServer interface {
-	mustEmbedUnimplementedUsersServer()
+func _OTP_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OTPServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OTP_History_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OTPServer).History(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUsersServer(s grpc.ServiceRegistrar, srv UsersServer) {
-	// If the following call pancis, it indicates UnimplementedUsersServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _OTP_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Users_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(OTPServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OTP_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OTPServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Users_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _OTP_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServer).Register(ctx, in)
+		return srv.(OTPServer).Rename(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Users_Register_FullMethodName,
+		FullMethod: OTP_Rename_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(OTPServer).Rename(ctx, req.(*RenameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Users_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _OTP_GenerateCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OTPRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServer).Login(ctx, in)
+		return srv.(OTPServer).GenerateCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Users_Login_FullMethodName,
+		FullMethod: OTP_GenerateCode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServer).Login(ctx, req.(*LoginRequest))
+		return srv.(OTPServer).GenerateCode(ctx, req.(*OTPRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Users_ServiceDesc is the grpc.ServiceDesc for Users service.
+// OTP_ServiceDesc is the grpc.ServiceDesc for OTP service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var Users_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "gophkeeper.Users",
-	HandlerType: (*UsersServer)(nil),
+var OTP_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gophkeeper.OTP",
+	HandlerType: (*OTPServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Register",
-			Handler:    _Users_Register_Handler,
+			MethodName: "Get",
+			Handler:    _OTP_Get_Handler,
 		},
 		{
-			MethodName: "Login",
-			Handler:    _Users_Login_Handler,
+			MethodName: "Add",
+			Handler:    _OTP_Add_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _OTP_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _OTP_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _OTP_List_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _OTP_History_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _OTP_Restore_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _OTP_Rename_Handler,
+		},
+		{
+			MethodName: "GenerateCode",
+			Handler:    _OTP_GenerateCode_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -160,215 +2795,405 @@ var Users_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	Passwords_Get_FullMethodName    = "/gophkeeper.Passwords/Get"
-	Passwords_Add_FullMethodName    = "/gophkeeper.Passwords/Add"
-	Passwords_Update_FullMethodName = "/gophkeeper.Passwords/Update"
-	Passwords_Delete_FullMethodName = "/gophkeeper.Passwords/Delete"
+	SSHKeys_Get_FullMethodName        = "/gophkeeper.SSHKeys/Get"
+	SSHKeys_Add_FullMethodName        = "/gophkeeper.SSHKeys/Add"
+	SSHKeys_Update_FullMethodName     = "/gophkeeper.SSHKeys/Update"
+	SSHKeys_Delete_FullMethodName     = "/gophkeeper.SSHKeys/Delete"
+	SSHKeys_List_FullMethodName       = "/gophkeeper.SSHKeys/List"
+	SSHKeys_PublicKeys_FullMethodName = "/gophkeeper.SSHKeys/PublicKeys"
+	SSHKeys_History_FullMethodName    = "/gophkeeper.SSHKeys/History"
+	SSHKeys_Restore_FullMethodName    = "/gophkeeper.SSHKeys/Restore"
+	SSHKeys_Rename_FullMethodName     = "/gophkeeper.SSHKeys/Rename"
 )
 
-// PasswordsClient is the client API for Passwords service.
+// SSHKeysClient is the client API for SSHKeys service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type PasswordsClient interface {
-	Get(ctx context.Context, in *PasswordRequest, opts ...grpc.CallOption) (*PasswordResponse, error)
-	Add(ctx context.Context, in *PasswordCreateRequest, opts ...grpc.CallOption) (*PasswordShortResponse, error)
-	Update(ctx context.Context, in *PasswordUpdateRequest, opts ...grpc.CallOption) (*PasswordShortResponse, error)
-	Delete(ctx context.Context, in *PasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-}
-
-type passwordsClient struct {
+type SSHKeysClient interface {
+	Get(ctx context.Context, in *SSHKeyRequest, opts ...grpc.CallOption) (*SSHKeyResponse, error)
+	Add(ctx context.Context, in *SSHKeyCreateRequest, opts ...grpc.CallOption) (*SSHKeyShortResponse, error)
+	Update(ctx context.Context, in *SSHKeyUpdateRequest, opts ...grpc.CallOption) (*SSHKeyShortResponse, error)
+	Delete(ctx context.Context, in *SSHKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*SSHKeyListResponse, error)
+	PublicKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SSHPublicKeysResponse, error)
+	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*SSHKeyShortResponse, error)
+	Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*SSHKeyShortResponse, error)
+}
+
+type sSHKeysClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewPasswordsClient(cc grpc.ClientConnInterface) PasswordsClient {
-	return &passwordsClient{cc}
+func NewSSHKeysClient(cc grpc.ClientConnInterface) SSHKeysClient {
+	return &sSHKeysClient{cc}
 }
 
-func (c *passwordsClient) Get(ctx context.Context, in *PasswordRequest, opts ...grpc.CallOption) (*PasswordResponse, error) {
+func (c *sSHKeysClient) Get(ctx context.Context, in *SSHKeyRequest, opts ...grpc.CallOption) (*SSHKeyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(PasswordResponse)
-	err := c.cc.Invoke(ctx, Passwords_Get_FullMethodName, in, out, cOpts...)
+	out := new(SSHKeyResponse)
+	err := c.cc.Invoke(ctx, SSHKeys_Get_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *passwordsClient) Add(ctx context.Context, in *PasswordCreateRequest, opts ...grpc.CallOption) (*PasswordShortResponse, error) {
+func (c *sSHKeysClient) Add(ctx context.Context, in *SSHKeyCreateRequest, opts ...grpc.CallOption) (*SSHKeyShortResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(PasswordShortResponse)
-	err := c.cc.Invoke(ctx, Passwords_Add_FullMethodName, in, out, cOpts...)
+	out := new(SSHKeyShortResponse)
+	err := c.cc.Invoke(ctx, SSHKeys_Add_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *passwordsClient) Update(ctx context.Context, in *PasswordUpdateRequest, opts ...grpc.CallOption) (*PasswordShortResponse, error) {
+func (c *sSHKeysClient) Update(ctx context.Context, in *SSHKeyUpdateRequest, opts ...grpc.CallOption) (*SSHKeyShortResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(PasswordShortResponse)
-	err := c.cc.Invoke(ctx, Passwords_Update_FullMethodName, in, out, cOpts...)
+	out := new(SSHKeyShortResponse)
+	err := c.cc.Invoke(ctx, SSHKeys_Update_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *passwordsClient) Delete(ctx context.Context, in *PasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *sSHKeysClient) Delete(ctx context.Context, in *SSHKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Passwords_Delete_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, SSHKeys_Delete_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// PasswordsServer is the server API for Passwords service.
-// All implementations must embed UnimplementedPasswordsServer
-// for forward compatibility.
-type PasswordsServer interface {
-	Get(context.Context, *PasswordRequest) (*PasswordResponse, error)
-	Add(context.Context, *PasswordCreateRequest) (*PasswordShortResponse, error)
-	Update(context.Context, *PasswordUpdateRequest) (*PasswordShortResponse, error)
-	Delete(context.Context, *PasswordRequest) (*emptypb.Empty, error)
-	mustEmbedUnimplementedPasswordsServer()
+func (c *sSHKeysClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*SSHKeyListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSHKeyListResponse)
+	err := c.cc.Invoke(ctx, SSHKeys_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedPasswordsServer must be embedded to have
+func (c *sSHKeysClient) PublicKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SSHPublicKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSHPublicKeysResponse)
+	err := c.cc.Invoke(ctx, SSHKeys_PublicKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSHKeysClient) History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, SSHKeys_History_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSHKeysClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*SSHKeyShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSHKeyShortResponse)
+	err := c.cc.Invoke(ctx, SSHKeys_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSHKeysClient) Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*SSHKeyShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSHKeyShortResponse)
+	err := c.cc.Invoke(ctx, SSHKeys_Rename_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SSHKeysServer is the server API for SSHKeys service.
+// All implementations must embed UnimplementedSSHKeysServer
+// for forward compatibility.
+type SSHKeysServer interface {
+	Get(context.Context, *SSHKeyRequest) (*SSHKeyResponse, error)
+	Add(context.Context, *SSHKeyCreateRequest) (*SSHKeyShortResponse, error)
+	Update(context.Context, *SSHKeyUpdateRequest) (*SSHKeyShortResponse, error)
+	Delete(context.Context, *SSHKeyRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListRequest) (*SSHKeyListResponse, error)
+	PublicKeys(context.Context, *emptypb.Empty) (*SSHPublicKeysResponse, error)
+	History(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	Restore(context.Context, *RestoreRequest) (*SSHKeyShortResponse, error)
+	Rename(context.Context, *RenameRequest) (*SSHKeyShortResponse, error)
+	mustEmbedUnimplementedSSHKeysServer()
+}
+
+// UnimplementedSSHKeysServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedPasswordsServer struct{}
+type UnimplementedSSHKeysServer struct{}
 
-func (UnimplementedPasswordsServer) Get(context.Context, *PasswordRequest) (*PasswordResponse, error) {
+func (UnimplementedSSHKeysServer) Get(context.Context, *SSHKeyRequest) (*SSHKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedPasswordsServer) Add(context.Context, *PasswordCreateRequest) (*PasswordShortResponse, error) {
+func (UnimplementedSSHKeysServer) Add(context.Context, *SSHKeyCreateRequest) (*SSHKeyShortResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
 }
-func (UnimplementedPasswordsServer) Update(context.Context, *PasswordUpdateRequest) (*PasswordShortResponse, error) {
+func (UnimplementedSSHKeysServer) Update(context.Context, *SSHKeyUpdateRequest) (*SSHKeyShortResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedPasswordsServer) Delete(context.Context, *PasswordRequest) (*emptypb.Empty, error) {
+func (UnimplementedSSHKeysServer) Delete(context.Context, *SSHKeyRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedPasswordsServer) mustEmbedUnimplementedPasswordsServer() {}
-func (UnimplementedPasswordsServer) testEmbeddedByValue()                   {}
+func (UnimplementedSSHKeysServer) List(context.Context, *ListRequest) (*SSHKeyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedSSHKeysServer) PublicKeys(context.Context, *emptypb.Empty) (*SSHPublicKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublicKeys not implemented")
+}
+func (UnimplementedSSHKeysServer) History(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (UnimplementedSSHKeysServer) Restore(context.Context, *RestoreRequest) (*SSHKeyShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedSSHKeysServer) Rename(context.Context, *RenameRequest) (*SSHKeyShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (UnimplementedSSHKeysServer) mustEmbedUnimplementedSSHKeysServer() {}
+func (UnimplementedSSHKeysServer) testEmbeddedByValue()                 {}
 
-// UnsafePasswordsServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PasswordsServer will
+// UnsafeSSHKeysServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SSHKeysServer will
 // result in compilation errors.
-type UnsafePasswordsServer interface {
-	mustEmbedUnimplementedPasswordsServer()
+type UnsafeSSHKeysServer interface {
+	mustEmbedUnimplementedSSHKeysServer()
 }
 
-func RegisterPasswordsServer(s grpc.ServiceRegistrar, srv PasswordsServer) {
-	// If the following call pancis, it indicates UnimplementedPasswordsServer was
+func RegisterSSHKeysServer(s grpc.ServiceRegistrar, srv SSHKeysServer) {
+	// If the following call pancis, it indicates UnimplementedSSHKeysServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&Passwords_ServiceDesc, srv)
+	s.RegisterService(&SSHKeys_ServiceDesc, srv)
+}
+
+func _SSHKeys_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSHKeysServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSHKeys_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSHKeysServer).Get(ctx, req.(*SSHKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSHKeys_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHKeyCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSHKeysServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSHKeys_Add_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSHKeysServer).Add(ctx, req.(*SSHKeyCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSHKeys_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHKeyUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSHKeysServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSHKeys_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSHKeysServer).Update(ctx, req.(*SSHKeyUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSHKeys_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSHKeysServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSHKeys_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSHKeysServer).Delete(ctx, req.(*SSHKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSHKeys_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSHKeysServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSHKeys_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSHKeysServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Passwords_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PasswordRequest)
+func _SSHKeys_PublicKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PasswordsServer).Get(ctx, in)
+		return srv.(SSHKeysServer).PublicKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Passwords_Get_FullMethodName,
+		FullMethod: SSHKeys_PublicKeys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PasswordsServer).Get(ctx, req.(*PasswordRequest))
+		return srv.(SSHKeysServer).PublicKeys(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Passwords_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PasswordCreateRequest)
+func _SSHKeys_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PasswordsServer).Add(ctx, in)
+		return srv.(SSHKeysServer).History(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Passwords_Add_FullMethodName,
+		FullMethod: SSHKeys_History_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PasswordsServer).Add(ctx, req.(*PasswordCreateRequest))
+		return srv.(SSHKeysServer).History(ctx, req.(*HistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Passwords_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PasswordUpdateRequest)
+func _SSHKeys_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PasswordsServer).Update(ctx, in)
+		return srv.(SSHKeysServer).Restore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Passwords_Update_FullMethodName,
+		FullMethod: SSHKeys_Restore_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PasswordsServer).Update(ctx, req.(*PasswordUpdateRequest))
+		return srv.(SSHKeysServer).Restore(ctx, req.(*RestoreRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Passwords_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PasswordRequest)
+func _SSHKeys_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PasswordsServer).Delete(ctx, in)
+		return srv.(SSHKeysServer).Rename(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Passwords_Delete_FullMethodName,
+		FullMethod: SSHKeys_Rename_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PasswordsServer).Delete(ctx, req.(*PasswordRequest))
+		return srv.(SSHKeysServer).Rename(ctx, req.(*RenameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Passwords_ServiceDesc is the grpc.ServiceDesc for Passwords service.
+// SSHKeys_ServiceDesc is the grpc.ServiceDesc for SSHKeys service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var Passwords_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "gophkeeper.Passwords",
-	HandlerType: (*PasswordsServer)(nil),
+var SSHKeys_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gophkeeper.SSHKeys",
+	HandlerType: (*SSHKeysServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Get",
-			Handler:    _Passwords_Get_Handler,
+			Handler:    _SSHKeys_Get_Handler,
 		},
 		{
 			MethodName: "Add",
-			Handler:    _Passwords_Add_Handler,
+			Handler:    _SSHKeys_Add_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _Passwords_Update_Handler,
+			Handler:    _SSHKeys_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _Passwords_Delete_Handler,
+			Handler:    _SSHKeys_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _SSHKeys_List_Handler,
+		},
+		{
+			MethodName: "PublicKeys",
+			Handler:    _SSHKeys_PublicKeys_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _SSHKeys_History_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _SSHKeys_Restore_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _SSHKeys_Rename_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -376,215 +3201,253 @@ var Passwords_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	Cards_Get_FullMethodName    = "/gophkeeper.Cards/Get"
-	Cards_Add_FullMethodName    = "/gophkeeper.Cards/Add"
-	Cards_Update_FullMethodName = "/gophkeeper.Cards/Update"
-	Cards_Delete_FullMethodName = "/gophkeeper.Cards/Delete"
+	Folders_Create_FullMethodName = "/gophkeeper.Folders/Create"
+	Folders_Move_FullMethodName   = "/gophkeeper.Folders/Move"
+	Folders_Remove_FullMethodName = "/gophkeeper.Folders/Remove"
+	Folders_List_FullMethodName   = "/gophkeeper.Folders/List"
+	Folders_Place_FullMethodName  = "/gophkeeper.Folders/Place"
 )
 
-// CardsClient is the client API for Cards service.
+// FoldersClient is the client API for Folders service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type CardsClient interface {
-	Get(ctx context.Context, in *CardRequest, opts ...grpc.CallOption) (*CardResponse, error)
-	Add(ctx context.Context, in *CardCreateRequest, opts ...grpc.CallOption) (*CardShortResponse, error)
-	Update(ctx context.Context, in *CardUpdateRequest, opts ...grpc.CallOption) (*CardShortResponse, error)
-	Delete(ctx context.Context, in *CardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+type FoldersClient interface {
+	Create(ctx context.Context, in *FolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Move(ctx context.Context, in *FolderMoveRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Remove(ctx context.Context, in *FolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FolderListResponse, error)
+	Place(ctx context.Context, in *PlaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
-type cardsClient struct {
+type foldersClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewCardsClient(cc grpc.ClientConnInterface) CardsClient {
-	return &cardsClient{cc}
+func NewFoldersClient(cc grpc.ClientConnInterface) FoldersClient {
+	return &foldersClient{cc}
 }
 
-func (c *cardsClient) Get(ctx context.Context, in *CardRequest, opts ...grpc.CallOption) (*CardResponse, error) {
+func (c *foldersClient) Create(ctx context.Context, in *FolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CardResponse)
-	err := c.cc.Invoke(ctx, Cards_Get_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Folders_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cardsClient) Add(ctx context.Context, in *CardCreateRequest, opts ...grpc.CallOption) (*CardShortResponse, error) {
+func (c *foldersClient) Move(ctx context.Context, in *FolderMoveRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CardShortResponse)
-	err := c.cc.Invoke(ctx, Cards_Add_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Folders_Move_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cardsClient) Update(ctx context.Context, in *CardUpdateRequest, opts ...grpc.CallOption) (*CardShortResponse, error) {
+func (c *foldersClient) Remove(ctx context.Context, in *FolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CardShortResponse)
-	err := c.cc.Invoke(ctx, Cards_Update_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Folders_Remove_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cardsClient) Delete(ctx context.Context, in *CardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *foldersClient) List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FolderListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FolderListResponse)
+	err := c.cc.Invoke(ctx, Folders_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foldersClient) Place(ctx context.Context, in *PlaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Cards_Delete_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Folders_Place_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// CardsServer is the server API for Cards service.
-// All implementations must embed UnimplementedCardsServer
+// FoldersServer is the server API for Folders service.
+// All implementations must embed UnimplementedFoldersServer
 // for forward compatibility.
-type CardsServer interface {
-	Get(context.Context, *CardRequest) (*CardResponse, error)
-	Add(context.Context, *CardCreateRequest) (*CardShortResponse, error)
-	Update(context.Context, *CardUpdateRequest) (*CardShortResponse, error)
-	Delete(context.Context, *CardRequest) (*emptypb.Empty, error)
-	mustEmbedUnimplementedCardsServer()
+type FoldersServer interface {
+	Create(context.Context, *FolderRequest) (*emptypb.Empty, error)
+	Move(context.Context, *FolderMoveRequest) (*emptypb.Empty, error)
+	Remove(context.Context, *FolderRequest) (*emptypb.Empty, error)
+	List(context.Context, *emptypb.Empty) (*FolderListResponse, error)
+	Place(context.Context, *PlaceRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedFoldersServer()
 }
 
-// UnimplementedCardsServer must be embedded to have
+// UnimplementedFoldersServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedCardsServer struct{}
+type UnimplementedFoldersServer struct{}
 
-func (UnimplementedCardsServer) Get(context.Context, *CardRequest) (*CardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func (UnimplementedFoldersServer) Create(context.Context, *FolderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedCardsServer) Add(context.Context, *CardCreateRequest) (*CardShortResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+func (UnimplementedFoldersServer) Move(context.Context, *FolderMoveRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Move not implemented")
 }
-func (UnimplementedCardsServer) Update(context.Context, *CardUpdateRequest) (*CardShortResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+func (UnimplementedFoldersServer) Remove(context.Context, *FolderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
 }
-func (UnimplementedCardsServer) Delete(context.Context, *CardRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (UnimplementedFoldersServer) List(context.Context, *emptypb.Empty) (*FolderListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedCardsServer) mustEmbedUnimplementedCardsServer() {}
-func (UnimplementedCardsServer) testEmbeddedByValue()               {}
+func (UnimplementedFoldersServer) Place(context.Context, *PlaceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Place not implemented")
+}
+func (UnimplementedFoldersServer) mustEmbedUnimplementedFoldersServer() {}
+func (UnimplementedFoldersServer) testEmbeddedByValue()                 {}
 
-// UnsafeCardsServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CardsServer will
+// UnsafeFoldersServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FoldersServer will
 // result in compilation errors.
-type UnsafeCardsServer interface {
-	mustEmbedUnimplementedCardsServer()
+type UnsafeFoldersServer interface {
+	mustEmbedUnimplementedFoldersServer()
 }
 
-func RegisterCardsServer(s grpc.ServiceRegistrar, srv CardsServer) {
-	// If the following call pancis, it indicates UnimplementedCardsServer was
+func RegisterFoldersServer(s grpc.ServiceRegistrar, srv FoldersServer) {
+	// If the following call pancis, it indicates UnimplementedFoldersServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&Cards_ServiceDesc, srv)
+	s.RegisterService(&Folders_ServiceDesc, srv)
 }
 
-func _Cards_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CardRequest)
+func _Folders_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FolderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CardsServer).Get(ctx, in)
+		return srv.(FoldersServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Cards_Get_FullMethodName,
+		FullMethod: Folders_Create_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CardsServer).Get(ctx, req.(*CardRequest))
+		return srv.(FoldersServer).Create(ctx, req.(*FolderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Cards_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CardCreateRequest)
+func _Folders_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FolderMoveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CardsServer).Add(ctx, in)
+		return srv.(FoldersServer).Move(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Cards_Add_FullMethodName,
+		FullMethod: Folders_Move_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CardsServer).Add(ctx, req.(*CardCreateRequest))
+		return srv.(FoldersServer).Move(ctx, req.(*FolderMoveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Cards_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CardUpdateRequest)
+func _Folders_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FolderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CardsServer).Update(ctx, in)
+		return srv.(FoldersServer).Remove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Cards_Update_FullMethodName,
+		FullMethod: Folders_Remove_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CardsServer).Update(ctx, req.(*CardUpdateRequest))
+		return srv.(FoldersServer).Remove(ctx, req.(*FolderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Cards_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CardRequest)
+func _Folders_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CardsServer).Delete(ctx, in)
+		return srv.(FoldersServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Cards_Delete_FullMethodName,
+		FullMethod: Folders_List_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CardsServer).Delete(ctx, req.(*CardRequest))
+		return srv.(FoldersServer).List(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Cards_ServiceDesc is the grpc.ServiceDesc for Cards service.
+func _Folders_Place_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoldersServer).Place(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Folders_Place_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoldersServer).Place(ctx, req.(*PlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Folders_ServiceDesc is the grpc.ServiceDesc for Folders service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var Cards_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "gophkeeper.Cards",
-	HandlerType: (*CardsServer)(nil),
+var Folders_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gophkeeper.Folders",
+	HandlerType: (*FoldersServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Get",
-			Handler:    _Cards_Get_Handler,
+			MethodName: "Create",
+			Handler:    _Folders_Create_Handler,
 		},
 		{
-			MethodName: "Add",
-			Handler:    _Cards_Add_Handler,
+			MethodName: "Move",
+			Handler:    _Folders_Move_Handler,
 		},
 		{
-			MethodName: "Update",
-			Handler:    _Cards_Update_Handler,
+			MethodName: "Remove",
+			Handler:    _Folders_Remove_Handler,
 		},
 		{
-			MethodName: "Delete",
-			Handler:    _Cards_Delete_Handler,
+			MethodName: "List",
+			Handler:    _Folders_List_Handler,
+		},
+		{
+			MethodName: "Place",
+			Handler:    _Folders_Place_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -592,215 +3455,177 @@ var Cards_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	Binaries_Get_FullMethodName    = "/gophkeeper.Binaries/Get"
-	Binaries_Add_FullMethodName    = "/gophkeeper.Binaries/Add"
-	Binaries_Update_FullMethodName = "/gophkeeper.Binaries/Update"
-	Binaries_Delete_FullMethodName = "/gophkeeper.Binaries/Delete"
+	Trash_ListTrash_FullMethodName = "/gophkeeper.Trash/ListTrash"
+	Trash_Restore_FullMethodName   = "/gophkeeper.Trash/Restore"
+	Trash_Purge_FullMethodName     = "/gophkeeper.Trash/Purge"
 )
 
-// BinariesClient is the client API for Binaries service.
+// TrashClient is the client API for Trash service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type BinariesClient interface {
-	Get(ctx context.Context, in *BinariesRequest, opts ...grpc.CallOption) (*BinariesResponse, error)
-	Add(ctx context.Context, in *BinariesCreateRequest, opts ...grpc.CallOption) (*BinariesShortResponse, error)
-	Update(ctx context.Context, in *BinariesUpdateRequest, opts ...grpc.CallOption) (*BinariesShortResponse, error)
-	Delete(ctx context.Context, in *BinariesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+type TrashClient interface {
+	ListTrash(ctx context.Context, in *TrashListRequest, opts ...grpc.CallOption) (*TrashListResponse, error)
+	Restore(ctx context.Context, in *TrashRequest, opts ...grpc.CallOption) (*TrashRestoreResponse, error)
+	Purge(ctx context.Context, in *TrashRequest, opts ...grpc.CallOption) (*TrashPurgeResponse, error)
 }
 
-type binariesClient struct {
+type trashClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewBinariesClient(cc grpc.ClientConnInterface) BinariesClient {
-	return &binariesClient{cc}
-}
-
-func (c *binariesClient) Get(ctx context.Context, in *BinariesRequest, opts ...grpc.CallOption) (*BinariesResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BinariesResponse)
-	err := c.cc.Invoke(ctx, Binaries_Get_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewTrashClient(cc grpc.ClientConnInterface) TrashClient {
+	return &trashClient{cc}
 }
 
-func (c *binariesClient) Add(ctx context.Context, in *BinariesCreateRequest, opts ...grpc.CallOption) (*BinariesShortResponse, error) {
+func (c *trashClient) ListTrash(ctx context.Context, in *TrashListRequest, opts ...grpc.CallOption) (*TrashListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BinariesShortResponse)
-	err := c.cc.Invoke(ctx, Binaries_Add_FullMethodName, in, out, cOpts...)
+	out := new(TrashListResponse)
+	err := c.cc.Invoke(ctx, Trash_ListTrash_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *binariesClient) Update(ctx context.Context, in *BinariesUpdateRequest, opts ...grpc.CallOption) (*BinariesShortResponse, error) {
+func (c *trashClient) Restore(ctx context.Context, in *TrashRequest, opts ...grpc.CallOption) (*TrashRestoreResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BinariesShortResponse)
-	err := c.cc.Invoke(ctx, Binaries_Update_FullMethodName, in, out, cOpts...)
+	out := new(TrashRestoreResponse)
+	err := c.cc.Invoke(ctx, Trash_Restore_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *binariesClient) Delete(ctx context.Context, in *BinariesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *trashClient) Purge(ctx context.Context, in *TrashRequest, opts ...grpc.CallOption) (*TrashPurgeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Binaries_Delete_FullMethodName, in, out, cOpts...)
+	out := new(TrashPurgeResponse)
+	err := c.cc.Invoke(ctx, Trash_Purge_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// BinariesServer is the server API for Binaries service.
-// All implementations must embed UnimplementedBinariesServer
+// TrashServer is the server API for Trash service.
+// All implementations must embed UnimplementedTrashServer
 // for forward compatibility.
-type BinariesServer interface {
-	Get(context.Context, *BinariesRequest) (*BinariesResponse, error)
-	Add(context.Context, *BinariesCreateRequest) (*BinariesShortResponse, error)
-	Update(context.Context, *BinariesUpdateRequest) (*BinariesShortResponse, error)
-	Delete(context.Context, *BinariesRequest) (*emptypb.Empty, error)
-	mustEmbedUnimplementedBinariesServer()
+type TrashServer interface {
+	ListTrash(context.Context, *TrashListRequest) (*TrashListResponse, error)
+	Restore(context.Context, *TrashRequest) (*TrashRestoreResponse, error)
+	Purge(context.Context, *TrashRequest) (*TrashPurgeResponse, error)
+	mustEmbedUnimplementedTrashServer()
 }
 
-// UnimplementedBinariesServer must be embedded to have
+// UnimplementedTrashServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedBinariesServer struct{}
+type UnimplementedTrashServer struct{}
 
-func (UnimplementedBinariesServer) Get(context.Context, *BinariesRequest) (*BinariesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
-}
-func (UnimplementedBinariesServer) Add(context.Context, *BinariesCreateRequest) (*BinariesShortResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+func (UnimplementedTrashServer) ListTrash(context.Context, *TrashListRequest) (*TrashListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
 }
-func (UnimplementedBinariesServer) Update(context.Context, *BinariesUpdateRequest) (*BinariesShortResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+func (UnimplementedTrashServer) Restore(context.Context, *TrashRequest) (*TrashRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
 }
-func (UnimplementedBinariesServer) Delete(context.Context, *BinariesRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (UnimplementedTrashServer) Purge(context.Context, *TrashRequest) (*TrashPurgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purge not implemented")
 }
-func (UnimplementedBinariesServer) mustEmbedUnimplementedBinariesServer() {}
-func (UnimplementedBinariesServer) testEmbeddedByValue()                  {}
+func (UnimplementedTrashServer) mustEmbedUnimplementedTrashServer() {}
+func (UnimplementedTrashServer) testEmbeddedByValue()               {}
 
-// UnsafeBinariesServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BinariesServer will
+// UnsafeTrashServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TrashServer will
 // result in compilation errors.
-type UnsafeBinariesServer interface {
-	mustEmbedUnimplementedBinariesServer()
+type UnsafeTrashServer interface {
+	mustEmbedUnimplementedTrashServer()
 }
 
-func RegisterBinariesServer(s grpc.ServiceRegistrar, srv BinariesServer) {
-	// If the following call pancis, it indicates UnimplementedBinariesServer was
+func RegisterTrashServer(s grpc.ServiceRegistrar, srv TrashServer) {
+	// If the following call pancis, it indicates UnimplementedTrashServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&Binaries_ServiceDesc, srv)
-}
-
-func _Binaries_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BinariesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BinariesServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: Binaries_Get_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BinariesServer).Get(ctx, req.(*BinariesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	s.RegisterService(&Trash_ServiceDesc, srv)
 }
 
-func _Binaries_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BinariesCreateRequest)
+func _Trash_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrashListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BinariesServer).Add(ctx, in)
+		return srv.(TrashServer).ListTrash(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Binaries_Add_FullMethodName,
+		FullMethod: Trash_ListTrash_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BinariesServer).Add(ctx, req.(*BinariesCreateRequest))
+		return srv.(TrashServer).ListTrash(ctx, req.(*TrashListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Binaries_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BinariesUpdateRequest)
+func _Trash_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrashRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BinariesServer).Update(ctx, in)
+		return srv.(TrashServer).Restore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Binaries_Update_FullMethodName,
+		FullMethod: Trash_Restore_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BinariesServer).Update(ctx, req.(*BinariesUpdateRequest))
+		return srv.(TrashServer).Restore(ctx, req.(*TrashRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Binaries_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BinariesRequest)
+func _Trash_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrashRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BinariesServer).Delete(ctx, in)
+		return srv.(TrashServer).Purge(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Binaries_Delete_FullMethodName,
+		FullMethod: Trash_Purge_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BinariesServer).Delete(ctx, req.(*BinariesRequest))
+		return srv.(TrashServer).Purge(ctx, req.(*TrashRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Binaries_ServiceDesc is the grpc.ServiceDesc for Binaries service.
+// Trash_ServiceDesc is the grpc.ServiceDesc for Trash service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var Binaries_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "gophkeeper.Binaries",
-	HandlerType: (*BinariesServer)(nil),
+var Trash_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gophkeeper.Trash",
+	HandlerType: (*TrashServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Get",
-			Handler:    _Binaries_Get_Handler,
-		},
-		{
-			MethodName: "Add",
-			Handler:    _Binaries_Add_Handler,
+			MethodName: "ListTrash",
+			Handler:    _Trash_ListTrash_Handler,
 		},
 		{
-			MethodName: "Update",
-			Handler:    _Binaries_Update_Handler,
+			MethodName: "Restore",
+			Handler:    _Trash_Restore_Handler,
 		},
 		{
-			MethodName: "Delete",
-			Handler:    _Binaries_Delete_Handler,
+			MethodName: "Purge",
+			Handler:    _Trash_Purge_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},